@@ -0,0 +1,193 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+var (
+	allocateByPages bool
+	allocateSeed    int64
+)
+
+// allocateCmd represents the allocate command
+var allocateCmd = &cobra.Command{
+	Use:   "allocate [exam] [marker...]",
+	Short: "Share the scripts in an exam between several markers",
+	Args:  cobra.MinimumNArgs(2),
+	Long: `Allocate the anonymous scripts in an exam between markers, and add
+mark bars for each marker. Each marker can have a weight (default 1) and a cap
+on the number of scripts (default none), given as name[:weight[:cap]], e.g.
+
+gradex-cli allocate 'ELEE09000 a b c exam' abc def:2 ghi::30
+
+gives def twice as much as abc, and ghi no more than 30 scripts.
+
+Scripts are balanced by count, or by number of pages with --by-pages. The
+allocation uses a fixed seed (--seed) so it can be repeated, and is recorded in
+the allocation register in the exam's 00-config directory. Scripts that are
+already in the register keep their marker, so running allocate again only
+shares out new scripts. Once there is a register, the mark command only gives
+a marker their allocated scripts, and export and flatten work from the
+markers' own directories as usual.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		exam := args[0]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		markers, err := ingester.ParseAllocationMarkers(args[1:])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "allocate").
+			Str("exam", exam).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+		g.SetupExamDirs(exam)
+
+		lockExamOrExit(g, exam)
+
+		if Template != "" {
+			err := g.SetOverlayTemplatePath(Template)
+			if err != nil {
+				fmt.Printf("Overlay not usable because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		allocations, err := g.AllocateMarking(exam, markers, allocateByPages, allocateSeed)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		scripts := make(map[string]int)
+		pages := make(map[string]int)
+		unallocated := 0
+
+		for _, a := range allocations {
+			if a.Marker == "" {
+				unallocated++
+				continue
+			}
+			scripts[ingester.GetShortActorName(a.Marker)]++
+			pages[ingester.GetShortActorName(a.Marker)] += a.Pages
+		}
+
+		for _, m := range markers {
+			short := ingester.GetShortActorName(m.Name)
+			fmt.Printf("%s: %d scripts, %d pages\n", m.Name, scripts[short], pages[short])
+		}
+
+		if unallocated > 0 {
+			fmt.Printf("%d scripts not allocated because all markers are at their cap\n", unallocated)
+		}
+
+		fmt.Printf("Register: %s\n", g.AllocationRegister(exam))
+
+		for _, m := range markers {
+
+			// e.g. more markers than scripts, or a cap of zero; AddMarkBar would
+			// refuse, and stopping here would leave the other markers half done
+			if scripts[ingester.GetShortActorName(m.Name)] == 0 {
+				fmt.Printf("Warning: no scripts allocated to %s, so not adding a mark bar for them\n", m.Name)
+				logger.Warn().Str("marker", m.Name).Msg("No scripts allocated, so no mark bar added")
+				continue
+			}
+
+			err = g.AddMarkBar(exam, m.Name)
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}
+
+		unlockExam(g, exam)
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(allocateCmd)
+	allocateCmd.Flags().BoolVar(&allocateByPages, "by-pages", false, "balance by number of pages instead of number of scripts [default false]")
+	allocateCmd.Flags().Int64Var(&allocateSeed, "seed", 1, "seed for shuffling the scripts, so the allocation can be repeated [default 1]")
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// allocateCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// allocateCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
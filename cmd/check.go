@@ -98,6 +98,8 @@ gradex-cli checker x demo-exam --ignore-question-file
 		}
 
 		g.EnsureDirectoryStructure()
+
+		lockExamOrExit(g, exam)
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -114,6 +116,8 @@ gradex-cli checker x demo-exam --ignore-question-file
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
@@ -88,6 +88,8 @@ is needed - there's no one to keep track of now!
 
 		g.EnsureDirectoryStructure()
 
+		lockExamOrExit(g, exam)
+
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -105,6 +107,8 @@ is needed - there's no one to keep track of now!
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
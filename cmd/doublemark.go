@@ -0,0 +1,148 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+// doublemarkCmd represents the doublemark command
+var doublemarkCmd = &cobra.Command{
+	Use:   "doublemark [first] [second] [exam]",
+	Short: "Add mark bars for two independent markers",
+	Long: `Add mark bars to all flattened scripts, for two markers who each mark every
+script without seeing the other's marks, for example
+
+gradex-cli doublemark abc def demo-exam
+
+Both markers get their own copy of the same anonymous original. When their
+scripts are returned, flatten keeps them apart in 23-marker-flattened/ABC and
+23-marker-flattened/DEF, and only the first marker's scripts are merged.
+Compare the two sets of marks with
+
+gradex-cli report reconcile demo-exam --threshold=2
+
+Scripts that differ by more than the threshold on any question, or in total,
+are flagged for a third marker or an agreed mark.
+
+Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT/usr/exam/
+
+`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		first := os.Args[2]
+		second := os.Args[3]
+		exam := os.Args[4]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		logger := zerolog.New(f).With().Timestamp().Logger()
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+		g.SetupExamDirs(exam)
+
+		lockExamOrExit(g, exam)
+
+		if Template != "" {
+			err := g.SetOverlayTemplatePath(Template)
+			if err != nil {
+				fmt.Printf("Overlay not usable because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		err = g.SetDoubleMarkers(exam, first, second)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		for _, marker := range []string{first, second} {
+
+			err = g.AddMarkBar(exam, marker)
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}
+
+		unlockExam(g, exam)
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doublemarkCmd)
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// doublemarkCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// doublemarkCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
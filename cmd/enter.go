@@ -92,6 +92,8 @@ this will produce a bunch of files in the enterReady directory, which can be exp
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
 
+		lockExamOrExit(g, exam)
+
 		// TODO handling redo flag to redo the split is starting to get into
 		// unclear territory - do you remove all files from moderation sets?
 		// what if they have been sent?
@@ -128,6 +130,8 @@ this will produce a bunch of files in the enterReady directory, which can be exp
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
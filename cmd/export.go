@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog"
@@ -28,6 +29,8 @@ import (
 	"github.com/timdrysdale/gradex-cli/ingester"
 )
 
+var exportDue string
+
 // exportCmd represents the export command
 var exportCmd = &cobra.Command{
 	Use:   "export [stage] [who] [exam]",
@@ -46,7 +49,16 @@ checking
 remarking
 rechecking
 
-Exported files are usually flagged in some way, e.g. being moved to a "sent" folder internally.`,
+Exported files are usually flagged in some way, e.g. being moved to a "sent" folder internally.
+
+Each export is recorded as a batch, with an optional due date, e.g.
+
+gradex-cli export marking pjh 'ELEE09000 a b c exam' --due=2020-06-01
+
+and returns are matched against the batch when they are ingested. To see what is
+outstanding, overdue or partially returned, use
+
+gradex-cli list turnaround 'ELEE09000 a b c exam'`,
 	Run: func(cmd *cobra.Command, args []string) {
 		which := os.Args[2]
 		who := os.Args[3]
@@ -105,15 +117,31 @@ Exported files are usually flagged in some way, e.g. being moved to a "sent" fol
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
 
-		err = g.ExportFiles(exam, which, who)
+		lockExamOrExit(g, exam)
+
+		var due time.Time
+
+		if exportDue != "" {
+			due, err = time.ParseInLocation("2006-01-02", exportDue, time.Local)
+			if err != nil {
+				fmt.Printf("Due date %s not understood, please use YYYY-MM-DD\n", exportDue)
+				os.Exit(1)
+			}
+			due = due.Add(24*time.Hour - time.Second) // due by the end of the day
+		}
+
+		err = g.ExportFilesDue(exam, which, who, due)
 		if err != nil {
 			fmt.Println(err)
 		}
+
+		unlockExam(g, exam)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&exportDue, "due", "", "date the exported files are due back, as YYYY-MM-DD")
 
 	// Here you will define your flags and configuration settings.
 
@@ -31,6 +31,7 @@ import (
 
 var (
 	ensureAncestor bool
+	splitA3        bool
 )
 
 // flattenCmd represents the flatten command
@@ -43,6 +44,11 @@ Example usage:
 
 gradex-cli flatten SomeExam new
 
+New pages that are landscape, or A3, use the variant of the flatten spread
+for them, if the ingest layout has one (e.g. flatten-landscape). With
+--split-a3, A3 landscape pages (two A4 pages scanned side by side) are split
+into two pages when flattening new papers.
+
 Possible stages to flatten
 
 new
@@ -100,6 +106,8 @@ rechecked`,
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
 
+		lockExamOrExit(g, exam)
+
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -114,6 +122,8 @@ rechecked`,
 
 		case strings.ToLower(stage) == "new":
 
+			g.SetSplitA3(splitA3)
+
 			err = g.FlattenNewPapers(exam)
 
 		case ingester.ValidStageForProcessedPapers(stage):
@@ -144,6 +154,8 @@ rechecked`,
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 
 	},
@@ -152,6 +164,7 @@ rechecked`,
 func init() {
 	rootCmd.AddCommand(flattenCmd)
 	flattenCmd.Flags().BoolVar(&ensureAncestor, "ensure-ancestor", false, "Force rewriting of pagedata to ensure link to ancestor in anonPapers [default false]")
+	flattenCmd.Flags().BoolVar(&splitA3, "split-a3", false, "split A3 landscape pages of new papers into two A4 pages [default false]")
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
@@ -29,6 +29,7 @@ import (
 )
 
 var UseFullAssignmentName bool
+var RelinkScans bool
 
 // ingestCmd represents the ingest command
 var ingestCmd = &cobra.Command{
@@ -50,6 +51,13 @@ variable, e.g. on linux
 
 GRADEX_CLI_ROOT=/some/test/gradex; gradex-cli ingest
 
+If there are scans of printed scripts in ingest, which have lost their pagedata,
+use --relink-scans to read their identity codes and put their pagedata back.
+This is off by default, because the first page of every PDF without pagedata
+has to be rasterised to look for a code.
+
+gradex-cli ingest --relink-scans
+
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		var s Specification
@@ -99,6 +107,8 @@ GRADEX_CLI_ROOT=/some/test/gradex; gradex-cli ingest
 			logger.Info().Msg("Using short names for assignments")
 		}
 
+		g.SetRelinkScans(RelinkScans)
+
 		g.EnsureDirectoryStructure()
 
 		err = g.StageFromIngest()
@@ -122,4 +132,5 @@ GRADEX_CLI_ROOT=/some/test/gradex; gradex-cli ingest
 func init() {
 	rootCmd.AddCommand(ingestCmd)
 	ingestCmd.Flags().BoolVarP(&UseFullAssignmentName, "use-long-name", "l", false, "Use long name for assignment [default false]")
+	ingestCmd.Flags().BoolVar(&RelinkScans, "relink-scans", false, "Relink PDFs without pagedata from their identity codes [default false]")
 }
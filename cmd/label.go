@@ -92,6 +92,8 @@ Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT
 
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
+
+		lockExamOrExit(g, exam)
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -108,6 +110,8 @@ Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
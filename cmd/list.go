@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog"
@@ -47,6 +48,7 @@ tree - tree diagram of exam folder with file/done counts
 pagetree - as above but with page counts
 sortcheck - checks the sort was ok
 pagedata - read and prettyprint the pagedata from a file
+turnaround - exported files that are outstanding, overdue or partially returned, by actor
 
 For example:
 
@@ -146,6 +148,38 @@ gradex-cli list badpages 'PGEE00000 A B D Exam'
 
 			g.SortCheck(exam)
 
+		case "turnaround":
+
+			summaries, err := g.GetTurnaround(exam, time.Now())
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			for _, at := range summaries {
+
+				fmt.Printf("%s (%s): %d returned, %d outstanding, %d overdue, %d partial batches\n",
+					at.Actor, at.Stage, at.Returned, len(at.Outstanding), len(at.Overdue), len(at.Partial))
+
+				overdue := make(map[string]bool)
+				for _, file := range at.Overdue {
+					overdue[file] = true
+				}
+
+				for _, file := range at.Outstanding {
+					if overdue[file] {
+						fmt.Printf("    OVERDUE %s\n", file)
+					} else {
+						fmt.Printf("    out     %s\n", file)
+					}
+				}
+
+				for _, batch := range at.Partial {
+					fmt.Printf("    partial batch %s\n", batch)
+				}
+			}
+
 		case "pagedata":
 
 			pageDataMap, err := pagedata.UnMarshalAllFromFile(exam)
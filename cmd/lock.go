@@ -0,0 +1,181 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+// lockCmd represents the lock command
+var lockCmd = &cobra.Command{
+	Use:   "lock [action] [exam]",
+	Args:  cobra.ExactArgs(2),
+	Short: "show or break the lock on an exam",
+	Long: `Commands that change an exam (flatten, mark, export etc) take a lock on
+the exam while they run, so that two people can't process the same exam at once.
+
+Locks left behind by a crashed process on this machine are noticed and
+replaced automatically. Locks from other machines are only treated as stale
+after a day, so you may need to break them by hand, once you are sure
+nobody else is working on the exam.
+
+status - show who holds the lock
+break - remove the lock, whoever holds it
+
+For example:
+
+gradex-cli lock status 'PGEE00000 A B D Exam'
+
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		action := strings.ToLower(os.Args[2])
+		exam := os.Args[3]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "lock").
+			Str("action", action).
+			Str("exam", exam).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		switch action {
+
+		case "status":
+
+			lock, err := g.GetExamLock(exam)
+
+			if os.IsNotExist(err) {
+				fmt.Printf("%s is not locked\n", exam)
+				os.Exit(0)
+			}
+
+			if err != nil {
+				fmt.Printf("Lock file %s could not be read: %v\n", g.ExamLockPath(exam), err)
+				os.Exit(1)
+			}
+
+			if lock.IsStale() {
+				fmt.Printf("%s has a stale lock %s\n", exam, lock.String())
+			} else {
+				fmt.Printf("%s is locked %s\n", exam, lock.String())
+			}
+
+		case "break":
+
+			lock, err := g.BreakExamLock(exam)
+
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("Broke lock on %s that was %s\n", exam, lock.String())
+
+		default:
+			fmt.Printf("Unknown lock action: %s\n", action)
+			os.Exit(1)
+		}
+	},
+}
+
+// lockExamOrExit is for commands that change an exam. The lock is released
+// by unlockExam on success; if we exit early with an error, the lock is
+// left behind but is detected as stale because our process has gone.
+func lockExamOrExit(g *ingester.Ingester, exam string) {
+
+	err := g.LockExam(exam, strings.Join(os.Args[1:], " "))
+
+	if err != nil {
+		fmt.Println(err)
+		fmt.Println("If you are sure nobody else is working on this exam, use gradex-cli lock break [exam]")
+		os.Exit(1)
+	}
+}
+
+func unlockExam(g *ingester.Ingester, exam string) {
+
+	err := g.UnlockExam(exam)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(lockCmd)
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// lockCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// lockCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
@@ -96,6 +96,8 @@ Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
 
+		lockExamOrExit(g, exam)
+
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -111,6 +113,8 @@ Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
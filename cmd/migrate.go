@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog"
@@ -112,22 +113,43 @@ Note that only error messages are shown when running for real
 
 			for _, thisExam := range exams {
 
+				// skip exams someone is working on, rather than stop half way through
+				if !testMigrate {
+					err = g.LockExam(filepath.Base(thisExam), strings.Join(os.Args[1:], " "))
+					if err != nil {
+						fmt.Printf("Not migrating %s: %v\n", filepath.Base(thisExam), err)
+						continue
+					}
+				}
+
 				err = g.MigrateVersionDirStruct(thisExam, testMigrate)
 
 				if err != nil {
 					fmt.Println(err)
 				}
 
+				if !testMigrate {
+					unlockExam(g, filepath.Base(thisExam))
+				}
+
 			}
 
 		default:
 
+			if !testMigrate {
+				lockExamOrExit(g, exam)
+			}
+
 			err = g.MigrateVersionDirStruct(g.GetExamRoot(exam), testMigrate)
 
 			if err != nil {
 				fmt.Println(err)
 			}
 
+			if !testMigrate {
+				unlockExam(g, exam)
+			}
+
 		}
 
 	},
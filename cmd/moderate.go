@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog"
@@ -28,6 +29,14 @@ import (
 	"github.com/timdrysdale/gradex-cli/ingester"
 )
 
+var (
+	moderateSeed       int64
+	moderateMinFiles   int
+	moderateMinPercent float64
+	moderatePerMarker  int
+	moderateBands      []string
+)
+
 // moderateCmd represents the moderate command
 var moderateCmd = &cobra.Command{
 	Use:   "moderate [moderator] [exam]",
@@ -43,8 +52,16 @@ $GRADEX_CLI_ROOT/usr/demo-exam/30.ReadyToModerate/ABC/<original-filename>-moABC.
 
 Note that the exam argument is the relative path to the exam in $GRADEX_CLI_ROOT/usr/exam/
 
-Also, you need to have split your batch of marked scripts and moved them into the 
-active/inactive directory according to whether they are to be moderated or not
+The first time you run this for an exam, the marked scripts are split into
+active and inactive sets according to the sampling policy:
+
+--band fail:0:40 --band borderline:38:42  all scripts with a total mark in these bands
+--per-marker 3                            at least 3 scripts from each marker
+--min-files 10 --min-percent 10           plus a random 10% of scripts, or 10, whichever is more
+
+The reasons for selecting each script, and the seed used for the random
+selection, are in the ModerationSample report in 99-reports. Use --seed to
+repeat a selection.
 
 `,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -98,6 +115,8 @@ active/inactive directory according to whether they are to be moderated or not
 		// these functions MUST not delete anything!
 		g.EnsureDirectoryStructure()
 		g.SetupExamDirs(exam)
+
+		lockExamOrExit(g, exam)
 		if Template != "" {
 			err := g.SetOverlayTemplatePath(Template)
 			if err != nil {
@@ -114,7 +133,27 @@ active/inactive directory according to whether they are to be moderated or not
 			// what if they have been sent?
 			// do you block if any moderation files have been exported?
 			// better to handle these cases manually
-			err = g.SplitForModeration(exam, 10, 10) //TODO set these from flag
+			policy := ingester.ModerationPolicy{
+				Seed:         moderateSeed,
+				MinFiles:     moderateMinFiles,
+				MinPercent:   moderateMinPercent,
+				PerMarkerMin: moderatePerMarker,
+			}
+
+			if policy.Seed == 0 {
+				policy.Seed = time.Now().Unix()
+			}
+
+			for _, spec := range moderateBands {
+				band, err := ingester.ParseMarkBand(spec)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+				policy.Bands = append(policy.Bands, band)
+			}
+
+			err = g.SplitForModerationWithPolicy(exam, policy)
 			if err != nil {
 				fmt.Println(err)
 				os.Exit(1)
@@ -184,12 +223,19 @@ active/inactive directory according to whether they are to be moderated or not
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(moderateCmd)
+	moderateCmd.Flags().Int64Var(&moderateSeed, "seed", 0, "seed for the random selection, 0 to pick one from the clock [default 0]")
+	moderateCmd.Flags().IntVar(&moderateMinFiles, "min-files", 10, "minimum number of randomly selected scripts [default 10]")
+	moderateCmd.Flags().Float64Var(&moderateMinPercent, "min-percent", 10, "minimum percentage of randomly selected scripts [default 10]")
+	moderateCmd.Flags().IntVar(&moderatePerMarker, "per-marker", 0, "minimum number of scripts from each marker [default 0]")
+	moderateCmd.Flags().StringArrayVar(&moderateBands, "band", []string{}, "select all scripts with total mark in name:low:high, e.g. fail:0:40 (repeatable)")
 
 	// Here you will define your flags and configuration settings.
 
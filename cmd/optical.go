@@ -0,0 +1,236 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+var (
+	opticalLow    float64
+	opticalMark   float64
+	opticalHigh   float64
+	opticalBlank  string
+	opticalFilled string
+	opticalSpread string
+	opticalExam   string
+)
+
+// opticalCmd represents the optical command
+var opticalCmd = &cobra.Command{
+	Use:   "optical [action]",
+	Short: "Show or change the optical box settings for a layout",
+	Args:  cobra.ExactArgs(1),
+	Long: `Show or change the optical box settings that are saved for a layout,
+and used whenever papers using that layout are flattened
+
+gradex-cli optical thresholds --layout layout.svg
+gradex-cli optical thresholds --layout layout.svg --low 0.01 --mark 0.02 --high 0.04
+gradex-cli optical calibrate --layout layout.svg --spread mark --blank ./blank --filled ./filled
+gradex-cli optical calibrate --layout layout.svg --exam ENGI01020 --blank ./blank --filled ./filled
+
+Boxes are scored by how much of them is filled in. Boxes filled more than
+mark are taken as marked, and boxes filled between low and high are flagged
+as uncertain, so that they are sent to enter, and show up in trace as UNSURE.
+Thresholds you don't give are left as they were.
+
+Calibrate takes sample pages made from the layout, in which every box of the
+spread is either blank, or filled in (e.g. by the pen or tablet your markers
+use), as images or PDFs. It tries each box shrink and background, and saves
+the shrink, background and thresholds that best separate the blank boxes from
+the filled ones. Once a layout is calibrated, flatten uses these settings
+instead of --box-shrink and --background-vanilla.
+
+With --exam, the exam's own layout is used if it has one in its template
+folder, and the settings are saved there, for that exam only (as they are
+anyway if the exam has a profile there already).
+
+Actions are: thresholds, calibrate`,
+	Run: func(cmd *cobra.Command, args []string) {
+		action := args[0]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "optical").
+			Str("action", action).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+
+		if Template != "" {
+			err := g.SetOverlayTemplatePath(Template)
+			if err != nil {
+				fmt.Printf("Overlay not usable because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		switch action {
+
+		case "thresholds":
+
+			profile, err := g.GetOpticalProfile(opticalExam)
+			if err != nil && !os.IsNotExist(err) {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			if cmd.Flags().Changed("low") || cmd.Flags().Changed("mark") || cmd.Flags().Changed("high") {
+
+				if cmd.Flags().Changed("low") {
+					profile.Thresholds.Low = opticalLow
+				}
+				if cmd.Flags().Changed("mark") {
+					profile.Thresholds.Mark = opticalMark
+				}
+				if cmd.Flags().Changed("high") {
+					profile.Thresholds.High = opticalHigh
+				}
+
+				err = g.SaveOpticalProfile(opticalExam, profile)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				fmt.Printf("Saved optical profile to %s\n", g.OpticalProfilePath(opticalExam))
+
+			} else if os.IsNotExist(err) {
+				fmt.Printf("No optical profile for %s, using defaults\n", profile.Template)
+			}
+
+			printOpticalProfile(profile)
+
+		case "calibrate":
+
+			if opticalBlank == "" || opticalFilled == "" {
+				fmt.Println("Please give the directories of blank and filled sample pages, with --blank and --filled")
+				os.Exit(1)
+			}
+
+			profile, err := g.CalibrateOptical(opticalExam, opticalSpread, opticalBlank, opticalFilled)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("Calibrated %s from %d blank and %d filled boxes, saved to %s\n",
+				profile.Template,
+				profile.BlankBoxes,
+				profile.FilledBoxes,
+				g.OpticalProfilePath(opticalExam))
+
+			if profile.Errors > 0 {
+				fmt.Printf("WARNING: %d sample boxes are still called wrongly with the best settings\n", profile.Errors)
+			}
+
+			printOpticalProfile(profile)
+
+		default:
+			fmt.Printf("Unknown action %s\n Try: [thresholds, calibrate]\n", action)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	},
+}
+
+func printOpticalProfile(profile ingester.OpticalProfile) {
+
+	fmt.Printf("low=%g mark=%g high=%g\n",
+		profile.Thresholds.Low,
+		profile.Thresholds.Mark,
+		profile.Thresholds.High)
+
+	if profile.Calibrated {
+		fmt.Printf("shrink=%d vanilla=%v (calibrated on spread %s, separation %.3f)\n",
+			profile.Shrink,
+			profile.Vanilla,
+			profile.Spread,
+			profile.Separation)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(opticalCmd)
+	opticalCmd.Flags().Float64Var(&opticalLow, "low", 0, "boxes filled more than this could be marked")
+	opticalCmd.Flags().Float64Var(&opticalMark, "mark", 0, "boxes filled more than this are taken as marked")
+	opticalCmd.Flags().Float64Var(&opticalHigh, "high", 0, "boxes filled more than this are certainly marked")
+	opticalCmd.Flags().StringVar(&opticalBlank, "blank", "", "directory of sample pages with every box blank")
+	opticalCmd.Flags().StringVar(&opticalFilled, "filled", "", "directory of sample pages with every box filled in")
+	opticalCmd.Flags().StringVar(&opticalSpread, "spread", "mark", "spread in the layout that the sample pages were made from")
+	opticalCmd.Flags().StringVar(&opticalExam, "exam", "", "use (and save) the settings in the exam's own template folder")
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// opticalCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// opticalCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
@@ -0,0 +1,219 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+var (
+	rasterStage   string
+	rasterDPI     int
+	rasterPPI     int
+	rasterQuality int
+	rasterColour  string
+	rasterMode    string
+)
+
+// rasterCmd represents the raster command
+var rasterCmd = &cobra.Command{
+	Use:   "raster [exam]",
+	Short: "Show or change how pages are rasterised for an exam",
+	Args:  cobra.ExactArgs(1),
+	Long: `Show or change the resolution, quality and colour used when the
+pages of an exam are turned into images, for all stages, or just one
+
+gradex-cli raster "PGEE00000 A Course"
+gradex-cli raster "PGEE00000 A Course" --colour grey --dpi 150
+gradex-cli raster "PGEE00000 A Course" --stage marking --colour bilevel --dpi 200
+gradex-cli raster "PGEE00000 A Course" --mode vector
+
+dpi is the resolution the pages are rendered at, and optical boxes are read
+at; ppi is the highest resolution kept for the page images in the PDF; quality
+is the JPEG quality from 1 to 100. Colour is one of colour, grey or bilevel.
+Bilevel pages are stored at one bit per pixel, compressed as CCITT Group 4
+fax, which suits black and white scans, but loses pale pen and highlighter.
+
+Mode is raster or vector. In vector mode, each stage is drawn over the pages
+of the last stage as they are, with their textfields and comments flattened,
+rather than over images of them, so typed answers stay sharp and selectable.
+Pages are still rendered at dpi to read the optical boxes.
+
+Stages are named by what the pages are being prepared for: prepare-for-marking
+(the first flatten), labelling, marking, moderating, entering, checking,
+finishing, or further-processing (merges). Settings you don't give for a stage
+come from the exam's default. Use "gradex-cli report sizes [exam]" to see how
+big the files are at each stage.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		exam := args[0]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "raster").
+			Str("exam", exam).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+
+		if _, err := os.Stat(g.GetExamRoot(exam)); err != nil {
+			fmt.Printf("Can't find exam %s\n", exam)
+			os.Exit(1)
+		}
+
+		rc, err := g.GetRasterConfig(exam)
+		if err != nil && !os.IsNotExist(err) {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		flags := cmd.Flags()
+
+		if flags.Changed("dpi") || flags.Changed("ppi") || flags.Changed("quality") || flags.Changed("colour") || flags.Changed("mode") {
+
+			rs := rc.Default
+			if rasterStage != "" {
+				rs = rc.Stages[rasterStage]
+			}
+
+			if flags.Changed("dpi") {
+				rs.DPI = rasterDPI
+			}
+			if flags.Changed("ppi") {
+				rs.PPI = rasterPPI
+			}
+			if flags.Changed("quality") {
+				rs.Quality = rasterQuality
+			}
+			if flags.Changed("colour") {
+				rs.Colour = rasterColour
+			}
+			if flags.Changed("mode") {
+				rs.Mode = rasterMode
+			}
+
+			if rasterStage != "" {
+				rc.Stages[rasterStage] = rs
+			} else {
+				rc.Default = rs
+			}
+
+			err = g.SetupExamDirs(exam)
+			if err != nil {
+				fmt.Printf("Failed setting up exam directories because %s\n", err.Error())
+				os.Exit(1)
+			}
+
+			err = g.SaveRasterConfig(exam, rc)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("Saved raster settings to %s\n", g.RasterConfigPath(exam))
+
+		} else if os.IsNotExist(err) {
+			fmt.Printf("No raster settings for %s, using defaults\n", exam)
+		}
+
+		printRasterConfig(rc)
+	},
+}
+
+func printRasterConfig(rc ingester.RasterConfig) {
+
+	fmt.Printf("default: %s\n", rc.Settings(""))
+
+	stages := []string{}
+	for stage := range rc.Stages {
+		stages = append(stages, stage)
+	}
+	sort.Strings(stages)
+
+	for _, stage := range stages {
+		fmt.Printf("%s: %s\n", stage, rc.Settings(stage))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(rasterCmd)
+	rasterCmd.Flags().StringVar(&rasterStage, "stage", "", "only change the settings for this stage")
+	rasterCmd.Flags().IntVar(&rasterDPI, "dpi", 0, "resolution to render pages at")
+	rasterCmd.Flags().IntVar(&rasterPPI, "ppi", 0, "highest resolution to keep for page images in the PDF")
+	rasterCmd.Flags().IntVar(&rasterQuality, "quality", 0, "JPEG quality, from 1 to 100")
+	rasterCmd.Flags().StringVar(&rasterColour, "colour", "", "colour, grey or bilevel")
+	rasterCmd.Flags().StringVar(&rasterMode, "mode", "", "raster or vector")
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// rasterCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// rasterCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
@@ -0,0 +1,153 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+var (
+	reassignFrom string
+	reassignTo   string
+)
+
+// reassignCmd represents the reassign command
+var reassignCmd = &cobra.Command{
+	Use:   "reassign [stage] [exam] --from A --to B",
+	Short: "Recall unreturned work from one actor and give it to another",
+	Args:  cobra.ExactArgs(2),
+	Long: `Recall the scripts that one actor has not yet returned for a stage,
+and add new bars to them for another actor, e.g. if a marker is off sick
+
+gradex-cli reassign marking 'ELEE09000 a b c exam' --from abc --to def
+
+The scripts are taken out of the first actor's ready and sent directories and
+kept in the exam's 98-reassigned directory. Fresh bars are added for the new
+actor on the same ancestor scripts as before, ready to export as usual. The
+handover is recorded in the pagedata of the new scripts, and in the export
+register. If the first actor sends back a script after it has been reassigned,
+ingest puts it in 98-reassigned/<actor>/late rather than using it.
+
+Stages that can be reassigned are: labelling, marking, moderating, entering, checking`,
+	Run: func(cmd *cobra.Command, args []string) {
+		stage := args[0]
+		exam := args[1]
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		if reassignFrom == "" || reassignTo == "" {
+			fmt.Println("Please say who to reassign the work from and to, with --from and --to")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "reassign").
+			Str("stage", stage).
+			Str("exam", exam).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+		g.SetupExamDirs(exam)
+
+		lockExamOrExit(g, exam)
+
+		if Template != "" {
+			err := g.SetOverlayTemplatePath(Template)
+			if err != nil {
+				fmt.Printf("Overlay not usable because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		files, err := g.ReassignWork(exam, stage, reassignFrom, reassignTo)
+
+		fmt.Printf("Reassigned %d scripts from %s to %s\n", len(files), reassignFrom, reassignTo)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		unlockExam(g, exam)
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reassignCmd)
+	reassignCmd.Flags().StringVar(&reassignFrom, "from", "", "actor whose unreturned work is recalled")
+	reassignCmd.Flags().StringVar(&reassignTo, "to", "", "actor who the work is given to instead")
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// reassignCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// reassignCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
@@ -0,0 +1,140 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+)
+
+// relinkCmd represents the relink command
+var relinkCmd = &cobra.Command{
+	Use:   "relink [input] [output]",
+	Short: "Put the pagedata back into a PDF that has lost it, using the identity codes",
+	Args:  cobra.RangeArgs(1, 2),
+	Long: `Read the QR identity code on each page of a PDF that has no pagedata,
+e.g. a script that was printed, marked on paper and scanned back in, and
+put back the pagedata for each page, from the exam's own copy of that page.
+
+gradex-cli relink scan.pdf scan-relinked.pdf
+
+The output defaults to the input name ending -relinked.pdf. Scans put in
+ingest are relinked automatically by gradex-cli ingest --relink-scans, so
+this is for checking or repairing files by hand. The exam is locked while
+its pagedata is looked up, in the same way as for the other commands.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		inputPath := args[0]
+		outputPath := strings.TrimSuffix(inputPath, filepath.Ext(inputPath)) + "-relinked.pdf"
+		if len(args) > 1 {
+			outputPath = args[1]
+		}
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "relink").
+			Str("file", inputPath).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+
+		exam, err := g.RelinkExam(inputPath)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		lockExamOrExit(g, exam)
+
+		pages, err := g.RelinkPDF(inputPath, outputPath)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		unlockExam(g, exam)
+
+		fmt.Printf("Relinked %d pages into %s\n", pages, outputPath)
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(relinkCmd)
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// relinkCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// relinkCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/timdrysdale/gradex-cli/ingester"
 )
 
+var reconcileThreshold float64
+var contactCrop string
+
 // reportCmd represents the report command
 var reportCmd = &cobra.Command{
 	Use:   "report [what] [exam]",
@@ -37,6 +40,10 @@ var reportCmd = &cobra.Command{
 	Long: `Produce a report and put in the 99-reports folder. 
 Types of report currently implemented:
 marks-provisional (csv format marks from cover sheets in 49-checker-cover)
+reconcile (compare the two markers' marks for a double marked exam, see --threshold)
+sizes (the number of files and pages, and their size, in each stage, compared per page with the first, see gradex-cli raster)
+contact-labelled (pdf contact sheet of the question crops of every page in 10-question-back, see --crop)
+contact-sorted (pdf contact sheet of the question crops of every page in 11-question-split, see --crop)
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		what := strings.ToLower(os.Args[2])
@@ -107,6 +114,30 @@ marks-provisional (csv format marks from cover sheets in 49-checker-cover)
 				fmt.Println(err)
 				os.Exit(1)
 			}
+		case "reconcile":
+			referred, err := g.ReconcileReport(exam, reconcileThreshold)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Printf("%d scripts need a third marker or an agreed mark\n", referred)
+		case "sizes":
+			sizes, err := g.SizeReport(exam)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			for _, size := range sizes {
+				fmt.Printf("%-28s %5d files %6d pages %10d bytes %8d bytes/page %4d%% of %s\n",
+					size.Stage, size.Files, size.Pages, size.Bytes, size.BytesPerPage(), size.PercentOf(sizes[0]), sizes[0].Stage)
+			}
+		case "contact-labelled", "contact-sorted":
+			path, pages, err := g.ContactSheet(exam, strings.TrimPrefix(what, "contact-"), contactCrop)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			fmt.Printf("%d pages on contact sheet %s\n", pages, path)
 
 		}
 	},
@@ -114,6 +145,8 @@ marks-provisional (csv format marks from cover sheets in 49-checker-cover)
 
 func init() {
 	rootCmd.AddCommand(reportCmd)
+	reportCmd.Flags().Float64Var(&reconcileThreshold, "threshold", 0, "largest difference in marks between double markers that is not flagged [default 0]")
+	reportCmd.Flags().StringVar(&contactCrop, "crop", "question", "which crop region to show on a contact sheet [default question]")
 
 	// Here you will define your flags and configuration settings.
 
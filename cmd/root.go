@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/spf13/cobra"
+	"github.com/timdrysdale/gradex-cli/image"
 )
 
 var (
@@ -53,11 +55,22 @@ func init() {
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().BoolVar(&redo, "redo", false, "Force redo of already processed files")
 	rootCmd.PersistentFlags().BoolVarP(&OpticalVanilla, "background-vanilla", "b", true, "Assume vanilla background for optical checkboxes? [default true]")
-	rootCmd.PersistentFlags().IntVarP(&OpticalShrink, "box-shrink", "s", 15, "Number of pixels to shrink optical boxes to avoid false positives from boundaries [default 15]")
+	rootCmd.PersistentFlags().IntVarP(&OpticalShrink, "box-shrink", "s", 15, "Number of pixels (at 175dpi) to shrink optical boxes to avoid false positives from boundaries [default 15]")
 	rootCmd.PersistentFlags().StringVar(&Template, "layout", "layout.svg", "Use this layout [default layout.svg]")
 	rootCmd.PersistentFlags().BoolVar(&noversion, "noversion", false, "don't show version")
 }
 
 func initConfig() {
 
+	var s Specification
+	// load configuration from environment variables GRADEX_CLI_<var>
+	if err := envconfig.Process("gradex_cli", &s); err != nil {
+		return // each command reports this for itself
+	}
+
+	// the rasteriser is chosen per installation, with GRADEX_CLI_RASTERISER
+	if err := image.SetRasteriser(s.Rasteriser); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 }
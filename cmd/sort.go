@@ -83,6 +83,8 @@ gradex-cli sort tdd demo-exam
 		}
 
 		g.EnsureDirectoryStructure()
+
+		lockExamOrExit(g, exam)
 		g.Redo = redo
 
 		err = g.SortQuestions(exam)
@@ -98,6 +100,8 @@ gradex-cli sort tdd demo-exam
 			os.Exit(1)
 		}
 
+		unlockExam(g, exam)
+
 		os.Exit(0)
 	},
 }
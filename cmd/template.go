@@ -0,0 +1,251 @@
+/*
+Copyright © 2020 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/ingester"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+var templateDebug bool
+
+var templateForce bool
+
+var templateExam string
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template [action] [layout] [spread]",
+	Short: "Build, check or preview the layouts used to add bars and covers",
+	Args:  cobra.RangeArgs(1, 3),
+	Long: `Build, check or preview a layout, and the ladders it uses, before any papers are processed with it
+
+gradex-cli template build layout-q5.json
+gradex-cli template build layout-q5.json PGEE00000
+gradex-cli template build layout-q5.json --exam PGEE00000
+gradex-cli template lint
+gradex-cli template lint layout-q5.svg
+gradex-cli template lint layout-q5.svg --exam PGEE00000
+gradex-cli template preview layout-q5.svg mark --debug
+
+Build makes a layout, and a ladder for each of its spreads, from a short JSON
+description, and puts them in the overlay template folder, e.g.
+
+{"id":"layout-q5","spreads":[
+ {"name":"mark","actor":"Marker","questions":["1","2","3"],"boxes":["number","mark"],"pageChecks":true},
+ {"name":"moderate-active","actor":"Moderator","colour":"#ffe0e0"}]}
+
+If an exam is given, spreads without questions get those in the exam's
+questions.csv. Existing files are not overwritten unless --force is used.
+With --exam, the files go in the exam's own template folder instead
+(<exam>/00-config/template), and are only used for that exam.
+
+An exam's template folder can hold its own version of any of the files in
+the overlay (or ingest) template folder, e.g. just a different mark ladder.
+Files it doesn't have come from the shared folder. The pagedata of each page
+records a hash of the template files that were used for it.
+
+Lint checks every spread the stages use has a page size, and an anchor and
+size for the previous image, that the ladder (.svg) and chrome (.jpg) files
+it refers to exist, that textfield names are unique and don't start with the
+name of another textfield (e.g. q1 and q10), that textfields have a tab
+sequence, and that the JSON in textprefill and combobox descriptions can be
+read, as can any template expressions (e.g. {{.Exam}}) in the textprefills,
+and any fonts named for pages or textprefills.
+The layout is in the overlay template folder (default is --layout), and
+the ingest layout, used for flattening, is checked too. With --exam, lint and
+preview use the exam's own template files, where it has them.
+
+Preview renders the spread (or all of them, if no spread is given) over a
+made-up A4 portrait page, and a landscape page, with sample values in the
+textfields and the first option chosen in each combobox, and puts the PDFs
+in $GRADEX_CLI_ROOT/var/preview. With --debug, the textfields (blue),
+comboboxes (green), optical boxes as read by flatten (red), and anchors
+(magenta) are outlined and named.
+
+Actions are: build, lint, preview`,
+	Run: func(cmd *cobra.Command, args []string) {
+		action := args[0]
+
+		layout := Template
+		if len(args) > 1 {
+			layout = args[1]
+		}
+
+		spread := ""
+		if len(args) > 2 {
+			spread = args[2]
+		}
+
+		var s Specification
+		// load configuration from environment variables GRADEX_CLI_<var>
+		if err := envconfig.Process("gradex_cli", &s); err != nil {
+			fmt.Println("Configuration Failed")
+			os.Exit(1)
+		}
+
+		mch := make(chan chmsg.MessageInfo)
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			for {
+				select {
+				case <-closed:
+					break
+				case msg := <-mch:
+					if s.Verbose {
+						fmt.Printf("MC:%s\n", msg.Message)
+					}
+				}
+
+			}
+		}()
+
+		logFile := filepath.Join(s.Root, "var/log/gradex-cli.log")
+		ingester.EnsureDirAll(filepath.Dir(logFile))
+		f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		logger := zerolog.
+			New(f).
+			With().
+			Timestamp().
+			Str("command", "template").
+			Str("action", action).
+			Str("layout", layout).
+			Logger()
+
+		g, err := ingester.New(s.Root, mch, &logger)
+		if err != nil {
+			fmt.Printf("Failed getting New Ingester %v", err)
+			os.Exit(1)
+		}
+
+		g.EnsureDirectoryStructure()
+
+		if action != "build" {
+			err = g.SetOverlayTemplatePath(layout)
+			if err != nil {
+				fmt.Printf("Overlay not usable because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		switch action {
+
+		case "build":
+
+			if len(args) < 2 {
+				fmt.Println("Please give the JSON file describing the layout")
+				os.Exit(1)
+			}
+
+			var paths []string
+
+			if templateExam != "" {
+				paths, err = g.BuildExamTemplate(layout, templateExam, templateForce)
+			} else {
+				// the exam, if any, is in the place of the spread
+				paths, err = g.BuildTemplate(layout, spread, templateForce)
+			}
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			for _, path := range paths {
+				fmt.Println(path)
+			}
+
+		case "lint":
+
+			errors := 0
+
+			for _, lint := range []func(string) ([]parsesvg.LintProblem, error){g.LintOverlayTemplate, g.LintIngestTemplate} {
+
+				problems, err := lint(templateExam)
+				if err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				for _, p := range problems {
+					fmt.Println(p)
+				}
+
+				errors = errors + parsesvg.CountLintErrors(problems)
+			}
+
+			if errors > 0 {
+				fmt.Printf("%d errors\n", errors)
+				os.Exit(1)
+			}
+
+			fmt.Println("OK")
+
+		case "preview":
+
+			g.SetOpticalShrink(OpticalShrink)
+
+			previews, err := g.PreviewTemplate(spread, templateExam, templateDebug)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+
+			for _, preview := range previews {
+				fmt.Println(preview)
+			}
+
+		default:
+			fmt.Printf("Unknown action %s\n Try: [build, lint, preview]\n", action)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+	templateCmd.Flags().BoolVar(&templateDebug, "debug", false, "outline textfields, optical boxes and anchors in previews")
+	templateCmd.Flags().BoolVar(&templateForce, "force", false, "overwrite existing files when building a layout")
+	templateCmd.Flags().StringVar(&templateExam, "exam", "", "use (or build) the exam's own template files")
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// templateCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// templateCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+}
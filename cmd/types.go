@@ -1,6 +1,7 @@
 package cmd
 
 type Specification struct {
-	Root    string `default:"/usr/local/gradex"`
-	Verbose bool   `default:"false"`
+	Root       string `default:"/usr/local/gradex"`
+	Verbose    bool   `default:"false"`
+	Rasteriser string `default:"ghostscript"`
 }
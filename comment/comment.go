@@ -82,6 +82,10 @@ func (c Comments) GetByPage(page int) []Comment {
 }
 
 func DrawMarker(c *creator.Creator, comment Comment) {
+	drawMarker(c, comment, nil)
+}
+
+func drawMarker(c *creator.Creator, comment Comment, font *pdf.PdfFont) {
 
 	width := ((float64(len(comment.Label)) * 2.1) + 1.9) * creator.PPMM
 	r := c.NewRectangle(comment.Pos.X, comment.Pos.Y, width, 5*creator.PPMM)
@@ -89,24 +93,40 @@ func DrawMarker(c *creator.Creator, comment Comment) {
 	r.SetFillColor(creator.ColorYellow)
 	c.Draw(r)
 	p := c.NewParagraph(fmt.Sprintf("[%s]", comment.Label))
+	if font != nil {
+		p.SetFont(font)
+	}
 	p.SetPos(comment.Pos.X, comment.Pos.Y)
 	c.Draw(p)
 
 }
 
 func DrawText(c *creator.Creator, comment Comment, X, Y float64) {
+	drawText(c, comment, X, Y, nil)
+}
+
+func drawText(c *creator.Creator, comment Comment, X, Y float64, font *pdf.PdfFont) {
 
 	p := c.NewParagraph(fmt.Sprintf("[%s] %s", comment.Label, comment.Text)) //label included to space text past the coloured marker (drawn separately)
+	if font != nil {
+		p.SetFont(font)
+	}
 	p.SetPos(X, Y)
 	c.Draw(p)
 
 }
 
 func DrawComment(c *creator.Creator, comment Comment, X, Y float64) {
+	DrawCommentFont(c, comment, X, Y, nil)
+}
+
+// DrawCommentFont draws the comment in the font, e.g. one that has the accented
+// characters in the comment, or the default font (Helvetica) if font is nil
+func DrawCommentFont(c *creator.Creator, comment Comment, X, Y float64, font *pdf.PdfFont) {
 	comment.Pos.Y = c.Height() - comment.Pos.Y
-	DrawText(c, comment, X, Y)
-	DrawMarker(c, comment)
+	drawText(c, comment, X, Y, font)
+	drawMarker(c, comment, font)
 	comment.Pos.X = X
 	comment.Pos.Y = Y
-	DrawMarker(c, comment) // we overwrite the text, but it gives us the colour
+	drawMarker(c, comment, font) // we overwrite the text, but it gives us the colour
 }
@@ -28,6 +28,26 @@ type TextField struct {
 	Value   string
 	Rect    []float64
 	PageDim geo.Dim
+	Kind    string               // FieldText, FieldCheckBox or FieldRadio
+	Options map[string][]float64 // the rect of each option of a radio group
+}
+
+const (
+	FieldText     = "text"
+	FieldCheckBox = "checkbox"
+	FieldRadio    = "radio"
+
+	// the state of a button that isn't ticked or selected
+	ButtonOff = "Off"
+
+	// RadioOptionSeparator joins the name of a radio group to one of its options,
+	// e.g. page-status=bad, to name the box each option is read optically from
+	RadioOptionSeparator = "="
+)
+
+// RadioOptionKey names an option of a radio group, see RadioOptionSeparator
+func RadioOptionKey(group, option string) string {
+	return group + RadioOptionSeparator + option
 }
 
 func ExtractTextFieldsFromPDF(path string) (map[int]map[string]string, error) {
@@ -190,13 +210,7 @@ func mapPdfFieldData(inputPath string) (map[string]string, error) {
 			continue
 		}
 
-		val := ""
-
-		if field.V != nil {
-			val = field.V.String()
-		}
-
-		textfields[fullname] = val
+		textfields[fullname] = fieldValue(field)
 
 	}
 
@@ -244,11 +258,7 @@ func mapTextFields(inputPath string) (map[string]TextField, []geo.Dim, error) {
 			continue
 		}
 
-		val := ""
-
-		if field.V != nil {
-			val = field.V.String()
-		}
+		val := fieldValue(field)
 
 		annots := field.Annotations
 
@@ -261,17 +271,97 @@ func mapTextFields(inputPath string) (map[string]TextField, []geo.Dim, error) {
 			rect, err = annots[0].Rect.(*core.PdfObjectArray).ToFloat64Array()
 		}
 
-		textfields[fullname] = TextField{
+		tf := TextField{
 			Name:  fullname,
 			Value: val,
 			Rect:  rect,
+			Kind:  fieldKind(field),
 		}
 
+		// each button of a radio group is its own annotation
+		if tf.Kind == FieldRadio {
+			tf.Options = make(map[string][]float64)
+			for _, annot := range annots {
+				array, ok := core.GetArray(annot.Rect)
+				if !ok {
+					continue
+				}
+				optionRect, err := array.ToFloat64Array()
+				if err != nil {
+					continue
+				}
+				tf.Options[onState(annot)] = optionRect
+			}
+		}
+
+		textfields[fullname] = tf
+
 	}
 
 	return textfields, pageSizeList, nil
 }
 
+func fieldKind(field *pdf.PdfField) string {
+
+	if button, ok := field.GetContext().(*pdf.PdfFieldButton); ok {
+		switch button.GetType() {
+		case pdf.ButtonTypeCheckbox:
+			return FieldCheckBox
+		case pdf.ButtonTypeRadio:
+			return FieldRadio
+		}
+	}
+
+	return FieldText
+}
+
+// fieldValue is the text in a textfield, or the option chosen in a combobox,
+// or the state of a button, with the off state read as empty, so that an
+// unticked checkbox reads the same as an empty textfield, and a ticked one
+// the same as a textfield that has been typed in
+func fieldValue(field *pdf.PdfField) string {
+
+	if field.V == nil {
+		return ""
+	}
+
+	if fieldKind(field) != FieldText {
+		if state, ok := core.GetName(field.V); ok && string(*state) != ButtonOff {
+			return string(*state)
+		}
+		return ""
+	}
+
+	// text is UTF-16 if it has anything outside PDFDocEncoding, e.g. an accented name
+	if text, ok := core.GetString(field.V); ok && strings.HasPrefix(text.Str(), "\xfe\xff") {
+		return text.Decoded()
+	}
+
+	return field.V.String()
+}
+
+// onState is the name of the appearance of a button when it is selected
+func onState(widget *pdf.PdfAnnotationWidget) string {
+
+	ap, ok := core.GetDict(widget.AP)
+	if !ok {
+		return ""
+	}
+
+	appearances, ok := core.GetDict(ap.Get("N"))
+	if !ok {
+		return ""
+	}
+
+	for _, name := range appearances.Keys() {
+		if string(name) != ButtonOff {
+			return string(name)
+		}
+	}
+
+	return ""
+}
+
 func PrettyPrintStruct(layout interface{}) error {
 
 	json, err := json.MarshalIndent(layout, "", "\t")
@@ -23,6 +23,8 @@ const (
 	TextFieldsLayer   = "textfields"
 	TextPrefillsLayer = "textprefills"
 	ComboBoxesLayer   = "comboboxes"
+	CheckBoxesLayer   = "checkboxes"
+	RadioButtonsLayer = "radiobuttons"
 	PagesLayer        = "pages"
 	ImagesLayer       = "images"
 	Translate         = "translate"
@@ -0,0 +1,106 @@
+package image
+
+import (
+	"fmt"
+	goimage "image"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+)
+
+// Crop regions (e.g. the question number a student writes at the top of the
+// page) come from the layout, see parsesvg.GetCropRegions, so the only one we
+// know about here is the default, for layouts that don't say.
+
+// the question number box, in pixels at RasterDPI, from the top left corner
+var questionRect = goimage.Rect(0, 0, 700, 500)
+
+// QuestionRect is the default question number box, for a page image at dpi
+func QuestionRect(dpi int) goimage.Rectangle {
+	return goimage.Rect(0, 0, questionRect.Dx()*dpi/RasterDPI, questionRect.Dy()*dpi/RasterDPI)
+}
+
+// CropToQuestion saves the top left of a page image, where the question
+// number is, as a JPEG
+func CropToQuestion(inputPath, outputPath string) error {
+	return CropToRects(inputPath, map[string]goimage.Rectangle{outputPath: questionRect})
+}
+
+// CropToRects saves each rectangle of the image (in pixels from its top left
+// corner) as a JPEG, at the path it is keyed by. Rectangles are trimmed to fit
+// the image.
+func CropToRects(inputPath string, crops map[string]goimage.Rectangle) error {
+
+	f, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	im, _, err := goimage.Decode(f)
+	if err != nil {
+		return fmt.Errorf("can't read %s: %s", inputPath, err.Error())
+	}
+
+	for outputPath, rect := range crops {
+
+		bounds := rect.Add(im.Bounds().Min).Intersect(im.Bounds())
+
+		if bounds.Empty() {
+			return fmt.Errorf("%s is too small to crop to %v", inputPath, rect)
+		}
+
+		crop := goimage.NewRGBA(goimage.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(crop, crop.Bounds(), im, bounds.Min, draw.Src)
+
+		err = writeJPEG(outputPath, crop, DefaultRasterSettings())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SplitInHalf saves the left and right halves of a page image as JPEGs, e.g.
+// for two A4 pages scanned side by side on A3, with the same settings as
+// the page was rasterised with
+func SplitInHalf(inputPath, leftPath, rightPath string, rs RasterSettings) error {
+
+	f, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	im, _, err := goimage.Decode(f)
+	if err != nil {
+		return fmt.Errorf("can't read %s: %s", inputPath, err.Error())
+	}
+
+	b := im.Bounds()
+	mid := b.Min.X + b.Dx()/2
+
+	halves := map[string]goimage.Rectangle{
+		leftPath:  goimage.Rect(b.Min.X, b.Min.Y, mid, b.Max.Y),
+		rightPath: goimage.Rect(mid, b.Min.Y, b.Max.X, b.Max.Y),
+	}
+
+	for outputPath, rect := range halves {
+
+		if rect.Empty() {
+			return fmt.Errorf("%s is too small to split", inputPath)
+		}
+
+		half := goimage.NewRGBA(goimage.Rect(0, 0, rect.Dx(), rect.Dy()))
+		draw.Draw(half, half.Bounds(), im, rect.Min, draw.Src)
+
+		err = writeJPEG(outputPath, half, rs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
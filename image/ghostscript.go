@@ -0,0 +1,62 @@
+package image
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// simplified https://github.com/catherinelu/evangelist/blob/master/server.go
+
+type ghostscriptRasteriser struct{}
+
+func (ghostscriptRasteriser) Name() string {
+	return Ghostscript
+}
+
+func ghostscriptCommand() string {
+	if runtime.GOOS == "windows" {
+		return "gswin64c"
+	}
+	return "gs"
+}
+
+func (ghostscriptRasteriser) ConvertPDFToJPEGs(pdfPath string, jpegPath string, outputFile string, rs RasterSettings) error {
+
+	return runGhostscript(pdfPath, rs, fmt.Sprintf("-sOutputFile=%s", outputFile))
+}
+
+func (ghostscriptRasteriser) ConvertPDFPageToJPEG(pdfPath string, page int, outputFile string, rs RasterSettings) error {
+
+	return runGhostscript(pdfPath, rs,
+		fmt.Sprintf("-sOutputFile=%s", outputFile),
+		fmt.Sprintf("-dFirstPage=%d", page),
+		fmt.Sprintf("-dLastPage=%d", page))
+}
+
+// bilevel pages are rendered in grey, and only thresholded when they go
+// back into a PDF, so that optical boxes are still read from a grey image
+func runGhostscript(pdfPath string, rs RasterSettings, options ...string) error {
+
+	device := "jpeg"
+	if rs.IsGrey() {
+		device = "jpeggray"
+	}
+
+	args := []string{"-dNOPAUSE", "-sDEVICE=" + device}
+	args = append(args, options...)
+	args = append(args, fmt.Sprintf("-dJPEGQ=%d", rs.Quality), fmt.Sprintf("-r%d", rs.DPI), "-q", pdfPath, "-c", "quit")
+
+	cmd := exec.Command(ghostscriptCommand(), args...)
+
+	err := cmd.Run()
+	if err != nil {
+		fmt.Printf("gs command failed: %s\n", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Known good command line
+// gs -dNOPAUSE -sDEVICE=jpeg -sOutputFile=edited-%d.jpg -dJPEGQ=95 -r300 -q edited5-covered.pdf -c quit
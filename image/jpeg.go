@@ -0,0 +1,45 @@
+package image
+
+import (
+	"fmt"
+	goimage "image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"os"
+	"strings"
+)
+
+// outputName numbers the output files as gs does, counting the
+// pages written, rather than the pages in the PDF
+func outputName(outputFile string, n int) string {
+	if strings.Contains(outputFile, "%") {
+		return fmt.Sprintf(outputFile, n)
+	}
+	return outputFile
+}
+
+// writeJPEG puts the image on white first, because
+// anything transparent would otherwise come out black
+func writeJPEG(path string, im goimage.Image, rs RasterSettings) error {
+
+	var flat draw.Image = goimage.NewRGBA(im.Bounds())
+	if rs.IsGrey() {
+		flat = goimage.NewGray(im.Bounds())
+	}
+	draw.Draw(flat, flat.Bounds(), &goimage.Uniform{color.White}, goimage.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), im, im.Bounds().Min, draw.Over)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = jpeg.Encode(f, flat, &jpeg.Options{Quality: rs.Quality})
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,182 @@
+package image
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Rasterising turns the pages of a PDF into JPEG images, which is how we
+// flatten a page (and read its optical boxes). There is more than one way to
+// do it, because not every installation can have Ghostscript, so the backend
+// is chosen once per installation (see SetRasteriser), and everything else
+// goes through ConvertPDFToJPEGs and ConvertPDFPageToJPEG as before.
+// The resolution, quality and colour of the images can be changed for each
+// exam and stage, with RasterSettings, which also say whether the images or
+// the pages themselves go into the next stage.
+
+const (
+	Ghostscript = "ghostscript"
+	PureGo      = "go"
+
+	RasterDPI   = 175
+	RasterPPI   = 150
+	JPEGQuality = 90
+
+	Colour  = "colour"
+	Grey    = "grey"
+	Bilevel = "bilevel"
+
+	Raster = "raster"
+	Vector = "vector"
+)
+
+// RasterSettings say how to rasterise pages. DPI is the resolution we render
+// at (and read optical boxes at), while PPI is the highest resolution kept
+// when the images go back into a PDF. Grey and bilevel pages are rendered in
+// greyscale; bilevel pages are then stored at one bit per pixel in the PDF.
+// In vector mode, the pages are still rasterised to read their optical boxes,
+// but the next stage is drawn over the page itself, rather than over the image,
+// so PPI, quality and colour have no effect on the PDF.
+type RasterSettings struct {
+	DPI     int    `json:"dpi,omitempty"`
+	PPI     int    `json:"ppi,omitempty"`
+	Quality int    `json:"quality,omitempty"`
+	Colour  string `json:"colour,omitempty"`
+	Mode    string `json:"mode,omitempty"`
+}
+
+func DefaultRasterSettings() RasterSettings {
+	return RasterSettings{
+		DPI:     RasterDPI,
+		PPI:     RasterPPI,
+		Quality: JPEGQuality,
+		Colour:  Colour,
+		Mode:    Raster,
+	}
+}
+
+// WithDefaults fills in anything not set, from d
+func (rs RasterSettings) WithDefaults(d RasterSettings) RasterSettings {
+	if rs.DPI == 0 {
+		rs.DPI = d.DPI
+	}
+	if rs.PPI == 0 {
+		rs.PPI = d.PPI
+	}
+	if rs.Quality == 0 {
+		rs.Quality = d.Quality
+	}
+	if rs.Colour == "" {
+		rs.Colour = d.Colour
+	}
+	if rs.Mode == "" {
+		rs.Mode = d.Mode
+	}
+	return rs
+}
+
+func (rs RasterSettings) Validate() error {
+	if rs.DPI < 50 || rs.DPI > 600 {
+		return fmt.Errorf("dpi must be from 50 to 600, not %d", rs.DPI)
+	}
+	if rs.PPI < 50 || rs.PPI > 600 {
+		return fmt.Errorf("ppi must be from 50 to 600, not %d", rs.PPI)
+	}
+	if rs.Quality < 1 || rs.Quality > 100 {
+		return fmt.Errorf("quality must be from 1 to 100, not %d", rs.Quality)
+	}
+	switch rs.Colour {
+	case Colour, Grey, Bilevel:
+	default:
+		return fmt.Errorf("colour must be one of %s, %s, %s, not %s", Colour, Grey, Bilevel, rs.Colour)
+	}
+	switch rs.Mode {
+	case Raster, Vector:
+	default:
+		return fmt.Errorf("mode must be %s or %s, not %s", Raster, Vector, rs.Mode)
+	}
+	return nil
+}
+
+func (rs RasterSettings) IsGrey() bool {
+	return rs.Colour == Grey || rs.Colour == Bilevel
+}
+
+func (rs RasterSettings) String() string {
+	return fmt.Sprintf("%s, %d dpi, %d ppi, quality %d, %s", rs.Mode, rs.DPI, rs.PPI, rs.Quality, rs.Colour)
+}
+
+// Rasteriser converts PDF pages to JPEG. The outputFile is a printf pattern
+// for the page number, counting from 1 (e.g. page%04d.jpg), as for gs.
+type Rasteriser interface {
+	Name() string
+	ConvertPDFToJPEGs(pdfPath string, jpegPath string, outputFile string, rs RasterSettings) error
+	ConvertPDFPageToJPEG(pdfPath string, page int, outputFile string, rs RasterSettings) error
+}
+
+var rasterisers = map[string]Rasteriser{
+	Ghostscript: ghostscriptRasteriser{},
+}
+
+var rasteriser Rasteriser = ghostscriptRasteriser{}
+
+func GetRasteriserNames() []string {
+	names := []string{}
+	for name := range rasterisers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func GetRasteriser() Rasteriser {
+	return rasteriser
+}
+
+func NewRasteriser(name string) (Rasteriser, error) {
+
+	r, ok := rasterisers[strings.ToLower(name)]
+
+	if !ok && strings.ToLower(name) == PureGo {
+		return nil, fmt.Errorf("the %s rasteriser is not built in, see the README for building with -tags gorender", PureGo)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("unknown rasteriser %s, must be one of %s", name, strings.Join(GetRasteriserNames(), ", "))
+	}
+
+	return r, nil
+}
+
+func SetRasteriser(name string) error {
+
+	r, err := NewRasteriser(name)
+	if err != nil {
+		return err
+	}
+
+	rasteriser = r
+
+	return nil
+}
+
+func ConvertPDFToJPEGs(pdfPath string, jpegPath string, outputFile string) error {
+	return rasteriser.ConvertPDFToJPEGs(pdfPath, jpegPath, outputFile, DefaultRasterSettings())
+}
+
+// ConvertPDFPageToJPEG converts one page (counting from 1), at the same
+// resolution as ConvertPDFToJPEGs
+func ConvertPDFPageToJPEG(pdfPath string, page int, outputFile string) error {
+	return rasteriser.ConvertPDFPageToJPEG(pdfPath, page, outputFile, DefaultRasterSettings())
+}
+
+func ConvertPDFToJPEGsWithSettings(pdfPath string, jpegPath string, outputFile string, rs RasterSettings) error {
+
+	err := rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	return rasteriser.ConvertPDFToJPEGs(pdfPath, jpegPath, outputFile, rs)
+}
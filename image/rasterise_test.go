@@ -0,0 +1,156 @@
+package image
+
+import (
+	goimage "image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRasteriser(t *testing.T) {
+
+	defer SetRasteriser(GetRasteriser().Name())
+
+	assert.Contains(t, GetRasteriserNames(), Ghostscript)
+
+	for _, name := range GetRasteriserNames() {
+		assert.NoError(t, SetRasteriser(name))
+		assert.Equal(t, name, GetRasteriser().Name())
+	}
+
+	assert.NoError(t, SetRasteriser("Ghostscript"))
+	assert.Equal(t, Ghostscript, GetRasteriser().Name())
+
+	assert.Error(t, SetRasteriser("mutool"))
+	assert.Equal(t, Ghostscript, GetRasteriser().Name())
+}
+
+func TestOutputName(t *testing.T) {
+	assert.Equal(t, "page0003.jpg", outputName("page%04d.jpg", 3))
+	assert.Equal(t, "page.jpg", outputName("page.jpg", 3))
+}
+
+func TestRasterSettings(t *testing.T) {
+
+	assert.NoError(t, DefaultRasterSettings().Validate())
+
+	rs := RasterSettings{DPI: 300, Colour: Bilevel}.WithDefaults(DefaultRasterSettings())
+	assert.Equal(t, RasterSettings{DPI: 300, PPI: RasterPPI, Quality: JPEGQuality, Colour: Bilevel, Mode: Raster}, rs)
+	assert.NoError(t, rs.Validate())
+	assert.True(t, rs.IsGrey())
+	assert.False(t, DefaultRasterSettings().IsGrey())
+
+	for _, bad := range []RasterSettings{
+		{DPI: 10, PPI: 150, Quality: 90, Colour: Colour, Mode: Raster},
+		{DPI: 175, PPI: 0, Quality: 90, Colour: Colour, Mode: Raster},
+		{DPI: 175, PPI: 150, Quality: 101, Colour: Colour, Mode: Raster},
+		{DPI: 175, PPI: 150, Quality: 90, Colour: "sepia", Mode: Raster},
+		{DPI: 175, PPI: 150, Quality: 90, Colour: Colour, Mode: "pdf"},
+	} {
+		assert.Error(t, bad.Validate())
+	}
+}
+
+func TestWriteJPEGGrey(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-grey")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	im := goimage.NewRGBA(goimage.Rect(0, 0, 40, 30))
+	im.Set(5, 5, color.RGBA{200, 0, 0, 255})
+
+	for colour, grey := range map[string]bool{Colour: false, Grey: true, Bilevel: true} {
+
+		outputPath := filepath.Join(dir, colour+".jpg")
+
+		rs := DefaultRasterSettings()
+		rs.Colour = colour
+
+		assert.NoError(t, writeJPEG(outputPath, im, rs))
+
+		f, err := os.Open(outputPath)
+		assert.NoError(t, err)
+		written, _, err := goimage.Decode(f)
+		f.Close()
+		assert.NoError(t, err)
+
+		_, isGrey := written.(*goimage.Gray)
+		assert.Equal(t, grey, isGrey, colour)
+	}
+}
+
+func TestCropToQuestion(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-crop")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, size := range []goimage.Point{{1000, 800}, {300, 200}} {
+
+		im := goimage.NewGray(goimage.Rect(0, 0, size.X, size.Y))
+		im.SetGray(10, 10, color.Gray{0})
+
+		inputPath := filepath.Join(dir, "page.png")
+		outputPath := filepath.Join(dir, "question.jpg")
+
+		f, err := os.Create(inputPath)
+		assert.NoError(t, err)
+		assert.NoError(t, png.Encode(f, im))
+		f.Close()
+
+		assert.NoError(t, CropToQuestion(inputPath, outputPath))
+
+		f, err = os.Open(outputPath)
+		assert.NoError(t, err)
+		crop, _, err := goimage.Decode(f)
+		f.Close()
+		assert.NoError(t, err)
+
+		want := questionRect.Intersect(im.Bounds())
+		assert.Equal(t, want.Dx(), crop.Bounds().Dx())
+		assert.Equal(t, want.Dy(), crop.Bounds().Dy())
+	}
+}
+
+func TestCropToRects(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-crop")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	im := goimage.NewGray(goimage.Rect(0, 0, 400, 300))
+
+	inputPath := filepath.Join(dir, "page.png")
+
+	f, err := os.Create(inputPath)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(f, im))
+	f.Close()
+
+	crops := map[string]goimage.Rectangle{
+		filepath.Join(dir, "a.jpg"): goimage.Rect(10, 20, 110, 70),
+		filepath.Join(dir, "b.jpg"): goimage.Rect(350, 250, 450, 350), //trimmed to fit
+	}
+
+	assert.NoError(t, CropToRects(inputPath, crops))
+
+	for path, want := range map[string]goimage.Point{"a.jpg": {100, 50}, "b.jpg": {50, 50}} {
+		f, err := os.Open(filepath.Join(dir, path))
+		assert.NoError(t, err)
+		crop, _, err := goimage.Decode(f)
+		f.Close()
+		assert.NoError(t, err)
+		assert.Equal(t, want, crop.Bounds().Size())
+	}
+
+	assert.Error(t, CropToRects(inputPath, map[string]goimage.Rectangle{filepath.Join(dir, "c.jpg"): goimage.Rect(500, 500, 600, 600)}))
+
+	assert.Equal(t, questionRect, QuestionRect(RasterDPI))
+	assert.Equal(t, goimage.Rect(0, 0, 1400, 1000), QuestionRect(2*RasterDPI))
+}
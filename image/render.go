@@ -0,0 +1,110 @@
+//go:build gorender
+// +build gorender
+
+package image
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	pdf "github.com/timdrysdale/unipdf/v3/model"
+	"github.com/timdrysdale/unipdf/v3/render"
+)
+
+// goRasteriser renders pages with unipdf, so it needs no external programs.
+// Pages come out at the same size as from Ghostscript, so layouts and optical
+// boxes line up the same way with either backend. It needs the render package,
+// which is only in unipdf v3.10.0 and later, so it is left out unless built
+// with -tags gorender (see the README), and an older unipdf still builds.
+
+func init() {
+	rasterisers[PureGo] = goRasteriser{}
+}
+
+type goRasteriser struct{}
+
+func (goRasteriser) Name() string {
+	return PureGo
+}
+
+func (goRasteriser) ConvertPDFToJPEGs(pdfPath string, jpegPath string, outputFile string, rs RasterSettings) error {
+	return renderPDFPages(pdfPath, outputFile, 0, rs)
+}
+
+func (goRasteriser) ConvertPDFPageToJPEG(pdfPath string, page int, outputFile string, rs RasterSettings) error {
+	return renderPDFPages(pdfPath, outputFile, page, rs)
+}
+
+// renderPDFPages renders every page, or just one if only is not zero
+func renderPDFPages(pdfPath, outputFile string, only int, rs RasterSettings) error {
+
+	f, err := os.Open(pdfPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	pdfReader, err := pdf.NewPdfReader(f)
+	if err != nil {
+		return err
+	}
+
+	isEncrypted, err := pdfReader.IsEncrypted()
+	if err != nil {
+		return err
+	}
+
+	if isEncrypted {
+		_, err = pdfReader.Decrypt([]byte(""))
+		if err != nil {
+			return err
+		}
+	}
+
+	numPages, err := pdfReader.GetNumPages()
+	if err != nil {
+		return err
+	}
+
+	if only > numPages {
+		return fmt.Errorf("%s has %d pages, so there is no page %d", pdfPath, numPages, only)
+	}
+
+	device := render.NewImageDevice()
+
+	outputNumber := 0
+
+	for i := 1; i <= numPages; i++ {
+
+		if only != 0 && i != only {
+			continue
+		}
+
+		page, err := pdfReader.GetPage(i)
+		if err != nil {
+			return err
+		}
+
+		mbox, err := page.GetMediaBox()
+		if err != nil {
+			return err
+		}
+
+		device.OutputWidth = int(math.Round((mbox.Urx - mbox.Llx) * float64(rs.DPI) / 72))
+
+		im, err := device.Render(page)
+		if err != nil {
+			return fmt.Errorf("can't render page %d of %s: %s", i, pdfPath, err.Error())
+		}
+
+		outputNumber++
+
+		err = writeJPEG(outputName(outputFile, outputNumber), im, rs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+//go:build gorender
+// +build gorender
+
+package image
+
+import (
+	goimage "image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPureGoRasteriser(t *testing.T) {
+	assert.Equal(t, []string{Ghostscript, PureGo}, GetRasteriserNames())
+}
+
+// both backends should make pages of the same size that look the same
+func TestRasterisersAgree(t *testing.T) {
+
+	if _, err := exec.LookPath(ghostscriptCommand()); err != nil {
+		t.Skip("ghostscript not installed")
+	}
+
+	dir, err := ioutil.TempDir("", "gradex-rasterise")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	images := make(map[string]goimage.Image)
+
+	for _, name := range GetRasteriserNames() {
+
+		r, err := NewRasteriser(name)
+		assert.NoError(t, err)
+
+		outputPath := filepath.Join(dir, name+".jpg")
+
+		err = r.ConvertPDFPageToJPEG("./test/test.pdf", 1, outputPath, DefaultRasterSettings())
+		assert.NoError(t, err)
+
+		f, err := os.Open(outputPath)
+		assert.NoError(t, err)
+		im, _, err := goimage.Decode(f)
+		f.Close()
+		assert.NoError(t, err)
+
+		images[name] = im
+	}
+
+	gs, pure := images[Ghostscript], images[PureGo]
+
+	assert.InDelta(t, gs.Bounds().Dx(), pure.Bounds().Dx(), 1)
+	assert.InDelta(t, gs.Bounds().Dy(), pure.Bounds().Dy(), 1)
+
+	assert.True(t, meanDifference(gs, pure) < 0.02)
+}
+
+// meanDifference is the mean absolute difference in grey level, from 0 to 1
+func meanDifference(a, b goimage.Image) float64 {
+
+	r := a.Bounds().Intersect(b.Bounds())
+
+	total := 0.0
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			ga := color.GrayModel.Convert(a.At(x, y)).(color.Gray).Y
+			gb := color.GrayModel.Convert(b.At(x, y)).(color.Gray).Y
+			if ga > gb {
+				total += float64(ga - gb)
+			} else {
+				total += float64(gb - ga)
+			}
+		}
+	}
+
+	return total / 255 / float64(r.Dx()*r.Dy())
+}
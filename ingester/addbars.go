@@ -3,6 +3,7 @@ package ingester
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -147,7 +148,22 @@ func (g *Ingester) AddMarkBar(exam string, marker string) error {
 		PathDecoration: g.GetNamedTaskDecoration(marking, marker),
 	}
 
-	err := g.OverlayPapers(oc, &logger)
+	// if the scripts have been allocated across markers, only
+	// give this marker the scripts that are theirs
+	allocated, err := g.GetAllocatedFiles(exam, marker)
+
+	switch {
+	case err == nil && len(allocated) == 0:
+		logger.Error().Str("marker", marker).Str("exam", exam).Msg("No scripts allocated to this marker")
+		return fmt.Errorf("no scripts allocated to %s in %s", marker, g.AllocationRegister(exam))
+	case err == nil:
+		oc.OnlyFiles = allocated
+	case !os.IsNotExist(err):
+		logger.Error().Str("error", err.Error()).Msg("Could not read allocation register")
+		return err
+	}
+
+	err = g.OverlayPapers(oc, &logger)
 
 	if err == nil {
 		cm.Send(fmt.Sprintf("Finished Processing markbar UUID=%s\n", procDetail.UUID))
@@ -0,0 +1,314 @@
+package ingester
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocarina/gocsv"
+)
+
+// Allocation of anonymous scripts across several markers.
+// Scripts are shuffled with a fixed seed, then handed out one at a time
+// to whichever marker has the lowest weighted load, so that running the
+// allocation again gives the same result. The register in 00-config
+// records who got what, and AddMarkBar only gives a marker their own scripts
+// once a register exists. Scripts already in the register keep their marker,
+// so late submissions can be allocated by running the command again.
+
+type AllocationMarker struct {
+	Name   string
+	Weight float64
+	Cap    int //max number of scripts, 0 for no limit
+}
+
+type Allocation struct {
+	File   string `csv:"file"`
+	Marker string `csv:"marker"`
+	Pages  int    `csv:"pages"`
+	Seed   int64  `csv:"seed"`
+	When   string `csv:"when"`
+}
+
+type allocationItem struct {
+	file string
+	size int
+}
+
+// ParseAllocationMarkers reads markers in the form name[:weight[:cap]]
+// e.g. abc, abc:2, abc:1:30, abc::30
+func ParseAllocationMarkers(specs []string) ([]AllocationMarker, error) {
+
+	markers := []AllocationMarker{}
+
+	seen := make(map[string]bool)
+
+	for _, spec := range specs {
+
+		tokens := strings.Split(spec, ":")
+
+		if len(tokens) > 3 || tokens[0] == "" {
+			return markers, fmt.Errorf("can't understand marker %s, want name[:weight[:cap]]", spec)
+		}
+
+		m := AllocationMarker{
+			Name:   tokens[0],
+			Weight: 1,
+		}
+
+		if len(tokens) > 1 && tokens[1] != "" {
+			w, err := strconv.ParseFloat(tokens[1], 64)
+			if err != nil || w <= 0 {
+				return markers, fmt.Errorf("marker %s needs a positive weight", spec)
+			}
+			m.Weight = w
+		}
+
+		if len(tokens) > 2 && tokens[2] != "" {
+			c, err := strconv.Atoi(tokens[2])
+			if err != nil || c < 0 {
+				return markers, fmt.Errorf("marker %s needs a cap that is a whole number", spec)
+			}
+			m.Cap = c
+		}
+
+		short := GetShortActorName(m.Name)
+		if seen[short] {
+			return markers, fmt.Errorf("marker %s appears twice (names are shortened to %s)", spec, short)
+		}
+		seen[short] = true
+
+		markers = append(markers, m)
+	}
+
+	return markers, nil
+}
+
+func (g *Ingester) AllocationRegister(exam string) string {
+	return filepath.Join(g.GetExamDir(exam, config), "allocation-marking.csv")
+}
+
+func (g *Ingester) GetAllocation(exam string) ([]Allocation, error) {
+
+	allocations := []Allocation{}
+
+	f, err := os.Open(g.AllocationRegister(exam))
+	if err != nil {
+		return allocations, err
+	}
+	defer f.Close()
+
+	err = gocsv.UnmarshalFile(f, &allocations)
+
+	return allocations, err
+}
+
+// GetAllocatedFiles returns the base names of the scripts allocated to the marker,
+// or an os.IsNotExist error if there is no allocation for this exam
+func (g *Ingester) GetAllocatedFiles(exam, marker string) (map[string]bool, error) {
+
+	files := make(map[string]bool)
+
+	allocations, err := g.GetAllocation(exam)
+	if err != nil {
+		return files, err
+	}
+
+	for _, a := range allocations {
+		if GetShortActorName(a.Marker) == GetShortActorName(marker) {
+			files[a.File] = true
+		}
+	}
+
+	return files, nil
+}
+
+func (g *Ingester) AllocateMarking(exam string, markers []AllocationMarker, byPages bool, seed int64) ([]Allocation, error) {
+
+	logger := g.logger.With().Str("process", "allocate-marking").Str("exam", exam).Logger()
+
+	if len(markers) < 1 {
+		return []Allocation{}, fmt.Errorf("no markers to allocate scripts to")
+	}
+
+	allocations, err := g.GetAllocation(exam)
+	if err != nil && !os.IsNotExist(err) {
+		logger.Error().Str("error", err.Error()).Msg("Could not read existing allocation register")
+		return allocations, err
+	}
+
+	already := make(map[string]bool)
+	load := make(map[string]int)
+	count := make(map[string]int)
+
+	for _, a := range allocations {
+		if a.Marker == "" {
+			continue // was unallocated last time, so try again
+		}
+		already[a.File] = true
+		short := GetShortActorName(a.Marker)
+		count[short]++
+		if byPages {
+			load[short] += a.Pages
+		} else {
+			load[short]++
+		}
+	}
+
+	paths, err := g.GetFileList(g.GetExamDir(exam, anonPapers))
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not get list of anonymous papers")
+		return allocations, err
+	}
+
+	items := []allocationItem{}
+	pages := make(map[string]int)
+
+	for _, path := range paths {
+
+		if !IsPDF(path) {
+			continue
+		}
+
+		file := filepath.Base(path)
+
+		if already[file] {
+			continue
+		}
+
+		n, err := CountPages(path)
+		if err != nil {
+			logger.Error().Str("file", path).Str("error", err.Error()).Msg("Could not count pages, skipping")
+			continue
+		}
+
+		pages[file] = n
+
+		size := 1
+		if byPages {
+			size = n
+		}
+
+		items = append(items, allocationItem{file: file, size: size})
+	}
+
+	result := allocate(items, markers, load, count, seed)
+
+	// keep the new entries in a predictable order in the register
+	sort.Slice(items, func(i, j int) bool { return items[i].file < items[j].file })
+
+	kept := []Allocation{}
+	for _, a := range allocations {
+		if a.Marker != "" {
+			kept = append(kept, a)
+		}
+	}
+	allocations = kept
+
+	when := time.Now().Format(time.RFC3339)
+
+	for _, item := range items {
+
+		a := Allocation{
+			File:   item.file,
+			Marker: result[item.file],
+			Pages:  pages[item.file],
+			Seed:   seed,
+			When:   when,
+		}
+
+		if a.Marker == "" {
+			logger.Warn().Str("file", a.File).Msg("Not allocated because all markers are at their cap")
+		}
+
+		allocations = append(allocations, a)
+	}
+
+	f, err := os.Create(g.AllocationRegister(exam))
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not write allocation register")
+		return allocations, err
+	}
+	defer f.Close()
+
+	err = gocsv.MarshalFile(&allocations, f)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not write allocation register")
+		return allocations, err
+	}
+
+	logger.Info().
+		Int("new", len(items)).
+		Int("total", len(allocations)).
+		Int64("seed", seed).
+		Bool("by-pages", byPages).
+		Msg("Wrote allocation register")
+
+	return allocations, nil
+}
+
+// allocate gives each item to the marker with the lowest weighted load after
+// taking it, skipping markers at their cap. Items are shuffled with the seed
+// so that ties are broken fairly but repeatably, then taken largest first so
+// that page counts even out. Unallocated items are missing from the map.
+func allocate(items []allocationItem, markers []AllocationMarker, load, count map[string]int, seed int64) map[string]string {
+
+	result := make(map[string]string)
+
+	ordered := make([]allocationItem, len(items))
+	copy(ordered, items)
+
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].file < ordered[j].file })
+
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(ordered), func(i, j int) { ordered[i], ordered[j] = ordered[j], ordered[i] })
+
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].size > ordered[j].size })
+
+	thisLoad := make(map[string]int)
+	thisCount := make(map[string]int)
+
+	for _, m := range markers {
+		short := GetShortActorName(m.Name)
+		thisLoad[short] = load[short]
+		thisCount[short] = count[short]
+	}
+
+	for _, item := range ordered {
+
+		best := -1
+		bestScore := 0.0
+
+		for i, m := range markers {
+
+			short := GetShortActorName(m.Name)
+
+			if m.Cap > 0 && thisCount[short] >= m.Cap {
+				continue
+			}
+
+			score := float64(thisLoad[short]+item.size) / m.Weight
+
+			if best < 0 || score < bestScore {
+				best = i
+				bestScore = score
+			}
+		}
+
+		if best < 0 {
+			continue
+		}
+
+		short := GetShortActorName(markers[best].Name)
+		thisLoad[short] += item.size
+		thisCount[short]++
+		result[item.file] = markers[best].Name
+	}
+
+	return result
+}
@@ -0,0 +1,108 @@
+package ingester
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAllocationMarkers(t *testing.T) {
+
+	markers, err := ParseAllocationMarkers([]string{"abc", "def:2", "ghi::30", "jkl:0.5:10"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []AllocationMarker{
+		{Name: "abc", Weight: 1},
+		{Name: "def", Weight: 2},
+		{Name: "ghi", Weight: 1, Cap: 30},
+		{Name: "jkl", Weight: 0.5, Cap: 10},
+	}, markers)
+
+	for _, bad := range [][]string{{"abc:0"}, {"abc:x"}, {"abc:1:-1"}, {":1"}, {"abc:1:2:3"}, {"abcd", "abce"}} {
+		_, err = ParseAllocationMarkers(bad)
+		assert.Error(t, err)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+
+	items := []allocationItem{}
+
+	for i := 0; i < 30; i++ {
+		items = append(items, allocationItem{file: fmt.Sprintf("B%03d.pdf", i), size: 1})
+	}
+
+	markers := []AllocationMarker{
+		{Name: "abc", Weight: 1},
+		{Name: "def", Weight: 2},
+	}
+
+	result := allocate(items, markers, map[string]int{}, map[string]int{}, 1)
+
+	count := make(map[string]int)
+	for _, marker := range result {
+		count[marker]++
+	}
+
+	assert.Equal(t, 10, count["abc"])
+	assert.Equal(t, 20, count["def"])
+
+	// same seed, same answer
+	assert.Equal(t, result, allocate(items, markers, map[string]int{}, map[string]int{}, 1))
+
+	// different seed, (almost certainly) different answer, same balance
+	other := allocate(items, markers, map[string]int{}, map[string]int{}, 2)
+	assert.NotEqual(t, result, other)
+
+	// caps leave scripts unallocated
+	markers = []AllocationMarker{
+		{Name: "abc", Weight: 1, Cap: 5},
+		{Name: "def", Weight: 1, Cap: 5},
+	}
+
+	result = allocate(items, markers, map[string]int{}, map[string]int{}, 1)
+	assert.Equal(t, 10, len(result))
+
+	// existing load is taken into account
+	markers = []AllocationMarker{
+		{Name: "abc", Weight: 1},
+		{Name: "def", Weight: 1},
+	}
+
+	result = allocate(items, markers, map[string]int{"ABC": 10}, map[string]int{"ABC": 10}, 1)
+
+	count = make(map[string]int)
+	for _, marker := range result {
+		count[marker]++
+	}
+
+	assert.Equal(t, 10, count["abc"])
+	assert.Equal(t, 20, count["def"])
+}
+
+func TestAllocateByPages(t *testing.T) {
+
+	items := []allocationItem{
+		{file: "a.pdf", size: 10},
+		{file: "b.pdf", size: 2},
+		{file: "c.pdf", size: 3},
+		{file: "d.pdf", size: 5},
+	}
+
+	markers := []AllocationMarker{
+		{Name: "abc", Weight: 1},
+		{Name: "def", Weight: 1},
+	}
+
+	result := allocate(items, markers, map[string]int{}, map[string]int{}, 1)
+
+	load := make(map[string]int)
+	for _, item := range items {
+		load[result[item.file]] += item.size
+	}
+
+	assert.Equal(t, "abc", result["a.pdf"])
+	assert.Equal(t, 10, load["abc"])
+	assert.Equal(t, 10, load["def"])
+}
@@ -0,0 +1,46 @@
+package ingester
+
+import (
+	"sort"
+
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+// Checkboxes go in the pagedata like textfields, empty if not ticked. Radio
+// groups go in as one field per option, e.g. tf-page-status=page-bad, which
+// is only filled if that option is selected, so that the checks on textfield
+// names (page-ok, page-bad etc) work for options too, and each option can be
+// compared with the optical box that was read from it (which has the same key).
+
+// fieldData returns the fields to put in the pagedata for
+// a textfield, combobox or button, without the textfield prefix
+func fieldData(key string, tf extract.TextField) []pagedata.Field {
+
+	if tf.Kind != extract.FieldRadio {
+		return []pagedata.Field{{Key: key, Value: tf.Value}}
+	}
+
+	options := []string{}
+	for option := range tf.Options {
+		options = append(options, option)
+	}
+	sort.Strings(options)
+
+	fields := []pagedata.Field{}
+
+	for _, option := range options {
+
+		value := ""
+		if option == tf.Value {
+			value = option
+		}
+
+		fields = append(fields, pagedata.Field{
+			Key:   extract.RadioOptionKey(key, option),
+			Value: value,
+		})
+	}
+
+	return fields
+}
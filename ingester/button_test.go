@@ -0,0 +1,102 @@
+package ingester
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+func TestFieldData(t *testing.T) {
+
+	assert.Equal(t,
+		[]pagedata.Field{{Key: "q1-mark", Value: "7"}},
+		fieldData("q1-mark", extract.TextField{Value: "7", Kind: extract.FieldText}))
+
+	assert.Equal(t,
+		[]pagedata.Field{{Key: "q1-attempted", Value: ""}},
+		fieldData("q1-attempted", extract.TextField{Kind: extract.FieldCheckBox}))
+
+	radio := extract.TextField{
+		Kind:  extract.FieldRadio,
+		Value: "bad",
+		Options: map[string][]float64{
+			"ok":  {0, 0, 10, 10},
+			"bad": {0, 20, 10, 30},
+		},
+	}
+
+	assert.Equal(t,
+		[]pagedata.Field{{Key: "page=bad", Value: "bad"}, {Key: "page=ok", Value: ""}},
+		fieldData("page", radio))
+}
+
+func TestSummariseButtons(t *testing.T) {
+
+	status := func(data []pagedata.Field) string {
+		return summarisePage(pagedata.PageData{Current: pagedata.PageDetail{Data: data}}).Status
+	}
+
+	assert.Equal(t, statusBad, status([]pagedata.Field{
+		{Key: "tf-page=ok", Value: ""},
+		{Key: "tf-page=bad", Value: "bad"},
+	}))
+
+	assert.Equal(t, statusSeen, status([]pagedata.Field{
+		{Key: "tf-page=ok", Value: "ok"},
+		{Key: "tf-page=bad", Value: ""},
+	}))
+
+	assert.Equal(t, statusMarked, status([]pagedata.Field{
+		{Key: "tf-q1-attempted", Value: "Yes"},
+		{Key: "tf-page-ok", Value: ""},
+	}))
+}
+
+func TestSelectForEnterButtons(t *testing.T) {
+
+	onScreen := "on-screen.pdf"
+	onPaper := "on-paper.pdf"
+
+	pdfFiles := map[string]bool{
+		onScreen: false,
+		onPaper:  false,
+	}
+
+	pdByFile := map[string]map[int]pagedata.PageData{
+		onScreen: {
+			1: pagedata.PageData{
+				Current: pagedata.PageDetail{
+					Data: []pagedata.Field{
+						{Key: "tf-q1-attempted", Value: "Yes"},
+						{Key: "tf-q1-attempted-optical", Value: markDetected},
+						{Key: "tf-page=ok", Value: "ok"},
+						{Key: "tf-page=ok-optical", Value: markDetected},
+						{Key: "tf-page=bad", Value: ""},
+						{Key: "tf-page=bad-optical", Value: ""},
+					},
+				},
+			},
+		},
+		onPaper: {
+			1: pagedata.PageData{
+				Current: pagedata.PageDetail{
+					Data: []pagedata.Field{
+						{Key: "tf-q1-attempted", Value: ""},
+						{Key: "tf-q1-attempted-optical", Value: ""},
+						{Key: "tf-page=ok", Value: ""},
+						{Key: "tf-page=ok-optical", Value: markDetected},
+						{Key: "tf-page=bad", Value: ""},
+						{Key: "tf-page=bad-optical", Value: ""},
+					},
+				},
+			},
+		},
+	}
+
+	selectByOpticalOnly(&pdfFiles, pdByFile)
+
+	assert.False(t, pdfFiles[onScreen])
+	assert.True(t, pdfFiles[onPaper])
+}
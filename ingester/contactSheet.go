@@ -0,0 +1,249 @@
+package ingester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+// A contact sheet puts the crops of every page in a stage side by side, with
+// the labels given to each page, so that labelling (and the sort that follows
+// from it) can be checked for a whole exam at a glance.
+
+const (
+	contactSheetColumns  = 2
+	contactSheetRows     = 4
+	contactSheetMargin   = 30.0
+	contactSheetGap      = 15.0
+	contactSheetCaption  = 24.0
+	contactSheetFontSize = 8.0
+)
+
+// the stages a contact sheet can be made for
+var contactSheetStages = map[string]string{
+	"labelled": questionBack,
+	"sorted":   questionSplit,
+}
+
+type contactSheetEntry struct {
+	CropPath string
+	File     string
+	Page     int
+	Caption  string
+}
+
+func ContactSheetStages() []string {
+	stages := []string{}
+	for stage := range contactSheetStages {
+		stages = append(stages, stage)
+	}
+	sort.Strings(stages)
+	return stages
+}
+
+// ContactSheet makes a contact sheet of the crops called name, for the pages
+// in the stage (see ContactSheetStages), and returns its path and the number
+// of pages it shows
+func (g *Ingester) ContactSheet(exam, stage, name string) (string, int, error) {
+
+	logger := g.logger.With().Str("process", "contact-sheet").Logger()
+
+	dir, ok := contactSheetStages[stage]
+	if !ok {
+		return "", 0, fmt.Errorf("can't make a contact sheet for %s, must be one of %s", stage, strings.Join(ContactSheetStages(), ", "))
+	}
+
+	entries, err := g.getContactSheetEntries(exam, dir, name)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if len(entries) == 0 {
+		return "", 0, fmt.Errorf("no %s crops found for the pages in %s", name, dir)
+	}
+
+	reportBase := fmt.Sprintf("Contact-%s-%s-%s-%d.pdf", stage, name, shortenAssignment(exam), time.Now().Unix())
+	reportPath := filepath.Join(g.GetExamDir(exam, reports), reportBase)
+
+	err = writeContactSheet(entries, reportPath)
+
+	logger.Info().
+		Str("report", reportPath).
+		Str("stage", stage).
+		Str("crop", name).
+		Int("pages", len(entries)).
+		Msg("Wrote contact sheet")
+
+	return reportPath, len(entries), err
+}
+
+func (g *Ingester) getContactSheetEntries(exam, dir, name string) ([]contactSheetEntry, error) {
+
+	entries := []contactSheetEntry{}
+
+	stageDir := g.GetExamDir(exam, dir)
+
+	files, err := g.GetFileList(stageDir)
+	if err != nil {
+		return entries, err
+	}
+
+	for _, file := range files {
+
+		if !IsPDF(file) {
+			continue
+		}
+
+		pdm, err := pagedata.UnMarshalAllFromFile(file)
+		if err != nil {
+			g.logger.Error().
+				Str("file", file).
+				Str("error", err.Error()).
+				Msg("Error getting pagedata for contact sheet")
+			continue
+		}
+
+		tfm, err := extract.ExtractTextFieldsFromPDF(file)
+		if err != nil {
+			tfm = make(map[int]map[string]string)
+		}
+
+		rel, err := filepath.Rel(stageDir, file)
+		if err != nil {
+			rel = filepath.Base(file)
+		}
+
+		for page, pd := range pdm {
+
+			cropPath, ok := g.findCrop(exam, name, pd)
+			if !ok {
+				continue
+			}
+
+			caption := fmt.Sprintf("%s p%d/%d %s", pd.Current.Item.Who, pd.Current.Own.Number, pd.Current.Own.Of, questionLabels(tfm[page]))
+
+			if dir == questionSplit {
+				caption = rel + " " + caption
+			}
+
+			entries = append(entries, contactSheetEntry{
+				CropPath: cropPath,
+				File:     rel,
+				Page:     page,
+				Caption:  caption,
+			})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].File != entries[j].File {
+			return entries[i].File < entries[j].File
+		}
+		return entries[i].Page < entries[j].Page
+	})
+
+	return entries, nil
+}
+
+// findCrop looks for a crop of this page, or of the pages it was made from
+func (g *Ingester) findCrop(exam, name string, pd pagedata.PageData) (string, bool) {
+
+	uuids := []string{pd.Current.UUID}
+
+	for i := len(pd.Previous) - 1; i >= 0; i-- {
+		uuids = append(uuids, pd.Previous[i].UUID)
+	}
+
+	for _, uuid := range uuids {
+
+		if uuid == "" {
+			continue
+		}
+
+		path := g.CropPath(exam, name, uuid)
+
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// questionLabels lists the question textfields that have been filled in
+func questionLabels(fields map[string]string) string {
+
+	labels := []string{}
+
+	for k, v := range fields {
+		if strings.HasPrefix(k, "question-") && v != "" {
+			labels = append(labels, fmt.Sprintf("%s=%s", strings.TrimPrefix(k, "question-"), v))
+		}
+	}
+
+	sort.Strings(labels)
+
+	return strings.Join(labels, " ")
+}
+
+func writeContactSheet(entries []contactSheetEntry, outputPath string) error {
+
+	c := creator.New()
+	c.SetPageSize(creator.PageSizeA4)
+	c.SetPageMargins(0, 0, 0, 0)
+
+	cellWidth := (c.Width() - 2*contactSheetMargin - (contactSheetColumns-1)*contactSheetGap) / contactSheetColumns
+	cellHeight := (c.Height() - 2*contactSheetMargin - (contactSheetRows-1)*contactSheetGap) / contactSheetRows
+	imageHeight := cellHeight - contactSheetCaption
+
+	perPage := contactSheetColumns * contactSheetRows
+
+	for i, entry := range entries {
+
+		if i%perPage == 0 {
+			c.NewPage()
+		}
+
+		col := (i % perPage) % contactSheetColumns
+		row := (i % perPage) / contactSheetColumns
+
+		x := contactSheetMargin + float64(col)*(cellWidth+contactSheetGap)
+		y := contactSheetMargin + float64(row)*(cellHeight+contactSheetGap)
+
+		img, err := c.NewImageFromFile(entry.CropPath)
+		if err != nil {
+			return err
+		}
+
+		img.ScaleToWidth(cellWidth)
+		if img.Height() > imageHeight {
+			img.ScaleToHeight(imageHeight)
+		}
+
+		img.SetPos(x, y)
+
+		err = c.Draw(img)
+		if err != nil {
+			return err
+		}
+
+		p := c.NewParagraph(entry.Caption)
+		p.SetFontSize(contactSheetFontSize)
+		p.SetWidth(cellWidth)
+		p.SetPos(x, y+imageHeight+2)
+
+		err = c.Draw(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.WriteToFile(outputPath)
+}
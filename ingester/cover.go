@@ -27,6 +27,8 @@ func (g *Ingester) CoverPage(cp CoverPageCommand, logger *zerolog.Logger) error
 	// sum up all the questions
 	// make sure only questions in questions conf are included in the cover page
 
+	cp.TemplatePath, cp.TemplateDirs = g.examTemplate(cp.ExamName, cp.TemplatePath)
+
 	files, err := g.GetFileList(cp.FromPath)
 
 	if err != nil {
@@ -265,6 +267,7 @@ func DoOneCoverPage(ct CoverPageTask, logger *zerolog.Logger) error {
 		PdfOutputPath:         pageFilename,
 		PageData:              thisPageData,
 		TemplatePathsRelative: true,
+		TemplateDirs:          cp.TemplateDirs,
 		Prefills:              Prefills,
 	}
 
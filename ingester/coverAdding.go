@@ -472,7 +472,8 @@ func getQMap(pageDetails []pagedata.PageDetail) map[string]string {
 
 		pqm := make(map[string]Q) //qnumber is string format
 
-		for _, item := range detail.Data {
+		// use handwriting we read confidently, where nothing was typed
+		for _, item := range fillFromOpticalReadings(detail.Data) {
 
 			// piece together the elements in a Q struct
 			// one by one as we find the textfields
@@ -0,0 +1,65 @@
+package ingester
+
+import (
+	goimage "image"
+	"path/filepath"
+
+	"github.com/timdrysdale/gradex-cli/image"
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+// Parts of each page, such as the question number the student wrote at the
+// top, are cropped out as the page is overlaid, if the layout has crop regions
+// for the spread (see parsesvg.GetCropRegions). Labelling always crops the
+// question number, at the top left of the page, if the layout doesn't say where
+// it is. Crops are named by the UUID of the page they were cut from, so that
+// they can be found again from the pagedata, e.g. for a contact sheet.
+
+const defaultCropName = "question"
+
+func (g *Ingester) CropPath(exam, name, uuid string) string {
+	return filepath.Join(g.GetExamDir(exam, questionImages), name, uuid+".jpg")
+}
+
+// cropPage saves the crop regions of the page image, or just the default
+// crop if there are none and useDefault is true
+func (g *Ingester) cropPage(exam string, regions parsesvg.CropRegions, useDefault bool, dpi int, imagePath, uuid string) error {
+
+	if len(regions.Regions) == 0 && !useDefault {
+		return nil
+	}
+
+	rects := map[string]goimage.Rectangle{
+		defaultCropName: image.QuestionRect(dpi),
+	}
+
+	if len(regions.Regions) > 0 {
+
+		widthPx, heightPx, err := optical.GetImageDimension(imagePath)
+		if err != nil {
+			return err
+		}
+
+		rects, err = regions.ImageRectangles(widthPx, heightPx)
+		if err != nil {
+			return err
+		}
+	}
+
+	crops := make(map[string]goimage.Rectangle)
+
+	for name, rect := range rects {
+
+		path := g.CropPath(exam, name, uuid)
+
+		err := g.EnsureDirAll(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		crops[path] = rect
+	}
+
+	return image.CropToRects(imagePath, crops)
+}
@@ -0,0 +1,312 @@
+package ingester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fvbommel/sortorder"
+	"github.com/timdrysdale/gradex-cli/csv"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+// Double-blind marking: two markers each get a mark bar on the same anonymous
+// original, so neither sees the other's marks. Their returns are flattened into
+// separate directories under 23-marker-flattened, and a reconciliation
+// report compares the question totals. Only the first marker's scripts go on to
+// be merged; scripts needing a third marker or an agreed mark can be sent
+// round the remarking stage.
+
+var (
+	reconcileAgreed        = "agreed"
+	reconcileRefer         = "refer"
+	reconcileMissingFirst  = "missing-first"
+	reconcileMissingSecond = "missing-second"
+)
+
+type ReconcileLine struct {
+	Script  string
+	Status  string
+	First   map[string]string
+	Second  map[string]string
+	Differ  []string //questions that differ by more than the threshold
+	Totals  [2]float64
+	Compare float64
+}
+
+func (g *Ingester) DoubleMarkingConf(exam string) string {
+	return filepath.Join(g.GetExamDir(exam, config), "double-marking.csv")
+}
+
+// SetDoubleMarkers records the pair of markers for an exam, first marker first
+func (g *Ingester) SetDoubleMarkers(exam, first, second string) error {
+
+	if GetShortActorName(first) == GetShortActorName(second) {
+		return fmt.Errorf("double markers %s and %s must have different initials", first, second)
+	}
+
+	markers, err := g.GetDoubleMarkers(exam)
+
+	if err == nil && (GetShortActorName(markers[0]) != GetShortActorName(first) ||
+		GetShortActorName(markers[1]) != GetShortActorName(second)) {
+		return fmt.Errorf("exam %s is already double marked by %s and %s", exam, markers[0], markers[1])
+	}
+
+	g.logger.Info().
+		Str("exam", exam).
+		Str("first", first).
+		Str("second", second).
+		Msg("Setting double markers")
+
+	return ioutil.WriteFile(g.DoubleMarkingConf(exam), []byte(first+","+second), 0644)
+}
+
+func (g *Ingester) GetDoubleMarkers(exam string) ([]string, error) {
+
+	markers := []string{}
+
+	contents, err := ioutil.ReadFile(g.DoubleMarkingConf(exam))
+	if err != nil {
+		return markers, err
+	}
+
+	for _, marker := range strings.Split(string(contents), ",") {
+		markers = append(markers, strings.TrimSpace(marker))
+	}
+
+	if len(markers) != 2 {
+		return markers, fmt.Errorf("expected two markers in %s", g.DoubleMarkingConf(exam))
+	}
+
+	return markers, nil
+}
+
+func (g *Ingester) IsDoubleMarked(exam string) bool {
+	_, err := g.GetDoubleMarkers(exam)
+	return err == nil
+}
+
+// ReconcileReport compares the question marks from the two markers' flattened
+// scripts, and writes a report to 99-reports. It returns the number of scripts
+// that need a third marker or an agreed mark.
+func (g *Ingester) ReconcileReport(exam string, threshold float64) (int, error) {
+
+	logger := g.logger.With().Str("process", "reconcile-report").Str("exam", exam).Logger()
+
+	markers, err := g.GetDoubleMarkers(exam)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Not a double marked exam")
+		return 0, fmt.Errorf("%s is not double marked: %v", exam, err)
+	}
+
+	marks := []map[string]map[string]string{}
+
+	for _, marker := range markers {
+
+		m, err := g.getFlattenedMarks(g.GetExamDirNamed(exam, markerFlattened, marker))
+		if err != nil {
+			logger.Error().Str("marker", marker).Str("error", err.Error()).Msg("Could not get marks")
+			return 0, err
+		}
+
+		marks = append(marks, m)
+	}
+
+	lines := reconcileMarks(marks[0], marks[1], threshold)
+
+	s := csv.New()
+
+	first := GetShortActorName(markers[0])
+	second := GetShortActorName(markers[1])
+
+	s.SetFixedHeader([]string{"script", "status", "differ", "total-" + first, "total-" + second, "difference"})
+
+	qfile := filepath.Join(g.GetExamDir(exam, config), "questions.csv")
+
+	reqdQ, err := GetRequiredQuestions(qfile)
+	if err == nil {
+		header := []string{}
+		for _, q := range reqdQ {
+			header = append(header, q+"-"+first, q+"-"+second)
+		}
+		s.SetRequiredHeader(header)
+	}
+
+	referred := 0
+
+	for _, rl := range lines {
+
+		if rl.Status != reconcileAgreed {
+			referred++
+		}
+
+		line := s.Add()
+		line.Add("script", rl.Script)
+		line.Add("status", rl.Status)
+		line.Add("differ", strings.Join(rl.Differ, " "))
+		line.Add("total-"+first, fmt.Sprintf("%g", rl.Totals[0]))
+		line.Add("total-"+second, fmt.Sprintf("%g", rl.Totals[1]))
+		line.Add("difference", fmt.Sprintf("%g", rl.Compare))
+
+		for q, v := range rl.First {
+			line.Add(q+"-"+first, v)
+		}
+		for q, v := range rl.Second {
+			line.Add(q+"-"+second, v)
+		}
+	}
+
+	reportBase := fmt.Sprintf("Reconcile-%s-%d.csv", shortenAssignment(exam), time.Now().Unix())
+	reportPath := filepath.Join(g.GetExamDir(exam, reports), reportBase)
+
+	f, err := os.OpenFile(reportPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return referred, err
+	}
+
+	defer f.Close()
+
+	_, err = s.WriteCSV(f)
+
+	logger.Info().
+		Str("report", reportPath).
+		Int("scripts", len(lines)).
+		Int("referred", referred).
+		Float64("threshold", threshold).
+		Msg("Wrote reconciliation report")
+
+	return referred, err
+}
+
+// getFlattenedMarks returns the question marks for each script in the dir,
+// keyed by the anonymous original so the two markers' scripts line up
+func (g *Ingester) getFlattenedMarks(dir string) (map[string]map[string]string, error) {
+
+	marks := make(map[string]map[string]string)
+
+	files, err := g.GetFileList(dir)
+	if err != nil {
+		return marks, err
+	}
+
+	for _, file := range files {
+
+		if !IsPDF(file) {
+			continue
+		}
+
+		pdMap, err := pagedata.UnMarshalAllFromFile(file)
+		if err != nil {
+			return marks, fmt.Errorf("error obtaining pagedata from %s: %v", file, err)
+		}
+
+		if pagedata.GetLen(pdMap) < 1 {
+			return marks, fmt.Errorf("no pagedata in %s", file)
+		}
+
+		var key string
+		for _, pd := range pdMap {
+			key = getOriginalKey(pd)
+			break
+		}
+
+		marks[key] = getQMap(selectPageDetailsWithMarks(pdMap))
+	}
+
+	return marks, nil
+}
+
+func reconcileMarks(first, second map[string]map[string]string, threshold float64) []ReconcileLine {
+
+	scripts := []string{}
+
+	for script := range first {
+		scripts = append(scripts, script)
+	}
+	for script := range second {
+		if _, ok := first[script]; !ok {
+			scripts = append(scripts, script)
+		}
+	}
+
+	sort.Strings(scripts)
+
+	lines := []ReconcileLine{}
+
+	for _, script := range scripts {
+
+		rl := ReconcileLine{
+			Script: script,
+			First:  first[script],
+			Second: second[script],
+			Differ: []string{},
+		}
+
+		_, hasFirst := first[script]
+		_, hasSecond := second[script]
+
+		switch {
+		case !hasFirst:
+			rl.Status = reconcileMissingFirst
+		case !hasSecond:
+			rl.Status = reconcileMissingSecond
+		default:
+			rl.Status = reconcileAgreed
+		}
+
+		questions := make(map[string]bool)
+		for q := range rl.First {
+			questions[q] = true
+		}
+		for q := range rl.Second {
+			questions[q] = true
+		}
+
+		qs := []string{}
+		for q := range questions {
+			qs = append(qs, q)
+		}
+		sort.Sort(sortorder.Natural(qs))
+
+		for _, q := range qs {
+
+			a := markValue(rl.First[q])
+			b := markValue(rl.Second[q])
+
+			rl.Totals[0] += a
+			rl.Totals[1] += b
+
+			if math.Abs(a-b) > threshold {
+				rl.Differ = append(rl.Differ, q)
+			}
+		}
+
+		rl.Compare = rl.Totals[0] - rl.Totals[1]
+
+		if rl.Status == reconcileAgreed && (len(rl.Differ) > 0 || math.Abs(rl.Compare) > threshold) {
+			rl.Status = reconcileRefer
+		}
+
+		lines = append(lines, rl)
+	}
+
+	return lines
+}
+
+// unattempted or unreadable marks count as zero
+func markValue(mark string) float64 {
+
+	v, err := strconv.ParseFloat(mark, 64)
+
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,47 @@
+package ingester
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileMarks(t *testing.T) {
+
+	first := map[string]map[string]string{
+		"X-B1.pdf": {"Q1": "5", "Q2": "7"},
+		"X-B2.pdf": {"Q1": "5", "Q2": "7"},
+		"X-B3.pdf": {"Q1": "5", "Q2": "7"},
+		"X-B4.pdf": {"Q1": "5"},
+	}
+
+	second := map[string]map[string]string{
+		"X-B1.pdf": {"Q1": "6", "Q2": "7"},  // within threshold
+		"X-B2.pdf": {"Q1": "2", "Q2": "10"}, // same total, questions differ
+		"X-B3.pdf": {"Q1": "6.5", "Q2": "8.5"},
+		"X-B5.pdf": {"Q1": "5"},
+	}
+
+	lines := reconcileMarks(first, second, 1.5)
+
+	assert.Equal(t, 5, len(lines))
+
+	status := make(map[string]string)
+	for _, line := range lines {
+		status[line.Script] = line.Status
+	}
+
+	assert.Equal(t, reconcileAgreed, status["X-B1.pdf"])
+	assert.Equal(t, reconcileRefer, status["X-B2.pdf"])
+	assert.Equal(t, reconcileRefer, status["X-B3.pdf"])
+	assert.Equal(t, reconcileMissingSecond, status["X-B4.pdf"])
+	assert.Equal(t, reconcileMissingFirst, status["X-B5.pdf"])
+
+	assert.Equal(t, "X-B2.pdf", lines[1].Script)
+	assert.Equal(t, []string{"Q1", "Q2"}, lines[1].Differ)
+	assert.Equal(t, 0.0, lines[1].Compare)
+
+	// each question is within threshold, but the total is not
+	assert.Equal(t, []string{}, lines[2].Differ)
+	assert.Equal(t, -3.0, lines[2].Compare)
+}
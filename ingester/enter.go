@@ -125,15 +125,30 @@ func selectByOpticalOnly(fileMap *map[string]bool, pageDataMap map[string]map[in
 
 		//see whether there are potical fields without textfields...
 		// look for tf-optical. Should this be done at page summary?
+		// (checkboxes, and each option of a radio group, are in the data in the same
+		// way as textfields, so one ticked on paper but not on screen is found too)
 	PAGE:
 		for _, docMap := range pageDataMap[path] {
 
 			df := docMap.Current.Data
 
+			// a box too faint to call either way needs someone to look at it
+			if len(getUncertainBoxes(df)) > 0 {
+				(*fileMap)[path] = true
+				break PAGE
+			}
+
 			keyMap := make(map[string]int)
 
+			// handwriting we could read confidently doesn't need entering
+			readings := getConfidentReadings(df)
+
 			for _, item := range df {
 
+				if _, ok := readings[strings.TrimSuffix(item.Key, opticalSuffix)]; ok {
+					continue
+				}
+
 				if strings.Contains(item.Value, markDetected) && strings.Contains(item.Key, opticalSuffix) && strings.Contains(item.Key, textFieldPrefix) {
 
 					keyMap[strings.TrimSuffix(item.Key, opticalSuffix)] = keyMap[strings.TrimSuffix(item.Key, opticalSuffix)] + 1
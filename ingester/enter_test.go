@@ -85,6 +85,84 @@ func TestSelectForEnter(t *testing.T) {
 
 }
 
+func TestSelectForEnterWithReadings(t *testing.T) {
+
+	confident := "confident.pdf"
+	unsure := "unsure.pdf"
+
+	pdfFiles := map[string]bool{
+		confident: false,
+		unsure:    false,
+	}
+
+	pdByFile := make(map[string]map[int]pagedata.PageData)
+
+	for file, confidence := range map[string]string{confident: "0.950", unsure: "0.500"} {
+		pdByFile[file] = map[int]pagedata.PageData{
+			1: pagedata.PageData{
+				Current: pagedata.PageDetail{
+					Data: []pagedata.Field{
+						{Key: "tf-q1-mark-optical", Value: markDetected},
+						{Key: "tf-q1-mark", Value: ""},
+						{Key: "tf-q1-mark-optical-reading", Value: "6"},
+						{Key: "tf-q1-mark-optical-confidence", Value: confidence},
+					},
+				},
+			},
+		}
+	}
+
+	selectByOpticalOnly(&pdfFiles, pdByFile)
+
+	// the mark we read confidently doesn't need typing in
+	assert.False(t, pdfFiles[confident])
+	assert.True(t, pdfFiles[unsure])
+}
+
+func TestSelectForEnterUncertainBox(t *testing.T) {
+
+	faint := "faint.pdf"
+	blank := "blank.pdf"
+
+	pdfFiles := map[string]bool{
+		faint: false,
+		blank: false,
+	}
+
+	pdByFile := map[string]map[int]pagedata.PageData{
+		faint: {
+			1: pagedata.PageData{
+				Current: pagedata.PageDetail{
+					Data: []pagedata.Field{
+						{Key: "tf-q1-mark-optical", Value: ""},
+						{Key: "tf-q1-mark", Value: ""},
+						{Key: "tf-q1-mark-optical-fill", Value: "0.0150"},
+						{Key: "tf-q1-mark-optical-fill-confidence", Value: "0.750"},
+						{Key: "tf-q1-mark-optical-uncertain", Value: markUncertain},
+					},
+				},
+			},
+		},
+		blank: {
+			1: pagedata.PageData{
+				Current: pagedata.PageDetail{
+					Data: []pagedata.Field{
+						{Key: "tf-q1-mark-optical", Value: ""},
+						{Key: "tf-q1-mark", Value: ""},
+						{Key: "tf-q1-mark-optical-fill", Value: "0.0010"},
+						{Key: "tf-q1-mark-optical-fill-confidence", Value: "1.000"},
+					},
+				},
+			},
+		},
+	}
+
+	selectByOpticalOnly(&pdfFiles, pdByFile)
+
+	assert.True(t, pdfFiles[faint])
+	assert.False(t, pdfFiles[blank])
+}
+
 func TestMergeAfterEnter(t *testing.T) {
 
 	if testing.Short() {
@@ -3,6 +3,7 @@ package ingester
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 var (
@@ -32,6 +33,25 @@ func ValidStageForExport(stage string) bool {
 
 func (g *Ingester) GetExportDirs(exam, stage, actor string) (string, string, string, error) {
 
+	ready, sent, err := getExportStageDirs(stage)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	readyDir := g.GetExamDirNamed(exam, ready, actor)
+	sentDir := g.GetExamDirNamed(exam, sent, actor)
+	exportDir := g.GetExportDir(exam, stage, actor)
+
+	g.EnsureDirAll(readyDir)
+	g.EnsureDirAll(sentDir)
+	g.EnsureDirAll(exportDir)
+
+	return readyDir, sentDir, exportDir, nil
+}
+
+func getExportStageDirs(stage string) (string, string, error) {
+
 	var ready, sent string
 
 	switch stage {
@@ -71,21 +91,19 @@ func (g *Ingester) GetExportDirs(exam, stage, actor string) (string, string, str
 		sent = reCheckerSent
 
 	default:
-		return "", "", "", fmt.Errorf("unknown stage %s.\n Try: [%s]", stage, strings.Join(exportStages, ","))
+		return "", "", fmt.Errorf("unknown stage %s.\n Try: [%s]", stage, strings.Join(exportStages, ","))
 	}
 
-	readyDir := g.GetExamDirNamed(exam, ready, actor)
-	sentDir := g.GetExamDirNamed(exam, sent, actor)
-	exportDir := g.GetExportDir(exam, stage, actor)
-
-	g.EnsureDirAll(readyDir)
-	g.EnsureDirAll(sentDir)
-	g.EnsureDirAll(exportDir)
-
-	return readyDir, sentDir, exportDir, nil
+	return ready, sent, nil
 }
 
 func (g *Ingester) ExportFiles(exam, stage, actor string) error {
+	return g.ExportFilesDue(exam, stage, actor, time.Time{})
+}
+
+// ExportFilesDue exports the files, recording them as a batch that is
+// due back at the time given (use a zero time if there is no due date)
+func (g *Ingester) ExportFilesDue(exam, stage, actor string, due time.Time) error {
 
 	logger := g.logger.With().
 		Str("exam", exam).
@@ -120,6 +138,8 @@ func (g *Ingester) ExportFiles(exam, stage, actor string) error {
 
 	numErrors := 0
 
+	exported := []string{}
+
 	for _, file := range files {
 
 		if !g.IsPDF(file) {
@@ -139,6 +159,8 @@ func (g *Ingester) ExportFiles(exam, stage, actor string) error {
 					Msg("Could not move file to sent directory")
 			}
 
+			exported = append(exported, file)
+
 		} else {
 			numErrors++
 			g.logger.Error().
@@ -149,6 +171,16 @@ func (g *Ingester) ExportFiles(exam, stage, actor string) error {
 		}
 
 	}
+
+	err = g.recordExportBatch(exam, stage, actor, exported, due)
+
+	if err != nil {
+		numErrors++
+		g.logger.Error().
+			Str("error", err.Error()).
+			Msg("Could not record export batch")
+	}
+
 	if numErrors == 0 {
 		g.logger.Info().
 			Int("count", len(files)).
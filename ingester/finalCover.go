@@ -21,6 +21,8 @@ func (g *Ingester) FinalCoverPage(cp CoverPageCommand, logger *zerolog.Logger) e
 	// sum up all the questions
 	// make sure only questions in questions conf are included in the cover page
 
+	cp.TemplatePath, cp.TemplateDirs = g.examTemplate(cp.ExamName, cp.TemplatePath)
+
 	files, err := g.GetFileList(cp.FromPath)
 
 	if err != nil {
@@ -197,6 +199,7 @@ func DoOneFinalCoverPage(ct CoverPageTask, logger *zerolog.Logger) error {
 		PdfOutputPath:         pageFilename,
 		PageData:              thisPageData,
 		TemplatePathsRelative: true,
+		TemplateDirs:          cp.TemplateDirs,
 		Prefills:              Prefills,
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/timdrysdale/anon"
 	"github.com/timdrysdale/gradex-cli/comment"
+	"github.com/timdrysdale/gradex-cli/image"
 	"github.com/timdrysdale/gradex-cli/merge"
 	"github.com/timdrysdale/gradex-cli/pagedata"
 	"github.com/timdrysdale/gradex-cli/parselearn"
@@ -286,9 +287,19 @@ func (g *Ingester) FlattenOneNewPDF(inputPath, outputPath string, pageDataMap ma
 
 	comments, err := comment.GetComments(pdfReader)
 
+	formats, err := getPageFormats(pdfReader)
+	if err != nil {
+		logger.Error().
+			Str("file", inputPath).
+			Str("error", err.Error()).
+			Msg(fmt.Sprintf("can't get page sizes for %s, so using the portrait spread", inputPath))
+	}
+
 	f.Close()
 
-	err = ConvertPDFToJPEGs(inputPath, jpegPath, jpegFileOption)
+	rs := g.GetRasterSettings(what, pageDataMap[1].Current.Process.ToDo)
+
+	err = ConvertPDFToJPEGsWithSettings(inputPath, jpegPath, jpegFileOption, rs)
 	if err != nil {
 		logger.Error().
 			Str("file", inputPath).
@@ -299,6 +310,20 @@ func (g *Ingester) FlattenOneNewPDF(inputPath, outputPath string, pageDataMap ma
 		return 0, err
 	}
 
+	var previous *previousPages
+
+	if rs.Mode == image.Vector {
+		previous, err = openPreviousPages(inputPath)
+		if err != nil {
+			logger.Error().
+				Str("file", inputPath).
+				Str("error", err.Error()).
+				Msg(fmt.Sprintf("can't use pages in vector mode %s", inputPath))
+			return 0, err
+		}
+		defer previous.Close()
+	}
+
 	// convert images to individual pdfs, with form overlay
 
 	pagePath := g.GetExamDir(what, tempPages)
@@ -306,54 +331,130 @@ func (g *Ingester) FlattenOneNewPDF(inputPath, outputPath string, pageDataMap ma
 
 	mergePaths := []string{}
 
-	// gs starts indexing at 1
-	for imgIdx := 1; imgIdx <= numPages; imgIdx = imgIdx + 1 {
+	svgLayoutPath, templateDirs := g.examTemplate(what, g.FlattenLayoutSVG())
+
+	// split pages make more pages than we started with
+	pages := flattenPages(formats, numPages, g.splitA3)
+	numFlattened := len(pages)
+	split := numFlattened != numPages
+
+	pageComments := flattenComments(comments, pages)
+
+	for idx, page := range pages {
+
+		pd := pageDataMap[page.Number]
+
+		pageNumber := idx
+
+		// construct image name (gs starts indexing at 1)
+		previousImagePath := fmt.Sprintf(jpegFileOption, page.Number)
+		pageFilename := fmt.Sprintf(pageFileOption, idx+1)
+
+		if page.Half != "" && previous == nil {
+
+			if page.Half == leftHalf {
+				err = image.SplitInHalf(previousImagePath, halfImagePath(previousImagePath, leftHalf), halfImagePath(previousImagePath, rightHalf), rs)
+				if err != nil {
+					logger.Error().
+						Str("file", inputPath).
+						Str("error", err.Error()).
+						Msg(fmt.Sprintf("Error splitting page %d of %s", page.Number, inputPath))
+					return 0, err
+				}
+			}
 
-		pd := pageDataMap[imgIdx]
+			previousImagePath = halfImagePath(previousImagePath, page.Half)
+		}
 
-		pageNumber := imgIdx - 1
+		// use the variant of the spread for landscape or A3 pages, if there is one
+		spreadName, err := parsesvg.GetSpreadForPage(svgLayoutPath, "flatten", page.Format)
+		if err != nil {
+			logger.Error().
+				Str("file", inputPath).
+				Str("template", svgLayoutPath).
+				Str("error", err.Error()).
+				Msg("Can't choose spread for page, so using flatten")
+		}
 
-		// construct image name
-		previousImagePath := fmt.Sprintf(jpegFileOption, imgIdx)
-		pageFilename := fmt.Sprintf(pageFileOption, imgIdx)
+		pd.Current.Process = rasterProcessDetail(pd.Current.Process, rs)
 
-		//TODO select Layout to suit landscape or portrait
-		svgLayoutPath := g.FlattenLayoutSVG()
+		pd.Current.Process, err = templateProcessDetail(pd.Current.Process, svgLayoutPath, spreadName, templateDirs)
+		if err != nil {
+			logger.Error().
+				Str("file", inputPath).
+				Str("template", svgLayoutPath).
+				Str("error", err.Error()).
+				Msg("Can't hash template, so not recording it in pagedata")
+		}
 
 		pd.Current.Own = pagedata.FileDetail{
 			Path:   pageFilename,
 			UUID:   safeUUID(),
-			Number: imgIdx,
-			Of:     numPages,
+			Number: idx + 1,
+			Of:     numFlattened,
+		}
+
+		// the halves of a split page are pages in their own right from now on,
+		// e.g. for merging, so the pages are numbered again
+		if split {
+			pd.Current.Original.Number = idx + 1
+			pd.Current.Original.Of = numFlattened
 		}
 
+		// and need a UUID each, else later stages, and the merge, can't tell them apart
+		if page.Half != "" {
+			pd.Current.UUID = safeUUID()
+		}
+
+		// the halves of a page share its pagedata, so don't append to its Data
+		data := []pagedata.Field{}
+		data = append(data, pd.Current.Data...)
+		pd.Current.Data = append(data, pageFormatData(page, split)...)
+
 		headerPrefills := parsesvg.DocPrefills{}
 
 		headerPrefills[pageNumber] = make(map[string]string)
 
-		headerPrefills[pageNumber]["page-number"] = fmt.Sprintf("%d/%d", imgIdx, numPages)
+		headerPrefills[pageNumber]["page-number"] = fmt.Sprintf("%d/%d", idx+1, numFlattened)
 
-		headerPrefills[pageNumber]["author"] = pageDataMap[imgIdx].Current.Item.Who
+		headerPrefills[pageNumber]["author"] = pd.Current.Item.Who
 
-		headerPrefills[pageNumber]["date"] = pageDataMap[imgIdx].Current.Item.When
+		headerPrefills[pageNumber]["date"] = pd.Current.Item.When
 
-		headerPrefills[pageNumber]["title"] = pageDataMap[imgIdx].Current.Item.What
+		headerPrefills[pageNumber]["title"] = pd.Current.Item.What
 
 		if len(headerPrefills[pageNumber]["title"]) > 12 {
 			headerPrefills[pageNumber]["title"] = headerPrefills[pageNumber]["title"][0:13]
 		}
 		contents := parsesvg.SpreadContents{
 			SvgLayoutPath:         svgLayoutPath,
-			SpreadName:            "flatten",
+			SpreadName:            spreadName,
 			PreviousImagePath:     previousImagePath,
 			PageNumber:            pageNumber,
 			PdfOutputPath:         pageFilename,
-			Comments:              comments,
+			Comments:              pageComments,
 			PageData:              pd,
 			TemplatePathsRelative: true,
+			TemplateDirs:          templateDirs,
 			Prefills:              headerPrefills,
 		}
 
+		setRasterContents(&contents, rs)
+
+		if previous != nil {
+			if page.Half != "" {
+				err = previous.placeHalf(&contents, page.Number, page.Half)
+			} else {
+				err = previous.placePage(&contents, page.Number)
+			}
+			if err != nil {
+				logger.Error().
+					Str("error", err.Error()).
+					Msg(fmt.Sprintf("Error getting page %d of %s", page.Number, inputPath))
+				return 0, err
+			}
+		}
+
 		err = parsesvg.RenderSpreadExtra(contents)
 		if err != nil {
 			logger.Error().
@@ -386,8 +487,8 @@ func (g *Ingester) FlattenOneNewPDF(inputPath, outputPath string, pageDataMap ma
 
 	logger.Info().
 		Str("file", inputPath).
-		Int("page-count", numPages).
-		Msg(fmt.Sprintf("processing finished for %s, with %d pages", inputPath, numPages))
-	return numPages, nil
+		Int("page-count", numFlattened).
+		Msg(fmt.Sprintf("processing finished for %s, with %d pages", inputPath, numFlattened))
+	return numFlattened, nil
 
 }
@@ -56,6 +56,12 @@ func (g *Ingester) FlattenProcessedPapers(exam, stage string) error {
 		return fmt.Errorf("%s is not a valid stage for flatten-processed\n", stage)
 	}
 
+	// thresholds saved for this template, if any
+	err := g.UseOpticalProfile(exam)
+	if err != nil {
+		return err
+	}
+
 	fromDir, err := g.FlattenProcessedPapersFromDir(exam, stage)
 	if err != nil {
 		logger.Error().Msg("Could not get FlattenProcessedPapersFromDir")
@@ -68,6 +74,35 @@ func (g *Ingester) FlattenProcessedPapers(exam, stage string) error {
 		return err
 	}
 
+	// keep each double marker's scripts apart, so they can be reconciled
+	if stage == "marked" && g.IsDoubleMarked(exam) {
+
+		markers, err := g.GetDoubleMarkers(exam)
+		if err != nil {
+			return err
+		}
+
+		for _, marker := range markers {
+
+			err = g.flattenProcessedPapersInDir(exam, stage,
+				g.GetExamDirNamed(exam, markerBack, marker),
+				g.GetExamDirNamed(exam, markerFlattened, marker))
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return g.flattenProcessedPapersInDir(exam, stage, fromDir, toDir)
+}
+
+func (g *Ingester) flattenProcessedPapersInDir(exam, stage, fromDir, toDir string) error {
+
+	logger := g.logger.With().Str("process", "flatten-processed-papers").Str("stage", stage).Str("exam", exam).Logger()
+
 	taskName := fmt.Sprintf("flatten-%s", stage)
 
 	mc := chmsg.MessagerConf{
@@ -101,7 +136,7 @@ func (g *Ingester) FlattenProcessedPapers(exam, stage string) error {
 		OmitPreviousComments: true, //avoid QBOX line in report checked from previous stage's comments
 	}
 
-	err = g.OverlayPapers(oc, &logger)
+	err := g.OverlayPapers(oc, &logger)
 
 	if err == nil {
 		cm.Send(fmt.Sprintf("Finished Processing %s UUID=%s\n", taskName, procDetail.UUID))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/optical"
 )
 
 type Ingester struct {
@@ -21,8 +22,12 @@ type Ingester struct {
 	ingestTemplatePath    string
 	backgroundIsVanilla   bool
 	opticalExpand         int
+	opticalThresholds     optical.Thresholds
 	SkipQuestionFile      bool //TODO revert to private, probably
 	changeAncestor        bool
+	splitA3               bool
+	relinkScans           bool
+	examLocks             map[string]int // how many times we have locked each exam, see LockExam
 }
 
 func New(path string, msgCh chan chmsg.MessageInfo, logger *zerolog.Logger) (*Ingester, error) {
@@ -39,6 +44,7 @@ func New(path string, msgCh chan chmsg.MessageInfo, logger *zerolog.Logger) (*In
 	g.ingestTemplatePath = "layout-flatten-312pt.svg"
 	g.backgroundIsVanilla = true
 	g.opticalExpand = -10
+	g.opticalThresholds = optical.DefaultThresholds
 
 	err := g.SetupGradexDirs()
 
@@ -68,6 +74,21 @@ func (g *Ingester) SetChangeAncestor(change bool) {
 	g.changeAncestor = change
 }
 
+// SetSplitA3 makes flatten split A3 landscape pages into two A4 pages,
+// for scripts where two pages were scanned side by side
+func (g *Ingester) SetSplitA3(split bool) {
+	g.logger.Info().Bool("split", split).Msg(fmt.Sprintf("Changing splitA3 from %v to %v", g.splitA3, split))
+	g.splitA3 = split
+}
+
+// SetRelinkScans makes ingest look for identity codes on PDFs that have no
+// pagedata, e.g. scans of printed scripts, so that they can be relinked. It is
+// off by default, because each page one has to be rasterised to look.
+func (g *Ingester) SetRelinkScans(relink bool) {
+	g.logger.Info().Bool("relink", relink).Msg(fmt.Sprintf("Changing relinkScans from %v to %v", g.relinkScans, relink))
+	g.relinkScans = relink
+}
+
 func (g *Ingester) SetOverlayTemplatePath(path string) error {
 
 	_, err := os.Stat(filepath.Join(g.OverlayTemplate(), path))
@@ -0,0 +1,297 @@
+package ingester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"time"
+)
+
+// Advisory lock per exam, so that two admins can't run mutating commands
+// (flatten, mark, export etc) on the same exam at the same time.
+// The lock is a small json file in var/lock which records who holds it.
+// A lock is stale if its process has gone away (same host only), or if it
+// is older than lockStaleAge (other hosts, where we can't check the PID).
+
+var lockStaleAge = 24 * time.Hour
+
+type ExamLock struct {
+	Exam     string `json:"exam"`
+	Host     string `json:"host"`
+	PID      int    `json:"pid"`
+	User     string `json:"user"`
+	Command  string `json:"command"`
+	UnixTime int64  `json:"unixTime"`
+}
+
+func (l ExamLock) String() string {
+	return fmt.Sprintf("[%s] held by %s@%s (pid %d) since %s",
+		l.Command, l.User, l.Host, l.PID, time.Unix(l.UnixTime, 0).Format(time.RFC3339))
+}
+
+func (l ExamLock) IsStale() bool {
+
+	host, err := os.Hostname()
+
+	if err == nil && host == l.Host {
+		return !processAlive(l.PID)
+	}
+
+	return time.Since(time.Unix(l.UnixTime, 0)) > lockStaleAge
+}
+
+func (l ExamLock) isOurs() bool {
+
+	host, err := os.Hostname()
+
+	return err == nil && host == l.Host && os.Getpid() == l.PID
+}
+
+func (g *Ingester) LockDir() string {
+	return filepath.Join(g.Var(), "lock")
+}
+
+func (g *Ingester) ExamLockPath(exam string) string {
+	return filepath.Join(g.LockDir(), filepath.Base(exam)+".lock")
+}
+
+func newExamLock(exam, command string) ExamLock {
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	who := "unknown"
+	if u, err := user.Current(); err == nil {
+		who = u.Username
+	}
+
+	return ExamLock{
+		Exam:     exam,
+		Host:     host,
+		PID:      os.Getpid(),
+		User:     who,
+		Command:  command,
+		UnixTime: time.Now().Unix(),
+	}
+}
+
+// LockExam takes the lock for the exam, replacing any stale lock it finds.
+// It can be called again from the process that already holds the lock, e.g.
+// by a command that calls another, and the lock is then kept until UnlockExam
+// has been called as many times as LockExam.
+func (g *Ingester) LockExam(exam, command string) error {
+
+	logger := g.logger.With().Str("process", "lock-exam").Str("exam", exam).Logger()
+
+	err := EnsureDirAll(g.LockDir())
+	if err != nil {
+		return err
+	}
+
+	lockPath := g.ExamLockPath(exam)
+
+	lock := newExamLock(exam, command)
+
+	contents, err := json.Marshal(lock)
+	if err != nil {
+		return err
+	}
+
+	// the lock is written in full before it is linked or renamed into place,
+	// so nobody can read a half-written lock
+	tmpPath := fmt.Sprintf("%s.%d.%d.tmp", lockPath, lock.PID, time.Now().UnixNano())
+
+	err = ioutil.WriteFile(tmpPath, contents, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	// two tries: the second one is after taking over a stale lock that
+	// someone else took over before us, or removed
+	for i := 0; i < 2; i++ {
+
+		err = os.Link(tmpPath, lockPath)
+
+		if err == nil {
+			g.countExamLock(exam)
+			logger.Info().Str("lock", lock.String()).Msg("Took exam lock")
+			return nil
+		}
+
+		if !os.IsExist(err) {
+			return err
+		}
+
+		stale, err := ioutil.ReadFile(lockPath)
+		if os.IsNotExist(err) {
+			continue // released while we looked
+		}
+		if err != nil {
+			return err
+		}
+
+		var held ExamLock
+		err = json.Unmarshal(stale, &held)
+		if err != nil {
+			return fmt.Errorf("exam %s has an unreadable lock at %s (%v); use lock break if no-one else is working on it", exam, lockPath, err)
+		}
+
+		if held.isOurs() {
+			g.countExamLock(exam)
+			return nil
+		}
+
+		if !held.IsStale() {
+			return fmt.Errorf("exam %s is locked: %s", exam, held.String())
+		}
+
+		logger.Warn().Str("lock", held.String()).Msg("Replacing stale exam lock")
+
+		took, err := g.takeOverExamLock(exam, stale, tmpPath)
+		if err != nil {
+			return err
+		}
+
+		if took {
+			g.countExamLock(exam)
+			logger.Info().Str("lock", lock.String()).Msg("Took exam lock")
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not take lock for exam %s", exam)
+}
+
+// how long a takeover can take before it is assumed its process has gone
+var lockTakeOverAge = time.Minute
+
+// takeOverExamLock replaces the stale lock with ours, if the lock is still
+// the one we found to be stale. Only one process can be taking over the lock
+// at a time, and the lock file is replaced by renaming, so it is never
+// missing for someone else to take in the meantime. If another process got
+// there first, the lock is left alone, and we report that we didn't take it.
+func (g *Ingester) takeOverExamLock(exam string, stale []byte, tmpPath string) (bool, error) {
+
+	lockPath := g.ExamLockPath(exam)
+	takeOverPath := lockPath + ".takeover"
+
+	f, err := os.OpenFile(takeOverPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+
+	if os.IsExist(err) {
+		if info, statErr := os.Stat(takeOverPath); statErr == nil && time.Since(info.ModTime()) > lockTakeOverAge {
+			os.Remove(takeOverPath)
+		}
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	f.Close()
+	defer os.Remove(takeOverPath)
+
+	current, err := ioutil.ReadFile(lockPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !bytes.Equal(current, stale) {
+		return false, nil
+	}
+
+	err = os.Rename(tmpPath, lockPath)
+
+	return err == nil, err
+}
+
+func (g *Ingester) countExamLock(exam string) {
+
+	if g.examLocks == nil {
+		g.examLocks = make(map[string]int)
+	}
+
+	g.examLocks[exam]++
+}
+
+// UnlockExam releases the lock, but only if this process holds it, and
+// only once it has been unlocked as many times as it was locked
+func (g *Ingester) UnlockExam(exam string) error {
+
+	held, err := g.GetExamLock(exam)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !held.isOurs() {
+		return fmt.Errorf("not releasing lock for exam %s because it is %s", exam, held.String())
+	}
+
+	if g.examLocks[exam] > 1 {
+		g.examLocks[exam]--
+		return nil
+	}
+
+	delete(g.examLocks, exam)
+
+	g.logger.Info().Str("process", "lock-exam").Str("exam", exam).Msg("Released exam lock")
+
+	return os.Remove(g.ExamLockPath(exam))
+}
+
+func (g *Ingester) GetExamLock(exam string) (ExamLock, error) {
+
+	var lock ExamLock
+
+	contents, err := ioutil.ReadFile(g.ExamLockPath(exam))
+	if err != nil {
+		return lock, err
+	}
+
+	err = json.Unmarshal(contents, &lock)
+
+	return lock, err
+}
+
+// BreakExamLock removes the lock whoever holds it, returning
+// what we could read of the lock that was broken
+func (g *Ingester) BreakExamLock(exam string) (ExamLock, error) {
+
+	held, err := g.GetExamLock(exam)
+	if os.IsNotExist(err) {
+		return held, fmt.Errorf("exam %s is not locked", exam)
+	}
+
+	g.logger.Warn().
+		Str("process", "lock-exam").
+		Str("exam", exam).
+		Str("lock", held.String()).
+		Msg("Breaking exam lock")
+
+	return held, os.Remove(g.ExamLockPath(exam))
+}
+
+// lockedByOther is for processes like ingest that touch many exams, and
+// would rather leave a file for next time than wait for a lock
+func (g *Ingester) lockedByOther(exam string) bool {
+
+	held, err := g.GetExamLock(exam)
+	if err != nil {
+		return false
+	}
+
+	return !held.isOurs() && !held.IsStale()
+}
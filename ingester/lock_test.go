@@ -0,0 +1,129 @@
+package ingester
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/chmsg"
+)
+
+func TestExamLock(t *testing.T) {
+
+	logger := zerolog.Nop()
+
+	mch := make(chan chmsg.MessageInfo)
+
+	g, err := New("./tmp-delete-me", mch, &logger)
+
+	assert.NoError(t, err)
+
+	g.EnsureDirectoryStructure()
+
+	exam := "lock-test"
+
+	os.Remove(g.ExamLockPath(exam))
+
+	assert.NoError(t, g.LockExam(exam, "test"))
+
+	lock, err := g.GetExamLock(exam)
+	assert.NoError(t, err)
+	assert.Equal(t, os.Getpid(), lock.PID)
+	assert.Equal(t, "test", lock.Command)
+	assert.False(t, lock.IsStale())
+
+	// we can take our own lock again, and it is kept until we have
+	// unlocked it as many times as we locked it
+	assert.NoError(t, g.LockExam(exam, "test"))
+
+	assert.NoError(t, g.UnlockExam(exam))
+	mustExist(t, g.ExamLockPath(exam))
+
+	assert.NoError(t, g.UnlockExam(exam))
+	mustNotExist(t, g.ExamLockPath(exam))
+
+	// a live lock held by someone else blocks us, and is seen by ingest
+	host, _ := os.Hostname()
+	other := ExamLock{
+		Exam:     exam,
+		Host:     host + "-elsewhere",
+		PID:      1,
+		User:     "someone",
+		Command:  "flatten",
+		UnixTime: time.Now().Unix(),
+	}
+	writeTestLock(t, g.ExamLockPath(exam), other)
+
+	assert.Error(t, g.LockExam(exam, "test"))
+	assert.True(t, g.lockedByOther(exam))
+	assert.Error(t, g.UnlockExam(exam))
+
+	// the same lock, but old enough to be stale, is replaced
+	other.UnixTime = time.Now().Add(-2 * lockStaleAge).Unix()
+	writeTestLock(t, g.ExamLockPath(exam), other)
+
+	assert.False(t, g.lockedByOther(exam))
+	assert.NoError(t, g.LockExam(exam, "test"))
+
+	lock, err = g.GetExamLock(exam)
+	assert.NoError(t, err)
+	assert.Equal(t, os.Getpid(), lock.PID)
+
+	assert.NoError(t, g.UnlockExam(exam))
+
+	// a stale lock is only taken over if it is still the one we found stale,
+	// e.g. not if someone else took it over first
+	writeTestLock(t, g.ExamLockPath(exam), other)
+	stale, err := ioutil.ReadFile(g.ExamLockPath(exam))
+	assert.NoError(t, err)
+
+	ours := g.ExamLockPath(exam) + ".test.tmp"
+	assert.NoError(t, ioutil.WriteFile(ours, []byte("{}"), 0644))
+
+	took, err := g.takeOverExamLock(exam, []byte("an older stale lock"), ours)
+	assert.NoError(t, err)
+	assert.False(t, took)
+
+	lock, err = g.GetExamLock(exam)
+	assert.NoError(t, err)
+	assert.Equal(t, "someone", lock.User)
+
+	// nor while someone else is taking it over
+	takeOverPath := g.ExamLockPath(exam) + ".takeover"
+	assert.NoError(t, ioutil.WriteFile(takeOverPath, []byte{}, 0644))
+
+	took, err = g.takeOverExamLock(exam, stale, ours)
+	assert.NoError(t, err)
+	assert.False(t, took)
+	assert.Error(t, g.LockExam(exam, "test"))
+
+	os.Remove(takeOverPath)
+
+	took, err = g.takeOverExamLock(exam, stale, ours)
+	assert.NoError(t, err)
+	assert.True(t, took)
+	_, err = os.Stat(ours)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(takeOverPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// breaking works whoever holds the lock
+	writeTestLock(t, g.ExamLockPath(exam), other)
+	broken, err := g.BreakExamLock(exam)
+	assert.NoError(t, err)
+	assert.Equal(t, "someone", broken.User)
+	mustNotExist(t, g.ExamLockPath(exam))
+
+	_, err = g.BreakExamLock(exam)
+	assert.Error(t, err)
+}
+
+func writeTestLock(t *testing.T, path string, lock ExamLock) {
+	contents, err := json.Marshal(lock)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(path, contents, 0644))
+}
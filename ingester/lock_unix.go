@@ -0,0 +1,14 @@
+//go:build !windows
+// +build !windows
+
+package ingester
+
+import "syscall"
+
+func processAlive(pid int) bool {
+
+	err := syscall.Kill(pid, syscall.Signal(0))
+
+	// EPERM means the process exists but belongs to someone else
+	return err == nil || err == syscall.EPERM
+}
@@ -0,0 +1,16 @@
+package ingester
+
+import "os"
+
+func processAlive(pid int) bool {
+
+	// FindProcess opens a handle on windows, so fails if there is no such process
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	p.Release()
+
+	return true
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/looplab/fsm"
 	"github.com/rs/zerolog"
+	"github.com/timdrysdale/gradex-cli/extract"
 	"github.com/timdrysdale/gradex-cli/pagedata"
 )
 
@@ -135,10 +136,13 @@ func (g *Ingester) MergeProcessedPapers(exam, stage string) error {
 		For:      "ingester",
 	}
 
+	template, templateDirs := g.examTemplate(exam, g.OverlayLayoutSVG())
+
 	mc := MergeCommand{
 		MergeFiles:    mergeFiles,
 		ToDir:         toDir,
-		Template:      g.OverlayLayoutSVG(),
+		Template:      template,
+		TemplateDirs:  templateDirs,
 		SpreadName:    "merge",
 		ProcessDetail: procDetail,
 	}
@@ -197,15 +201,17 @@ func summarisePage(pageData pagedata.PageData) PageSummary {
 	noTextFields := true
 	for _, item := range pageData.Current.Data {
 
-		if strings.Contains(item.Key, textFieldPrefix) {
+		if strings.Contains(item.Key, textFieldPrefix) && !isOpticalScore(item.Key) {
 			noTextFields = false
-			if strings.Contains(item.Key, "page-ok") && item.Value != "" {
+			// an option of a radio group, e.g. tf-page=bad, reads as tf-page-bad
+			key := strings.Replace(item.Key, extract.RadioOptionSeparator, "-", 1)
+			if strings.Contains(key, "page-ok") && item.Value != "" {
 				pageFSM.Event(statusSeen)
 			}
-			if strings.Contains(item.Key, "page-bad") && item.Value != "" {
+			if strings.Contains(key, "page-bad") && item.Value != "" {
 				pageFSM.Event(statusBad)
 			}
-			if !strings.Contains(item.Key, "page-bad") && !strings.Contains(item.Key, "page-ok") && item.Value != "" {
+			if !strings.Contains(key, "page-bad") && !strings.Contains(key, "page-ok") && item.Value != "" {
 				pageFSM.Event(statusMarked)
 			}
 		}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/rs/zerolog"
+	"github.com/timdrysdale/gradex-cli/image"
 	"github.com/timdrysdale/gradex-cli/merge"
 	"github.com/timdrysdale/gradex-cli/pagedata"
 	"github.com/timdrysdale/gradex-cli/parsesvg"
@@ -23,6 +24,7 @@ type MergeCommand struct {
 	MergeFiles    []MergeFile
 	ToDir         string
 	Template      string
+	TemplateDirs  []string
 	SpreadName    string
 	ProcessDetail pagedata.ProcessDetail
 }
@@ -33,6 +35,7 @@ type MergeTask struct {
 	ProcessDetail pagedata.ProcessDetail
 	SpreadName    string
 	Template      string
+	TemplateDirs  []string
 }
 
 // we pass pointer to logger that has a processing stage string pre-prended to it
@@ -56,6 +59,7 @@ func (g *Ingester) MergeOverlayPapers(mc MergeCommand, logger *zerolog.Logger) e
 			ToDir:         mc.ToDir,
 			SpreadName:    mc.SpreadName,
 			Template:      mc.Template,
+			TemplateDirs:  mc.TemplateDirs,
 			ProcessDetail: mc.ProcessDetail,
 		})
 		logger.Info().
@@ -192,7 +196,18 @@ func (g *Ingester) MergeOverlayOnePDF(mt MergeTask, logger *zerolog.Logger) (int
 
 		newThisPageDataCurrent.Follows = oldThisPageDataCurrent.UUID
 
-		newThisPageDataCurrent.Process = mt.ProcessDetail
+		rs := g.GetRasterSettings(newThisPageDataCurrent.Item.What, mt.ProcessDetail.ToDo)
+
+		newThisPageDataCurrent.Process = rasterProcessDetail(mt.ProcessDetail, rs)
+
+		newThisPageDataCurrent.Process, err = templateProcessDetail(newThisPageDataCurrent.Process, mt.Template, mt.SpreadName, mt.TemplateDirs)
+		if err != nil {
+			logger.Error().
+				Str("file", inPath).
+				Str("template", mt.Template).
+				Str("error", err.Error()).
+				Msg("Can't hash template, so not recording it in pagedata")
+		}
 
 		newThisPageDataCurrent.Data = append(newThisPageDataCurrent.Data, pagedata.Field{
 			Key:   "merge-message",
@@ -230,7 +245,7 @@ func (g *Ingester) MergeOverlayOnePDF(mt MergeTask, logger *zerolog.Logger) (int
 		// but if it changes, here's where to do the comment processing
 		// (remember to get the comments for _this_ particular page only)
 
-		err = ConvertPDFToJPEGs(inPath, jpegDir, jpegPathOption)
+		err = ConvertPDFToJPEGsWithSettings(inPath, jpegDir, jpegPathOption, rs)
 		if err != nil {
 			logger.Error().
 				Str("file", inPath).
@@ -292,10 +307,40 @@ func (g *Ingester) MergeOverlayOnePDF(mt MergeTask, logger *zerolog.Logger) (int
 			PdfOutputPath:         pagePath,
 			PageData:              thisPageData, //no pageNumber index needed
 			TemplatePathsRelative: true,
+			TemplateDirs:          mt.TemplateDirs,
 			Prefills:              prefills,
 		}
 
+		setRasterContents(&contents, rs)
+
+		var previous *previousPages
+
+		if rs.Mode == image.Vector {
+
+			previous, err = openPreviousPages(inPath)
+
+			if err == nil {
+				err = previous.placePage(&contents, 1)
+			}
+
+			if err != nil {
+				logger.Error().
+					Str("file", inPath).
+					Str("error", err.Error()).
+					Msg(fmt.Sprintf("Can't use page (%s) in vector mode because: %v\n", inPath, err))
+				if previous != nil {
+					previous.Close()
+				}
+				return 0, err
+			}
+		}
+
 		err = parsesvg.RenderSpreadExtra(contents)
+
+		if previous != nil {
+			previous.Close()
+		}
+
 		if err != nil {
 			logger.Error().
 				Str("file", pagePath).
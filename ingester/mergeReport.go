@@ -27,6 +27,8 @@ type PageReport struct {
 	IsLinked     bool   `csv:"linked"`
 	FirstLink    string `csv:"firstlink"`
 	LastLink     string `csv:"lastlink"`
+	Uncertain    string `csv:"uncertain"`
+	Readings     string `csv:"readings"`
 }
 
 func (p *PageReport) String() string {
@@ -55,6 +57,8 @@ func (g *Ingester) ReportOnProcessedDir(exam, dir string, showOK bool, showMark
 
 	errorPageReports := []PageReport{}
 
+	readingPageReports := []PageReport{}
+
 	destMap := make(map[string]map[int]PageReport)
 
 	for _, file := range files {
@@ -103,10 +107,38 @@ func (g *Ingester) ReportOnProcessedDir(exam, dir string, showOK bool, showMark
 				errorPageReports = append(errorPageReports, pr)
 			}
 
+			// optical boxes too faint to call are flagged whatever the page status
+			if pr.Uncertain != "" {
+				tokens = append(tokens, "UNSURE: "+pr.String()+" Boxes: "+pr.Uncertain)
+				pr.Error = "UNSURE-BOX"
+				errorPageReports = append(errorPageReports, pr)
+			}
+
+			// handwriting we read is not an error, so it goes in a report of its own,
+			// to compare with what was typed
+			if pr.Readings != "" {
+				tokens = append(tokens, "READ: "+pr.String()+" Readings: "+pr.Readings)
+				readingPageReports = append(readingPageReports, pr)
+			}
+
 		}
 
 	}
 
+	if len(readingPageReports) > 0 {
+
+		readingsPath := filepath.Join(g.GetExamDir(exam, reports),
+			fmt.Sprintf("%s-%s-readings-%d.csv",
+				shortenAssignment(exam),
+				filepath.Base(dir),
+				time.Now().Unix()))
+
+		err = WritePageReportsToCSV(readingPageReports, readingsPath)
+		if err != nil {
+			fmt.Printf("Error writing readings report to %s\n", readingsPath)
+		}
+	}
+
 	if !reconcile {
 		return tokens, nil
 	}
@@ -255,6 +287,8 @@ func GetPageSummaryMap(pdMap map[int]pagedata.PageData) (map[int]PageReport, err
 			IsLinked:     linkMap[page].IsLinked,
 			FirstLink:    linkMap[page].First,
 			LastLink:     linkMap[page].Last,
+			Uncertain:    strings.Join(getUncertainBoxes(pd.Current.Data), " "),
+			Readings:     strings.Join(getOpticalReadings(pd.Current.Data), " "),
 		}
 
 	}
@@ -5,13 +5,81 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/timdrysdale/gradex-cli/csv"
+	"github.com/timdrysdale/gradex-cli/pagedata"
 	"github.com/timdrysdale/gradex-cli/util"
 )
 
+// Moderation sampling policy. Scripts are selected for active moderation
+// because their total mark is in a band of interest (e.g. all fails, all
+// borderlines), to make sure each marker has some scripts looked at, and then
+// at random to make up the required percentage of the cohort. The seed is
+// recorded in the sampling-rationale report, so the sample can be reproduced.
+type ModerationPolicy struct {
+	Seed         int64
+	MinFiles     int
+	MinPercent   float64
+	Bands        []MarkBand
+	PerMarkerMin int //at least this many scripts from each marker
+}
+
+type MarkBand struct {
+	Name string
+	Low  float64 //inclusive
+	High float64 //exclusive
+}
+
+type moderationScript struct {
+	Path    string
+	Total   float64
+	Markers []string
+	HasMark bool
+}
+
+// ParseMarkBand reads a band in the form name:low:high e.g. fail:0:40
+func ParseMarkBand(spec string) (MarkBand, error) {
+
+	tokens := strings.Split(spec, ":")
+
+	if len(tokens) != 3 || tokens[0] == "" {
+		return MarkBand{}, fmt.Errorf("can't understand mark band %s, want name:low:high", spec)
+	}
+
+	low, err := strconv.ParseFloat(tokens[1], 64)
+	if err != nil {
+		return MarkBand{}, fmt.Errorf("mark band %s needs a number for low", spec)
+	}
+
+	high, err := strconv.ParseFloat(tokens[2], 64)
+	if err != nil {
+		return MarkBand{}, fmt.Errorf("mark band %s needs a number for high", spec)
+	}
+
+	if high <= low {
+		return MarkBand{}, fmt.Errorf("mark band %s needs high to be more than low", spec)
+	}
+
+	return MarkBand{Name: tokens[0], Low: low, High: high}, nil
+}
+
 func (g *Ingester) SplitForModeration(exam string, minFiles int, minPercent float64) error {
 
+	return g.SplitForModerationWithPolicy(exam, ModerationPolicy{
+		Seed:       time.Now().Unix(),
+		MinFiles:   minFiles,
+		MinPercent: minPercent,
+	})
+}
+
+func (g *Ingester) SplitForModerationWithPolicy(exam string, policy ModerationPolicy) error {
+
 	dir := g.GetExamDir(exam, markerProcessed)
 
 	files, err := g.GetFileList(dir)
@@ -20,27 +88,27 @@ func (g *Ingester) SplitForModeration(exam string, minFiles int, minPercent floa
 		return err
 	}
 
-	pdfFiles := make(map[string]bool)
-
-	inputCount := 0
+	scripts := []moderationScript{}
 
 	for _, file := range files {
 
 		if g.IsPDF(file) {
-			pdfFiles[file] = false
-			inputCount++
+			scripts = append(scripts, g.getModerationScript(file))
 		}
 	}
 
-	fmt.Printf("We think we have %d files to split for moderating\n", inputCount)
+	inputCount := len(scripts)
 
-	reqdPercent := requiredPercent(len(pdfFiles), minFiles, minPercent)
+	fmt.Printf("We think we have %d files to split for moderating\n", inputCount)
 
-	predictedActiveCount := float64(inputCount) * reqdPercent / 100
+	reasons := selectForModeration(scripts, policy)
 
-	fmt.Printf("We think we want %f percent of the files, which equates to  %f files, to be active; minpercent was %f\n", reqdPercent, predictedActiveCount, minPercent)
+	pdfFiles := make(map[string]bool)
 
-	selectByPercent(&pdfFiles, reqdPercent)
+	for _, script := range scripts {
+		_, isActive := reasons[script.Path]
+		pdfFiles[script.Path] = isActive
+	}
 
 	util.PrettyPrintStruct(pdfFiles)
 
@@ -55,9 +123,22 @@ func (g *Ingester) SplitForModeration(exam string, minFiles int, minPercent floa
 		}
 	}
 
-	predictedInactiveCount := inputCount - int(math.Round(predictedActiveCount))
+	fmt.Printf("We have %d active files, and %d inactive files, using seed %d\n", activeCount, inactiveCount, policy.Seed)
+
+	err = g.writeSamplingReport(exam, scripts, reasons, policy)
+	if err != nil {
+		g.logger.Error().
+			Str("error", err.Error()).
+			Msg("Could not write sampling-rationale report")
+		return err
+	}
+
+	g.logger.Info().
+		Int64("seed", policy.Seed).
+		Int("active", activeCount).
+		Int("inactive", inactiveCount).
+		Msg("Selected scripts for moderation")
 
-	fmt.Printf("We think we have %d active files (wanted %d), and %d inactive files (should be %d)\n", activeCount, int(predictedActiveCount), inactiveCount, predictedInactiveCount)
 	numErrors := 0
 	newCount := 0
 	for k, v := range pdfFiles {
@@ -146,12 +227,9 @@ func requiredPercent(numFiles, minFiles int, minPercent float64) float64 {
 
 }
 
-func selectByPercent(fileMap *map[string]bool, percent float64) {
-
-	numSelected := int(0)
-	s := rand.NewSource(time.Now().Unix())
+func selectByPercent(fileMap *map[string]bool, percent float64, seed int64) {
 
-	r := rand.New(s) // initialize local pseudorandom generator
+	r := rand.New(rand.NewSource(seed)) // initialize local pseudorandom generator
 
 	numRequired := int(math.Ceil(float64(len(*fileMap))*percent/100.0 - 1e-9))
 
@@ -172,30 +250,214 @@ func selectByPercent(fileMap *map[string]bool, percent float64) {
 
 	}
 
-	// perform the smallest number of selections possible
-	// Note that inverting the percentage is all we need to do
-	// We do NOT need to invert the logic values afterwards
-	// that will just mean we select fewer than intended
-	if percent > 50 {
-		percent = 100 - percent
+	// sort before shuffling, because map order is random,
+	// and we want the same selection every time for a given seed
+	keys := []string{}
+
+	for k, _ := range *fileMap {
+		keys = append(keys, k)
 	}
 
-LOOP:
-	for {
+	sort.Strings(keys)
 
-		for k, _ := range *fileMap {
+	r.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+
+	for _, k := range keys[:numRequired] {
+		(*fileMap)[k] = true
+	}
 
-			if r.Float64()*100 < percent {
-				if (*fileMap)[k] == false {
-					(*fileMap)[k] = true
-					numSelected++
+}
+
+// selectForModeration returns the reasons for selecting each active script
+func selectForModeration(scripts []moderationScript, policy ModerationPolicy) map[string][]string {
+
+	reasons := make(map[string][]string)
+
+	sorted := make([]moderationScript, len(scripts))
+	copy(sorted, scripts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	// all scripts in the bands of interest
+	for _, band := range policy.Bands {
+		for _, script := range sorted {
+			if script.HasMark && script.Total >= band.Low && script.Total < band.High {
+				reasons[script.Path] = append(reasons[script.Path],
+					fmt.Sprintf("band %s [%g,%g)", band.Name, band.Low, band.High))
+			}
+		}
+	}
+
+	// make up the numbers for each marker
+	if policy.PerMarkerMin > 0 {
+
+		byMarker := make(map[string][]string)
+
+		for _, script := range sorted {
+			for _, marker := range script.Markers {
+				byMarker[marker] = append(byMarker[marker], script.Path)
+			}
+		}
+
+		markers := []string{}
+		for marker := range byMarker {
+			markers = append(markers, marker)
+		}
+		sort.Strings(markers)
+
+		for i, marker := range markers {
+
+			have := 0
+			candidates := make(map[string]bool)
+
+			for _, path := range byMarker[marker] {
+				if _, ok := reasons[path]; ok {
+					have++
+				} else {
+					candidates[path] = false
 				}
 			}
-			if numSelected >= numRequired {
-				break LOOP
+
+			need := policy.PerMarkerMin - have
+
+			if need <= 0 || len(candidates) == 0 {
+				continue
 			}
+
+			selectByPercent(&candidates, 100*float64(need)/float64(len(candidates)), policy.Seed+int64(i)+1)
+
+			for path, selected := range candidates {
+				if selected {
+					reasons[path] = append(reasons[path], fmt.Sprintf("marker %s", marker))
+				}
+			}
+		}
+	}
+
+	// random sample of the cohort, on top of everything else
+	if len(sorted) > 0 {
+
+		percent := requiredPercent(len(sorted), policy.MinFiles, policy.MinPercent)
+
+		need := int(math.Ceil(float64(len(sorted))*percent/100.0 - 1e-9))
+
+		candidates := make(map[string]bool)
+
+		for _, script := range sorted {
+			if _, ok := reasons[script.Path]; !ok {
+				candidates[script.Path] = false
+			}
+		}
+
+		if need > len(candidates) {
+			need = len(candidates)
 		}
 
+		if need > 0 {
+
+			selectByPercent(&candidates, 100*float64(need)/float64(len(candidates)), policy.Seed)
+
+			for path, selected := range candidates {
+				if selected {
+					reasons[path] = append(reasons[path], fmt.Sprintf("random %g%%", percent))
+				}
+			}
+		}
 	}
 
+	return reasons
+}
+
+// getModerationScript reads the total mark and markers from the pagedata
+// of a merged, marked script
+func (g *Ingester) getModerationScript(path string) moderationScript {
+
+	script := moderationScript{
+		Path:    path,
+		Markers: []string{},
+	}
+
+	pdMap, err := pagedata.UnMarshalAllFromFile(path)
+
+	if err != nil {
+		g.logger.Error().
+			Str("file", path).
+			Str("error", err.Error()).
+			Msg("Could not read pagedata for moderation sampling, so no marks or marker known")
+		return script
+	}
+
+	markers := make(map[string]bool)
+
+	for _, pd := range pdMap {
+		for _, previous := range pd.Previous {
+			if strings.HasPrefix(previous.Process.Name, "mark-bar") && previous.Process.For != "" {
+				markers[GetShortActorName(previous.Process.For)] = true
+			}
+		}
+	}
+
+	for marker := range markers {
+		script.Markers = append(script.Markers, marker)
+	}
+
+	sort.Strings(script.Markers)
+
+	qMap := getQMap(selectPageDetailsWithMarks(pdMap))
+
+	for _, mark := range qMap {
+		if v, err := strconv.ParseFloat(mark, 64); err == nil {
+			script.Total += v
+			script.HasMark = true
+		}
+	}
+
+	return script
+}
+
+func (g *Ingester) writeSamplingReport(exam string, scripts []moderationScript, reasons map[string][]string, policy ModerationPolicy) error {
+
+	s := csv.New()
+
+	s.SetFixedHeader([]string{"file", "markers", "total", "moderate", "reasons", "seed"})
+
+	sorted := make([]moderationScript, len(scripts))
+	copy(sorted, scripts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	for _, script := range sorted {
+
+		line := s.Add()
+
+		line.Add("file", filepath.Base(script.Path))
+		line.Add("markers", strings.Join(script.Markers, " "))
+
+		if script.HasMark {
+			line.Add("total", fmt.Sprintf("%g", script.Total))
+		}
+
+		if why, ok := reasons[script.Path]; ok {
+			line.Add("moderate", "active")
+			line.Add("reasons", strings.Join(why, "; "))
+		} else {
+			line.Add("moderate", "inactive")
+		}
+
+		line.Add("seed", strconv.FormatInt(policy.Seed, 10))
+	}
+
+	reportBase := fmt.Sprintf("ModerationSample-%s-%d.csv", shortenAssignment(exam), time.Now().Unix())
+	reportPath := filepath.Join(g.GetExamDir(exam, reports), reportBase)
+
+	f, err := os.OpenFile(reportPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = s.WriteCSV(f)
+
+	fmt.Printf("Sampling rationale: %s\n", reportPath)
+
+	return err
 }
@@ -3,6 +3,7 @@ package ingester
 import (
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestSelect(t *testing.T) {
 		fm[string(char)] = false
 	}
 
-	selectByPercent(&fm, 20)
+	selectByPercent(&fm, 20, 1)
 
 	assert.Equal(t, 6, countSelected(fm))
 
@@ -31,12 +32,12 @@ func TestSelect(t *testing.T) {
 		fm[string(char)] = false
 	}
 
-	selectByPercent(&fm, 10)
+	selectByPercent(&fm, 10, 1)
 
 	assert.Equal(t, 3, countSelected(fm))
 
 	// this should just select all and return
-	selectByPercent(&fm, 99)
+	selectByPercent(&fm, 99, 1)
 	assert.Equal(t, 26, countSelected(fm))
 
 }
@@ -106,7 +107,7 @@ func TestSelectSetsBelow10(t *testing.T) {
 
 		assert.Equal(t, 0, countActive(fm))
 
-		selectByPercent(&fm, requiredPercent(setSize, 10, 10))
+		selectByPercent(&fm, requiredPercent(setSize, 10, 10), int64(setSize))
 
 		expectedActiveCount := setSize
 
@@ -139,7 +140,7 @@ func TestSelectSets11To100(t *testing.T) {
 
 		assert.Equal(t, 0, countActive(fm))
 
-		selectByPercent(&fm, requiredPercent(setSize, 10, 10))
+		selectByPercent(&fm, requiredPercent(setSize, 10, 10), int64(setSize))
 
 		countOK := expectedActiveCount == countActive(fm)
 
@@ -169,7 +170,7 @@ func TestSelectSetsAbove100(t *testing.T) {
 
 		assert.Equal(t, 0, countActive(fm))
 
-		selectByPercent(&fm, requiredPercent(setSize, 10, 10))
+		selectByPercent(&fm, requiredPercent(setSize, 10, 10), int64(setSize))
 
 		expectedActiveCount := int(math.Ceil(0.1 * float64(setSize)))
 
@@ -209,3 +210,67 @@ func countSelected(fileMap map[string]bool) int {
 
 	return selected
 }
+
+func TestSelectForModeration(t *testing.T) {
+
+	scripts := []moderationScript{}
+
+	for i := 0; i < 40; i++ {
+		marker := "ABC"
+		if i >= 30 {
+			marker = "DEF"
+		}
+		scripts = append(scripts, moderationScript{
+			Path:    fmt.Sprintf("X-B%03d.pdf", i),
+			Total:   float64(i * 2),
+			Markers: []string{marker},
+			HasMark: true,
+		})
+	}
+
+	policy := ModerationPolicy{
+		Seed:         7,
+		MinFiles:     4,
+		MinPercent:   10,
+		Bands:        []MarkBand{{Name: "fail", Low: 0, High: 10}},
+		PerMarkerMin: 3,
+	}
+
+	reasons := selectForModeration(scripts, policy)
+
+	// all five fails (0,2,4,6,8), all from ABC
+	for i := 0; i < 5; i++ {
+		assert.Contains(t, reasons[fmt.Sprintf("X-B%03d.pdf", i)][0], "band fail")
+	}
+
+	byReason := make(map[string]int)
+	for _, why := range reasons {
+		for _, reason := range why {
+			byReason[strings.Fields(reason)[0]]++
+		}
+	}
+
+	assert.Equal(t, 5, byReason["band"])
+	assert.Equal(t, 3, byReason["marker"]) // ABC already has 5 fails, DEF needs 3
+	assert.Equal(t, 4, byReason["random"])
+	assert.Equal(t, 12, len(reasons))
+
+	// same seed, same sample
+	assert.Equal(t, reasons, selectForModeration(scripts, policy))
+
+	// a different seed still follows the policy
+	policy.Seed = 8
+	assert.Equal(t, 12, len(selectForModeration(scripts, policy)))
+}
+
+func TestParseMarkBand(t *testing.T) {
+
+	band, err := ParseMarkBand("borderline:38:42")
+	assert.NoError(t, err)
+	assert.Equal(t, MarkBand{Name: "borderline", Low: 38, High: 42}, band)
+
+	for _, bad := range []string{"fail", "fail:0", ":0:40", "fail:x:40", "fail:40:0"} {
+		_, err = ParseMarkBand(bad)
+		assert.Error(t, err)
+	}
+}
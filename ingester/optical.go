@@ -1 +1,169 @@
 package ingester
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+// Handwritten digits are read from the data boxes that hold numbers (question
+// numbers, marks, and subtotals), and stored next to the -optical key, along
+// with how confident the reading is. A reading at or above
+// opticalReadingConfidence is used in place of a typed value, so a script whose
+// handwritten marks can all be read confidently does not need the enter stage.
+// Every reading is listed in the readings report on the processed papers, so
+// they can be compared with what was typed.
+
+var numericDataBox = regexp.MustCompile("^(q[0-9]*-(mark|number)|subtotal-[0-9]*)$")
+
+func isNumericDataBox(id string) bool {
+	return numericDataBox.MatchString(id)
+}
+
+// getConfidentReadings returns the optical readings we can trust,
+// keyed by the textfield they were read from, e.g. tf-q1-mark
+func getConfidentReadings(data []pagedata.Field) map[string]string {
+
+	readings := make(map[string]string)
+	confident := make(map[string]bool)
+
+	for _, item := range data {
+
+		switch {
+
+		case strings.HasSuffix(item.Key, opticalReadingSuffix):
+			readings[strings.TrimSuffix(item.Key, opticalReadingSuffix)] = item.Value
+
+		case strings.HasSuffix(item.Key, opticalConfidenceSuffix):
+			c, err := strconv.ParseFloat(item.Value, 64)
+			if err == nil && c >= opticalReadingConfidence {
+				confident[strings.TrimSuffix(item.Key, opticalConfidenceSuffix)] = true
+			}
+		}
+	}
+
+	for key, reading := range readings {
+		if !confident[key] || reading == "" {
+			delete(readings, key)
+		}
+	}
+
+	return readings
+}
+
+// fillFromOpticalReadings returns a copy of the data where textfields left
+// empty are filled with the confident handwritten reading from the same box
+func fillFromOpticalReadings(data []pagedata.Field) []pagedata.Field {
+
+	readings := getConfidentReadings(data)
+
+	filled := []pagedata.Field{}
+
+	for _, item := range data {
+		if reading, ok := readings[item.Key]; ok && item.Value == "" {
+			item.Value = reading
+		}
+		filled = append(filled, item)
+	}
+
+	return filled
+}
+
+// getOpticalReadings lists the handwriting read on the page, for review,
+// e.g. tf-q1-mark=7.5(0.950), with what was typed if it differs,
+// e.g. tf-q1-mark=7.5(0.950)typed:7
+func getOpticalReadings(data []pagedata.Field) []string {
+
+	readings := make(map[string]string)
+	confidence := make(map[string]string)
+	typed := make(map[string]string)
+
+	for _, item := range data {
+
+		switch {
+
+		case strings.HasSuffix(item.Key, opticalReadingSuffix):
+			readings[strings.TrimSuffix(item.Key, opticalReadingSuffix)] = item.Value
+
+		case strings.HasSuffix(item.Key, opticalConfidenceSuffix):
+			confidence[strings.TrimSuffix(item.Key, opticalConfidenceSuffix)] = item.Value
+
+		case strings.HasPrefix(item.Key, textFieldPrefix) && !strings.Contains(item.Key, opticalSuffix):
+			typed[item.Key] = item.Value
+		}
+	}
+
+	keys := []string{}
+	for key := range readings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	flagged := []string{}
+
+	for _, key := range keys {
+
+		reading := key + "=" + readings[key] + "(" + confidence[key] + ")"
+
+		if typed[key] != "" && typed[key] != readings[key] {
+			reading = reading + "typed:" + typed[key]
+		}
+
+		flagged = append(flagged, reading)
+	}
+
+	return flagged
+}
+
+// opticalScoreFields records the raw score for a box, and flags it
+// if it is in the uncertain band, so someone checks it by eye
+func opticalScoreFields(id string, score optical.BoxScore) []pagedata.Field {
+
+	fields := []pagedata.Field{
+		{
+			Key:   textFieldPrefix + id + opticalFillSuffix,
+			Value: fmt.Sprintf("%.4f", score.Fill),
+		},
+		{
+			Key:   textFieldPrefix + id + opticalFillConfidenceSuffix,
+			Value: fmt.Sprintf("%.3f", score.Confidence),
+		},
+	}
+
+	if score.Uncertain {
+		fields = append(fields, pagedata.Field{
+			Key:   textFieldPrefix + id + opticalUncertainSuffix,
+			Value: markUncertain,
+		})
+	}
+
+	return fields
+}
+
+// isOpticalScore is true for the keys that hold raw scores, which always have
+// a value, so must not be mistaken for something the marker wrote
+func isOpticalScore(key string) bool {
+	return strings.HasSuffix(key, opticalFillSuffix) ||
+		strings.HasSuffix(key, opticalFillConfidenceSuffix) ||
+		strings.HasSuffix(key, opticalUncertainSuffix)
+}
+
+// getUncertainBoxes returns the textfields whose optical box
+// was too faint to call either way, e.g. tf-q1-mark
+func getUncertainBoxes(data []pagedata.Field) []string {
+
+	uncertain := []string{}
+
+	for _, item := range data {
+		if strings.HasSuffix(item.Key, opticalUncertainSuffix) && item.Value != "" {
+			uncertain = append(uncertain, strings.TrimSuffix(item.Key, opticalUncertainSuffix))
+		}
+	}
+
+	return uncertain
+}
@@ -0,0 +1,203 @@
+package ingester
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+// Calibrating the optical boxes for a template: every box on the blank sample
+// pages is known to be empty, and every box on the filled sample pages is known
+// to have been marked. We try each shrink and background, and keep whichever
+// calls the most samples correctly, with the widest gap between blank and
+// filled. Samples can be images, or PDFs, which are converted the same way as
+// papers are when they are flattened.
+
+var calibrationShrinks = []int{0, 5, 10, 15, 20, 25, 30}
+
+// CalibrateOptical finds the best optical settings for the boxes in this spread
+// of the current overlay template, and saves them as its optical profile. If an
+// exam is given, its own layout is used if it has one, and the profile is saved
+// for the exam (see OpticalProfilePath).
+func (g *Ingester) CalibrateOptical(exam, spread, blankDir, filledDir string) (OpticalProfile, error) {
+
+	layout, templateDirs := g.examTemplate(exam, g.OverlayLayoutSVG())
+
+	logger := g.logger.With().
+		Str("process", "optical-calibrate").
+		Str("template", layout).
+		Str("spread", spread).
+		Logger()
+
+	profile, err := g.GetOpticalProfile(exam)
+	if err != nil && !os.IsNotExist(err) {
+		return profile, err
+	}
+
+	tempDir, err := ioutil.TempDir("", "gradex-calibrate")
+	if err != nil {
+		return profile, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	blank, err := g.loadCalibrationSamples(blankDir, filepath.Join(tempDir, "blank"))
+	if err != nil {
+		logger.Error().Str("dir", blankDir).Str("error", err.Error()).Msg("Could not load blank samples")
+		return profile, err
+	}
+
+	filled, err := g.loadCalibrationSamples(filledDir, filepath.Join(tempDir, "filled"))
+	if err != nil {
+		logger.Error().Str("dir", filledDir).Str("error", err.Error()).Msg("Could not load filled samples")
+		return profile, err
+	}
+
+	if len(blank) < 1 || len(filled) < 1 {
+		return profile, fmt.Errorf("need blank and filled sample pages, but found %d blank and %d filled", len(blank), len(filled))
+	}
+
+	var best optical.Calibration
+	found := false
+
+	for _, vanilla := range []bool{true, false} {
+		for _, shrink := range calibrationShrinks {
+
+			blankFills, err := getSampleFills(layout, spread, blank, vanilla, shrink, templateDirs)
+			if err != nil {
+				return profile, err
+			}
+
+			filledFills, err := getSampleFills(layout, spread, filled, vanilla, shrink, templateDirs)
+			if err != nil {
+				return profile, err
+			}
+
+			c, err := optical.CalibrateThresholds(blankFills, filledFills)
+			if err != nil {
+				return profile, fmt.Errorf("no boxes found in spread %s of %s", spread, g.overlayTemplatePath)
+			}
+
+			logger.Info().
+				Bool("vanilla", vanilla).
+				Int("shrink", shrink).
+				Int("errors", c.Errors).
+				Float64("separation", c.Separation).
+				Msg("Tried optical settings")
+
+			if !found || c.Better(best) {
+				best = c
+				found = true
+				profile.Shrink = shrink
+				profile.Vanilla = vanilla
+				profile.BlankBoxes = len(blankFills)
+				profile.FilledBoxes = len(filledFills)
+			}
+		}
+	}
+
+	profile.Calibrated = true
+	profile.Spread = spread
+	profile.Thresholds = best.Thresholds
+	profile.Errors = best.Errors
+	profile.Separation = best.Separation
+
+	err = g.SaveOpticalProfile(exam, profile)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not save optical profile")
+		return profile, err
+	}
+
+	return profile, nil
+}
+
+// loadCalibrationSamples reads every image in the dir, and
+// every page of every PDF, converting them in tempDir
+func (g *Ingester) loadCalibrationSamples(dir, tempDir string) ([]image.Image, error) {
+
+	samples := []image.Image{}
+
+	files, err := g.GetFileList(dir)
+	if err != nil {
+		return samples, err
+	}
+
+	imagePaths := []string{}
+
+	for _, file := range files {
+
+		switch strings.ToLower(filepath.Ext(file)) {
+
+		case ".jpg", ".jpeg", ".png":
+			imagePaths = append(imagePaths, file)
+
+		case ".pdf":
+			jpegPath := filepath.Join(tempDir, strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)))
+
+			err := EnsureDirAll(jpegPath)
+			if err != nil {
+				return samples, err
+			}
+
+			err = ConvertPDFToJPEGs(file, jpegPath, filepath.Join(jpegPath, "page%04d.jpg"))
+			if err != nil {
+				return samples, err
+			}
+
+			pages, err := g.GetFileList(jpegPath)
+			if err != nil {
+				return samples, err
+			}
+
+			imagePaths = append(imagePaths, pages...)
+		}
+	}
+
+	for _, path := range imagePaths {
+
+		f, err := os.Open(path)
+		if err != nil {
+			return samples, err
+		}
+
+		im, _, err := image.Decode(f)
+		f.Close()
+
+		if err != nil {
+			return samples, fmt.Errorf("can't read %s: %s", path, err.Error())
+		}
+
+		samples = append(samples, im)
+	}
+
+	return samples, nil
+}
+
+// getSampleFills scores every box in the spread on every sample page
+func getSampleFills(layoutPath, spread string, samples []image.Image, vanilla bool, shrink int, templateDirs []string) ([]float64, error) {
+
+	fills := []float64{}
+
+	for _, im := range samples {
+
+		bounds := im.Bounds()
+
+		boxes, err := parsesvg.GetImageBoxesForTextFieldsFromTemplate(layoutPath, spread, bounds.Dx(), bounds.Dy(), vanilla, -1*shrink, templateDirs...)
+		if err != nil {
+			return fills, err
+		}
+
+		for _, box := range boxes {
+			fills = append(fills, optical.ScoreBox(im, box, optical.DefaultThresholds).Fill)
+		}
+	}
+
+	return fills, nil
+}
@@ -0,0 +1,151 @@
+package ingester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/optical"
+)
+
+// Each overlay template can have its own optical profile, stored next to it
+// as <layout>-optical.json, because boxes of a different size or shape, or a
+// different background, need different thresholds. Templates without a profile
+// use the optical package defaults. A calibrated profile (see CalibrateOptical)
+// also sets the box shrink and background, in place of the command line flags.
+// An exam with its own template folder can have its own profile there, which
+// is used in place of the shared one, like the layout and ladders (see
+// examTemplate). A profile for an exam with its own layout is always kept
+// with that layout, because the shared profile was made for other boxes.
+
+type OpticalProfile struct {
+	Template    string             `json:"template"`
+	Thresholds  optical.Thresholds `json:"thresholds"`
+	Calibrated  bool               `json:"calibrated"`
+	Spread      string             `json:"spread,omitempty"`
+	Shrink      int                `json:"shrink"`
+	Vanilla     bool               `json:"vanilla"`
+	BlankBoxes  int                `json:"blankBoxes,omitempty"`
+	FilledBoxes int                `json:"filledBoxes,omitempty"`
+	Errors      int                `json:"errors,omitempty"`
+	Separation  float64            `json:"separation,omitempty"`
+}
+
+func DefaultOpticalProfile(template string) OpticalProfile {
+	return OpticalProfile{
+		Template:   template,
+		Thresholds: optical.DefaultThresholds,
+	}
+}
+
+// OpticalProfilePath is the profile for the current overlay template,
+// for the exam, which can be "" for the shared profile
+func (g *Ingester) OpticalProfilePath(exam string) string {
+
+	base := strings.TrimSuffix(g.overlayTemplatePath, filepath.Ext(g.overlayTemplatePath))
+	name := base + "-optical.json"
+
+	layout, templateDirs := g.examTemplate(exam, g.OverlayLayoutSVG())
+
+	if len(templateDirs) > 0 {
+
+		examProfile := filepath.Join(templateDirs[0], name)
+
+		if _, err := os.Stat(examProfile); err == nil || layout != g.OverlayLayoutSVG() {
+			return examProfile
+		}
+	}
+
+	return filepath.Join(g.OverlayTemplate(), name)
+}
+
+// GetOpticalProfile returns the profile for the current overlay template,
+// or the default profile (and an os.IsNotExist error) if there is none
+func (g *Ingester) GetOpticalProfile(exam string) (OpticalProfile, error) {
+
+	profile := DefaultOpticalProfile(g.overlayTemplatePath)
+
+	contents, err := ioutil.ReadFile(g.OpticalProfilePath(exam))
+	if err != nil {
+		return profile, err
+	}
+
+	err = json.Unmarshal(contents, &profile)
+	if err != nil {
+		return DefaultOpticalProfile(g.overlayTemplatePath), err
+	}
+
+	err = profile.Thresholds.Validate()
+	if err != nil {
+		return DefaultOpticalProfile(g.overlayTemplatePath), fmt.Errorf("%s: %s", g.OpticalProfilePath(exam), err.Error())
+	}
+
+	return profile, nil
+}
+
+func (g *Ingester) SaveOpticalProfile(exam string, profile OpticalProfile) error {
+
+	err := profile.Thresholds.Validate()
+	if err != nil {
+		return err
+	}
+
+	profile.Template = g.overlayTemplatePath
+
+	contents, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(g.OpticalProfilePath(exam), contents, 0644)
+	if err != nil {
+		return err
+	}
+
+	g.logger.Info().
+		Str("path", g.OpticalProfilePath(exam)).
+		Str("template", profile.Template).
+		Msg("Saved optical profile")
+
+	return nil
+}
+
+// UseOpticalProfile applies the saved profile for the current overlay
+// template and exam, if there is one, otherwise keeps the current settings
+func (g *Ingester) UseOpticalProfile(exam string) error {
+
+	profile, err := g.GetOpticalProfile(exam)
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		g.logger.Error().
+			Str("path", g.OpticalProfilePath(exam)).
+			Str("error", err.Error()).
+			Msg("Could not use optical profile")
+		return err
+	}
+
+	g.SetOpticalThresholds(profile.Thresholds)
+
+	if profile.Calibrated {
+		g.SetOpticalShrink(profile.Shrink)
+		g.SetBackgroundIsVanilla(profile.Vanilla)
+	}
+
+	return nil
+}
+
+func (g *Ingester) SetOpticalThresholds(t optical.Thresholds) {
+	g.logger.Info().
+		Float64("low", t.Low).
+		Float64("mark", t.Mark).
+		Float64("high", t.High).
+		Msg(fmt.Sprintf("Changing optical thresholds from %v to %v", g.opticalThresholds, t))
+	g.opticalThresholds = t
+}
@@ -0,0 +1,99 @@
+package ingester
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+func TestIsNumericDataBox(t *testing.T) {
+
+	for _, id := range []string{"q1-mark", "q12-number", "subtotal-00", "subtotal-14"} {
+		assert.True(t, isNumericDataBox(id), id)
+	}
+
+	for _, id := range []string{"q1-section", "page-ok", "page-bad", "q1-mark-optical", "subtotal"} {
+		assert.False(t, isNumericDataBox(id), id)
+	}
+}
+
+func TestOpticalReadings(t *testing.T) {
+
+	data := []pagedata.Field{
+		{Key: "tf-q1-mark", Value: ""},
+		{Key: "tf-q1-mark-optical", Value: markDetected},
+		{Key: "tf-q1-mark-optical-reading", Value: "7.5"},
+		{Key: "tf-q1-mark-optical-confidence", Value: "0.950"},
+		{Key: "tf-q2-mark", Value: ""},
+		{Key: "tf-q2-mark-optical", Value: markDetected},
+		{Key: "tf-q2-mark-optical-reading", Value: "1"},
+		{Key: "tf-q2-mark-optical-confidence", Value: "0.400"},
+		{Key: "tf-q3-mark", Value: "4"},
+		{Key: "tf-q3-mark-optical", Value: markDetected},
+		{Key: "tf-q3-mark-optical-reading", Value: "9"},
+		{Key: "tf-q3-mark-optical-confidence", Value: "0.990"},
+		{Key: "tf-q1-number", Value: "1"},
+		{Key: "tf-q2-number", Value: "2"},
+		{Key: "tf-q3-number", Value: "3"},
+	}
+
+	assert.Equal(t, map[string]string{"tf-q1-mark": "7.5", "tf-q3-mark": "9"}, getConfidentReadings(data))
+
+	filled := fillFromOpticalReadings(data)
+
+	assert.Equal(t, "7.5", filled[0].Value)
+	assert.Equal(t, "", filled[4].Value)
+	assert.Equal(t, "4", filled[8].Value) //typed values win
+	assert.Equal(t, "", data[0].Value)    //original untouched
+
+	assert.Equal(t, []string{
+		"tf-q1-mark=7.5(0.950)",
+		"tf-q2-mark=1(0.400)",
+		"tf-q3-mark=9(0.990)typed:4",
+	}, getOpticalReadings(data))
+
+	assert.Equal(t, map[string]string{"1": "7.5", "2": "0", "3": "4"}, getQMap([]pagedata.PageDetail{{Data: data}}))
+
+	// all readings are listed in the report, so they can be checked
+	report, _ := GetPageSummaryMap(map[int]pagedata.PageData{1: {Current: pagedata.PageDetail{Data: data}}})
+	assert.Equal(t, "tf-q1-mark=7.5(0.950) tf-q2-mark=1(0.400) tf-q3-mark=9(0.990)typed:4", report[1].Readings)
+}
+
+func TestOpticalScoreFields(t *testing.T) {
+
+	faint := opticalScoreFields("q1-mark", optical.BoxScore{Marked: false, Uncertain: true, Fill: 0.015, Confidence: 0.75})
+
+	assert.Equal(t, []pagedata.Field{
+		{Key: "tf-q1-mark-optical-fill", Value: "0.0150"},
+		{Key: "tf-q1-mark-optical-fill-confidence", Value: "0.750"},
+		{Key: "tf-q1-mark-optical-uncertain", Value: markUncertain},
+	}, faint)
+
+	blank := opticalScoreFields("page-ok", optical.BoxScore{Fill: 0.001, Confidence: 1})
+
+	assert.Equal(t, 2, len(blank))
+
+	for _, item := range append(faint, blank...) {
+		assert.True(t, isOpticalScore(item.Key), item.Key)
+	}
+
+	for _, key := range []string{"tf-q1-mark", "tf-q1-mark-optical", "tf-q1-mark-optical-reading", "tf-q1-mark-optical-confidence"} {
+		assert.False(t, isOpticalScore(key), key)
+	}
+
+	assert.Equal(t, []string{"tf-q1-mark"}, getUncertainBoxes(append(faint, blank...)))
+
+	// scores always have a value, but don't mean the page was marked
+	unmarked := []pagedata.Field{
+		{Key: "tf-page-ok", Value: ""},
+		{Key: "tf-q1-mark", Value: ""},
+	}
+
+	withScores := append(append(unmarked, faint...), blank...)
+
+	assert.Equal(t,
+		summarisePage(pagedata.PageData{Current: pagedata.PageDetail{Data: unmarked}}).Status,
+		summarisePage(pagedata.PageData{Current: pagedata.PageDetail{Data: withScores}}).Status)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/timdrysdale/gradex-cli/comment"
 	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/image"
 	"github.com/timdrysdale/gradex-cli/merge"
 	"github.com/timdrysdale/gradex-cli/optical"
 	"github.com/timdrysdale/gradex-cli/pagedata"
@@ -136,6 +137,10 @@ func (g *Ingester) OverlayPapers(oc OverlayCommand, logger *zerolog.Logger) erro
 			continue
 		}
 
+		if oc.OnlyFiles != nil && !oc.OnlyFiles[filepath.Base(inPath)] {
+			continue
+		}
+
 		// see if we have a cover file
 		coverPath := ""
 
@@ -199,12 +204,14 @@ func (g *Ingester) OverlayPapers(oc OverlayCommand, logger *zerolog.Logger) erro
 
 				for key, value := range fields {
 
-					data =
-						append(data,
-							pagedata.Field{
-								Key:   util.SafeText(textFieldPrefix + key), //unlikely to get unicode in the fields, but, protect anyway.
-								Value: util.SafeText(value.Value),
-							})
+					for _, field := range fieldData(key, value) {
+						data =
+							append(data,
+								pagedata.Field{
+									Key:   util.SafeText(textFieldPrefix + field.Key), //keeps any unicode, e.g. accented names
+									Value: util.SafeText(field.Value),
+								})
+					}
 				}
 
 				newFieldMap[page] = data
@@ -375,6 +382,10 @@ OUTER:
 		return 0, errors.New("Couldn't find a course code")
 	}
 
+	// the exam may have its own version of the template
+	var templateDirs []string
+	ot.Template, templateDirs = g.examTemplate(courseCode, ot.Template)
+
 	// render to images
 
 	jpegPath := g.GetExamDir(courseCode, tempImages) //ot.PageDataMap[0].Exam.CourseCode)
@@ -407,9 +418,10 @@ OUTER:
 
 	f.Close()
 
-	//  make comment text safe (ASCII only, no non-space whitespace)
-	//  so they can be represented in standard font without changing
+	//  make comment text safe (printable, no non-space whitespace)
 	//  this helps us avoid pagedata hash errors (e.g. as arised from \r in comment)
+	//  non-ASCII is kept, for a TrueType font for the spread to draw, and is
+	//  only reduced where it is drawn in a standard font, see parsesvg/font.go
 	for key, pageComments := range comments { //map
 		for idx, cmt := range pageComments { //slice
 			cmt.Text = util.SafeText(cmt.Text)
@@ -494,7 +506,21 @@ OUTER:
 
 	// >>>>>>>>>>>>>>>>>>>>> START PROCESSING IMAGES >>>>>>>>>>>>>>>>>>>>>>>>>>>>>
 
-	err = ConvertPDFToJPEGs(ot.InputPath, jpegPath, jpegFileOption)
+	rs := g.GetRasterSettings(courseCode, ot.ProcessDetail.ToDo)
+
+	processDetail := rasterProcessDetail(ot.ProcessDetail, rs)
+
+	processDetail, err = templateProcessDetail(processDetail, ot.Template, ot.SpreadName, templateDirs)
+	if err != nil {
+		logger.Error().
+			Str("file", ot.InputPath).
+			Str("template", ot.Template).
+			Str("spread", ot.SpreadName).
+			Str("error", err.Error()).
+			Msg("Can't hash template, so not recording it in pagedata")
+	}
+
+	err = ConvertPDFToJPEGsWithSettings(ot.InputPath, jpegPath, jpegFileOption, rs)
 	if err != nil {
 		logger.Error().
 			Str("file", ot.InputPath).
@@ -504,6 +530,38 @@ OUTER:
 		return 0, err
 	}
 
+	var previous *previousPages
+
+	if rs.Mode == image.Vector {
+		previous, err = openPreviousPages(ot.InputPath)
+		if err != nil {
+			logger.Error().
+				Str("file", ot.InputPath).
+				Str("error", err.Error()).
+				Msg(fmt.Sprintf("Can't use pages of file (%s) in vector mode because: %v\n", ot.InputPath, err))
+			ot.Msg.Send(fmt.Sprintf("Can't use pages of file (%s) in vector mode because: %v\n", ot.InputPath, err))
+			return 0, err
+		}
+		defer previous.Close()
+	}
+
+	cropRegions, err := parsesvg.GetCropRegions(ot.Template, ot.SpreadName)
+	if err != nil {
+		logger.Error().
+			Str("file", ot.InputPath).
+			Str("template", ot.Template).
+			Str("spread", ot.SpreadName).
+			Str("error", err.Error()).
+			Msg("Can't get crop regions, so not cropping pages")
+	}
+
+	// labellers need the question numbers checking, so we crop them even if the layout doesn't say where they are
+	useDefaultCrop := err == nil && ot.ProcessDetail.ToDo == labelling
+
+	// landscape pages get the landscape variant of the spread, if the layout has one,
+	// going by the format of the page when it was flattened, not its size now
+	formats := pageDataFormats(ot.OldPageDataMap)
+
 	// convert images to individual pdfs, with form overlay
 
 	pagePath := g.GetExamDir(courseCode, tempPages)
@@ -525,6 +583,23 @@ OUTER:
 
 		pageNumber := imgIdx - 1 //pageNumber starts at zero
 
+		spreadName := ot.SpreadName
+		pageCropRegions := cropRegions
+		pageProcessDetail := processDetail
+
+		if variant, err := parsesvg.GetSpreadForPage(ot.Template, ot.SpreadName, formats[imgIdx]); err == nil && variant != ot.SpreadName {
+
+			spreadName = variant
+
+			if regions, err := parsesvg.GetCropRegions(ot.Template, spreadName); err == nil {
+				pageCropRegions = regions
+			}
+
+			if pd, err := templateProcessDetail(rasterProcessDetail(ot.ProcessDetail, rs), ot.Template, spreadName, templateDirs); err == nil {
+				pageProcessDetail = pd
+			}
+		}
+
 		// FOR THIS PAGE INDIVIDUALLY
 		// we take the "current" pagedata loaded with the extracted field data,
 		// and tack it on the end of the list of previous PageDatas
@@ -543,7 +618,8 @@ OUTER:
 			textfieldValues[pageNumber] = make(map[string]string)
 
 			//check if has prefix ... and not optical suffix ...
-			for _, item := range thisPageData.Current.Data {
+			// (empty fields get any handwriting we read confidently)
+			for _, item := range fillFromOpticalReadings(thisPageData.Current.Data) {
 
 				if !strings.Contains(item.Key, textFieldPrefix) {
 					continue //just in case something else snuck in
@@ -667,12 +743,21 @@ OUTER:
 
 		newThisPageDataCurrent.Follows = oldThisPageDataCurrent.UUID
 
-		newThisPageDataCurrent.Process = ot.ProcessDetail
+		newThisPageDataCurrent.Process = pageProcessDetail
 
 		// TODO - do we need to update Own? Host?
 
 		thisPageData.Current = newThisPageDataCurrent
 
+		err = g.cropPage(courseCode, pageCropRegions, useDefaultCrop, rs.DPI, previousImagePath, newThisPageDataCurrent.UUID)
+		if err != nil {
+			logger.Error().
+				Str("file", ot.InputPath).
+				Int("page-number", imgIdx).
+				Str("error", err.Error()).
+				Msg("Error cropping page")
+		}
+
 		// Add the new field data to the current page
 
 		var data []pagedata.Field
@@ -708,7 +793,27 @@ OUTER:
 			} else {
 
 				// We use the textfield dimensions we read out of the pdf file itself, and adjust them according to total page size
-				boxes, err := parsesvg.GetImageBoxesForTextFields(ot.TextFields[imgIdx], heightPx, widthPx, g.backgroundIsVanilla, g.opticalExpand)
+				boxes, err := parsesvg.GetImageBoxesForTextFields(ot.TextFields[imgIdx], heightPx, widthPx, g.backgroundIsVanilla, g.opticalExpandAt(rs.DPI))
+
+				opticalImagePath := previousImagePath
+
+				// a page that was printed and scanned has lost its textfields, and been moved about by the
+				// scanner, so we line it up by its fiducials, and take the boxes from the layout instead
+				if err == nil && len(ot.TextFields[imgIdx]) == 0 && ot.OpticalBoxSpread != "" {
+
+					registeredPath, registeredBoxes, regErr := g.registerScannedPage(previousImagePath, ot.Template, ot.OpticalBoxSpread, g.opticalExpandAt(rs.DPI), templateDirs)
+
+					if regErr != nil {
+						logger.Info().
+							Str("imagePath", previousImagePath).
+							Str("ot.OpticalBoxSpread", ot.OpticalBoxSpread).
+							Str("error", regErr.Error()).
+							Msg("No textfields on page, and could not register it as a scan, so no optical boxes to read")
+					} else {
+						opticalImagePath = registeredPath
+						boxes = registeredBoxes
+					}
+				}
 
 				if err != nil {
 					logger.Error().
@@ -721,7 +826,7 @@ OUTER:
 
 					if len(boxes) > 0 {
 
-						results, err := optical.CheckBoxFile(previousImagePath, boxes)
+						results, err := optical.ScoreBoxFile(opticalImagePath, boxes, g.opticalThresholds)
 
 						if err != nil {
 
@@ -745,7 +850,7 @@ OUTER:
 								for i, result := range results {
 
 									val := ""
-									if result {
+									if result.Marked {
 										val = markDetected
 									}
 									item := pagedata.Field{
@@ -755,6 +860,38 @@ OUTER:
 
 									data = append(data, item)
 
+									// keep the raw scores, so trace and enter can find boxes that need a human to look at them
+									data = append(data, opticalScoreFields(boxes[i].ID, result)...)
+
+								}
+
+								// try reading handwritten numbers, so that those we read
+								// confidently don't need to be typed in at the enter stage
+								readings, err := optical.ReadDigitsFile(opticalImagePath, boxes)
+
+								if err != nil {
+									logger.Error().
+										Str("imagePath", opticalImagePath).
+										Str("error", err.Error()).
+										Msg("Error reading digits in optical boxes")
+								} else {
+
+									for i, result := range results {
+
+										if !result.Marked || !isNumericDataBox(boxes[i].ID) || readings[i].Text == "" {
+											continue
+										}
+
+										data = append(data, pagedata.Field{
+											Key:   textFieldPrefix + boxes[i].ID + opticalReadingSuffix,
+											Value: readings[i].Text,
+										})
+
+										data = append(data, pagedata.Field{
+											Key:   textFieldPrefix + boxes[i].ID + opticalConfidenceSuffix,
+											Value: fmt.Sprintf("%.3f", readings[i].Confidence),
+										})
+									}
 								}
 
 							}
@@ -799,15 +936,32 @@ OUTER:
 
 		contents := parsesvg.SpreadContents{
 			SvgLayoutPath:         ot.Template,
-			SpreadName:            ot.SpreadName,
+			SpreadName:            spreadName,
 			PreviousImagePath:     previousImagePath,
 			PageNumber:            pageNumber,
 			PdfOutputPath:         pageFilename,
 			Comments:              comments,
 			PageData:              thisPageData,
 			TemplatePathsRelative: true,
+			TemplateDirs:          templateDirs,
 			Prefills:              headerPrefills,
 			TextFieldValues:       textfieldValues,
+			Fiducials:             true,
+			IdentityCode:          true,
+		}
+
+		setRasterContents(&contents, rs)
+
+		if previous != nil {
+			err = previous.placePage(&contents, imgIdx)
+			if err != nil {
+				logger.Error().
+					Str("file", ot.InputPath).
+					Int("page-number", imgIdx).
+					Str("error", err.Error()).
+					Msg(fmt.Sprintf("Error getting page <%d> of (%s) because %v\n", imgIdx, ot.InputPath, err))
+				return 0, err
+			}
 		}
 
 		err = parsesvg.RenderSpreadExtra(contents)
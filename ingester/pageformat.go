@@ -0,0 +1,180 @@
+package ingester
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/comment"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+	pdf "github.com/timdrysdale/unipdf/v3/model"
+)
+
+// New papers are not all A4 portrait, so FlattenOneNewPDF uses the variant of
+// the flatten spread that suits each page (see parsesvg.PageFormat), and can
+// split A3 landscape pages, which are usually two A4 pages scanned side by
+// side, into two pages (see SetSplitA3). The format of each page, and any
+// split, are recorded in its pagedata, which is where the later stages get
+// the format from, because by then the pages have a sidebar.
+
+const (
+	leftHalf      = "left"
+	rightHalf     = "right"
+	pageFormatKey = "page-format"
+)
+
+// flattenPage is a page of the flattened paper, which is a page of the
+// original, or half of one, if it was split
+type flattenPage struct {
+	Number int    // in the original, counting from 1
+	Half   string // leftHalf or rightHalf, if the page was split
+	Format parsesvg.PageFormat
+}
+
+// getPageFormats gets the format of each page, counting from 1
+func getPageFormats(reader *pdf.PdfReader) (map[int]parsesvg.PageFormat, error) {
+
+	formats := make(map[int]parsesvg.PageFormat)
+
+	numPages, err := reader.GetNumPages()
+	if err != nil {
+		return formats, err
+	}
+
+	for n := 1; n <= numPages; n++ {
+
+		page, err := reader.GetPage(n)
+		if err != nil {
+			return formats, err
+		}
+
+		format, err := parsesvg.GetPageFormat(page)
+		if err != nil {
+			return formats, err
+		}
+
+		formats[n] = format
+	}
+
+	return formats, nil
+}
+
+// pageDataFormats gets the format of each page, counting from 1, from the
+// page-format recorded in its pagedata at flatten, because the pages have had
+// a sidebar added since, so their size no longer says. Pages flattened before
+// it was recorded are taken to be portrait.
+func pageDataFormats(pageDataMap map[int]pagedata.PageData) map[int]parsesvg.PageFormat {
+
+	formats := make(map[int]parsesvg.PageFormat)
+
+	for n, pd := range pageDataMap {
+		if format, ok := pageDataFormat(pd); ok {
+			formats[n] = format
+		}
+	}
+
+	return formats
+}
+
+// pageDataFormat finds the most recent page-format in the pagedata
+func pageDataFormat(pd pagedata.PageData) (parsesvg.PageFormat, bool) {
+
+	if format, ok := detailFormat(pd.Current); ok {
+		return format, true
+	}
+
+	// previous is oldest first
+	for i := len(pd.Previous) - 1; i >= 0; i-- {
+		if format, ok := detailFormat(pd.Previous[i]); ok {
+			return format, true
+		}
+	}
+
+	return parsesvg.PageFormat{}, false
+}
+
+func detailFormat(detail pagedata.PageDetail) (parsesvg.PageFormat, bool) {
+
+	for _, field := range detail.Data {
+		if field.Key != pageFormatKey {
+			continue
+		}
+		if format, err := parsesvg.ParsePageFormat(field.Value); err == nil {
+			return format, true
+		}
+	}
+
+	return parsesvg.PageFormat{}, false
+}
+
+// flattenPages lists the pages of the flattened paper. Pages we don't know
+// the format of are taken to be portrait.
+func flattenPages(formats map[int]parsesvg.PageFormat, numPages int, splitA3 bool) []flattenPage {
+
+	pages := []flattenPage{}
+
+	for n := 1; n <= numPages; n++ {
+
+		format := formats[n]
+
+		if splitA3 && format.IsA3Landscape() {
+			half := parsesvg.PageFormat{Paper: parsesvg.PaperA4}
+			pages = append(pages,
+				flattenPage{Number: n, Half: leftHalf, Format: half},
+				flattenPage{Number: n, Half: rightHalf, Format: half})
+			continue
+		}
+
+		pages = append(pages, flattenPage{Number: n, Format: format})
+	}
+
+	return pages
+}
+
+// halfImagePath is where the image of half of the page goes
+func halfImagePath(imagePath, half string) string {
+	ext := filepath.Ext(imagePath)
+	return strings.TrimSuffix(imagePath, ext) + "-" + half + ext
+}
+
+// flattenComments moves the comments to the pages of the flattened paper.
+// We can't tell which half of a split page a comment is on without knowing
+// how the page is turned, so they all go on the left half, where they are
+// listed in the margin as usual.
+func flattenComments(comments comment.Comments, pages []flattenPage) comment.Comments {
+
+	moved := make(comment.Comments)
+
+	for idx, page := range pages {
+		if page.Half == rightHalf {
+			continue
+		}
+		if cmts, ok := comments[page.Number-1]; ok {
+			moved[idx] = cmts
+		}
+	}
+
+	return moved
+}
+
+// pageFormatData records the format of the page, and, if the paper had any
+// pages split, which page of the original it came from
+func pageFormatData(page flattenPage, split bool) []pagedata.Field {
+
+	data := []pagedata.Field{
+		{Key: pageFormatKey, Value: page.Format.String()},
+	}
+
+	if !split {
+		return data
+	}
+
+	data = append(data, pagedata.Field{Key: "original-page", Value: strconv.Itoa(page.Number)})
+
+	if page.Half != "" {
+		data = append(data, pagedata.Field{Key: "split-half", Value: page.Half})
+	}
+
+	return data
+}
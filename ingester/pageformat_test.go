@@ -0,0 +1,172 @@
+package ingester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/comment"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+func TestFlattenPages(t *testing.T) {
+
+	a4 := parsesvg.PageFormat{Paper: parsesvg.PaperA4}
+	a3 := parsesvg.PageFormat{Paper: parsesvg.PaperA3, Landscape: true}
+
+	formats := map[int]parsesvg.PageFormat{1: a4, 2: a3}
+
+	// page 3 has no format, so is taken to be portrait
+	assert.Equal(t, []flattenPage{
+		{Number: 1, Format: a4},
+		{Number: 2, Format: a3},
+		{Number: 3},
+	}, flattenPages(formats, 3, false))
+
+	pages := flattenPages(formats, 3, true)
+
+	assert.Equal(t, []flattenPage{
+		{Number: 1, Format: a4},
+		{Number: 2, Half: leftHalf, Format: a4},
+		{Number: 2, Half: rightHalf, Format: a4},
+		{Number: 3},
+	}, pages)
+
+	comments := comment.Comments{
+		1: []comment.Comment{{Text: "split"}},
+		2: []comment.Comment{{Text: "after"}},
+	}
+
+	moved := flattenComments(comments, pages)
+	assert.Equal(t, 2, len(moved))
+	assert.Equal(t, "split", moved[1][0].Text)
+	assert.Equal(t, "after", moved[3][0].Text)
+
+	assert.Equal(t, []pagedata.Field{
+		{Key: "page-format", Value: "a4-portrait"},
+	}, pageFormatData(pages[0], false))
+
+	assert.Equal(t, []pagedata.Field{
+		{Key: "page-format", Value: "a4-portrait"},
+		{Key: "original-page", Value: "2"},
+		{Key: "split-half", Value: "right"},
+	}, pageFormatData(pages[2], true))
+
+	assert.Equal(t, "/foo/bar-left.jpg", halfImagePath("/foo/bar.jpg", leftHalf))
+}
+
+func TestPageDataFormats(t *testing.T) {
+
+	flattened := pagedata.PageDetail{
+		Data: []pagedata.Field{{Key: "page-format", Value: "a3-landscape"}},
+	}
+
+	marked := pagedata.PageDetail{
+		Data: []pagedata.Field{{Key: "tf-q1-mark", Value: "3"}},
+	}
+
+	formats := pageDataFormats(map[int]pagedata.PageData{
+		1: {Current: flattened},
+		2: {Current: marked, Previous: []pagedata.PageDetail{flattened}},
+		3: {Current: marked}, // flattened before the format was recorded
+	})
+
+	a3 := parsesvg.PageFormat{Paper: parsesvg.PaperA3, Landscape: true}
+
+	assert.Equal(t, map[int]parsesvg.PageFormat{1: a3, 2: a3}, formats)
+}
+
+func TestFlattenSplitA3(t *testing.T) {
+
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	mch := make(chan chmsg.MessageInfo)
+
+	logFile := "./split-a3-testing.log"
+
+	f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+	assert.NoError(t, err)
+
+	defer f.Close()
+
+	logger := zerolog.New(f).With().Timestamp().Logger()
+
+	g, err := New("./tmp-delete-me", mch, &logger)
+	assert.NoError(t, err)
+
+	os.RemoveAll("./tmp-delete-me")
+
+	g.EnsureDirectoryStructure()
+
+	templateFiles, err := g.GetFileList("./test-fs/etc/overlay/template")
+	assert.NoError(t, err)
+
+	for _, file := range templateFiles {
+		destination := filepath.Join(g.OverlayTemplate(), filepath.Base(file))
+		err := Copy(file, destination)
+		assert.NoError(t, err)
+	}
+
+	exam := "Practice"
+
+	err = g.SetupExamDirs(exam)
+	assert.NoError(t, err)
+
+	g.SetSplitA3(true)
+
+	// one A3 landscape page, as if two A4 pages were scanned side by side
+	c := creator.New()
+	c.SetPageSize(creator.PageSize{1190.55, 841.89})
+	c.NewPage()
+
+	inputPath := filepath.Join(g.GetExamDir(exam, acceptedPapers), "a3.pdf")
+	assert.NoError(t, c.WriteToFile(inputPath))
+
+	original := pagedata.PageDetail{
+		Is:   pagedata.IsPage,
+		UUID: safeUUID(),
+		Original: pagedata.FileDetail{
+			Path:   inputPath,
+			Number: 1,
+			Of:     1,
+		},
+		Item: pagedata.ItemDetail{
+			What: exam,
+			Who:  "B999999",
+		},
+		Process: pagedata.ProcessDetail{
+			Name: "flatten",
+			For:  "ingester",
+			ToDo: "prepare-for-marking",
+		},
+	}
+
+	outputPath := filepath.Join(g.GetExamDir(exam, anonPapers), "Practice-B999999.pdf")
+
+	_, err = g.FlattenOneNewPDF(inputPath, outputPath, map[int]pagedata.PageData{1: {Current: original}}, &logger)
+	assert.NoError(t, err)
+
+	after, err := pagedata.UnMarshalAllFromFile(outputPath)
+	assert.NoError(t, err)
+
+	if assert.Equal(t, 2, len(after)) {
+
+		// each half is a page in its own right
+		assert.NotEqual(t, after[1].Current.UUID, after[2].Current.UUID)
+		assert.NotEqual(t, original.UUID, after[1].Current.UUID)
+		assert.NotEqual(t, original.UUID, after[2].Current.UUID)
+
+		// and stays A4 portrait, though it now has the flatten sidebar
+		a4 := parsesvg.PageFormat{Paper: parsesvg.PaperA4}
+		assert.Equal(t, map[int]parsesvg.PageFormat{1: a4, 2: a4}, pageDataFormats(after))
+	}
+
+	os.RemoveAll("./tmp-delete-me")
+}
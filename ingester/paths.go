@@ -14,6 +14,14 @@ import (
 //>>>>>>>>>>>>>> MERGE PROCESSED PAPERS >>>>>>>>>>>>>>>>>>>>>>
 
 func (g *Ingester) MergeProcessedPapersFromDir(exam, stage string) (string, error) {
+
+	// only the first marker's scripts go forward when double marking
+	if stage == "marked" {
+		if markers, err := g.GetDoubleMarkers(exam); err == nil {
+			return g.GetExamDirNamed(exam, markerFlattened, markers[0]), nil
+		}
+	}
+
 	return g.FlattenProcessedPapersToDir(exam, stage)
 }
 
@@ -0,0 +1,167 @@
+package ingester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/timdrysdale/gradex-cli/image"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+// Each exam can have its own raster settings, stored in its config dir as
+// raster.json, because a typed exam is happy in greyscale at low resolution,
+// while a scanned exam with diagrams may need colour. Settings can be given for
+// each stage, by the ToDo of the process (e.g. marking, moderating, checking,
+// or prepare-for-marking for the first flatten), and anything left out comes
+// from the exam's default, then from image.DefaultRasterSettings.
+
+const rasterConfigFile = "raster.json"
+
+type RasterConfig struct {
+	Default image.RasterSettings            `json:"default"`
+	Stages  map[string]image.RasterSettings `json:"stages,omitempty"`
+}
+
+func DefaultRasterConfig() RasterConfig {
+	return RasterConfig{
+		Default: image.DefaultRasterSettings(),
+		Stages:  make(map[string]image.RasterSettings),
+	}
+}
+
+// Settings returns the settings for the stage, filling in the defaults
+func (rc RasterConfig) Settings(stage string) image.RasterSettings {
+	return rc.Stages[stage].WithDefaults(rc.Default.WithDefaults(image.DefaultRasterSettings()))
+}
+
+func (rc RasterConfig) Validate() error {
+
+	err := rc.Settings("").Validate()
+	if err != nil {
+		return fmt.Errorf("default: %s", err.Error())
+	}
+
+	for stage := range rc.Stages {
+		err := rc.Settings(stage).Validate()
+		if err != nil {
+			return fmt.Errorf("%s: %s", stage, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (g *Ingester) RasterConfigPath(exam string) string {
+	return filepath.Join(g.GetExamDir(exam, config), rasterConfigFile)
+}
+
+// GetRasterConfig returns the exam's raster config, or the default
+// config (and an os.IsNotExist error) if there is none
+func (g *Ingester) GetRasterConfig(exam string) (RasterConfig, error) {
+
+	rc := DefaultRasterConfig()
+
+	contents, err := ioutil.ReadFile(g.RasterConfigPath(exam))
+	if err != nil {
+		return rc, err
+	}
+
+	err = json.Unmarshal(contents, &rc)
+	if err != nil {
+		return DefaultRasterConfig(), err
+	}
+
+	err = rc.Validate()
+	if err != nil {
+		return DefaultRasterConfig(), fmt.Errorf("%s: %s", g.RasterConfigPath(exam), err.Error())
+	}
+
+	if rc.Stages == nil {
+		rc.Stages = make(map[string]image.RasterSettings)
+	}
+
+	return rc, nil
+}
+
+func (g *Ingester) SaveRasterConfig(exam string, rc RasterConfig) error {
+
+	err := rc.Validate()
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.MarshalIndent(rc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(g.RasterConfigPath(exam), contents, 0644)
+	if err != nil {
+		return err
+	}
+
+	g.logger.Info().
+		Str("path", g.RasterConfigPath(exam)).
+		Str("default", rc.Settings("").String()).
+		Int("stages", len(rc.Stages)).
+		Msg("Saved raster config")
+
+	return nil
+}
+
+// GetRasterSettings returns the settings to use for the stage, falling back
+// to the defaults if the exam's config can't be read
+func (g *Ingester) GetRasterSettings(exam, stage string) image.RasterSettings {
+
+	rc, err := g.GetRasterConfig(exam)
+
+	if err != nil && !os.IsNotExist(err) {
+		g.logger.Error().
+			Str("path", g.RasterConfigPath(exam)).
+			Str("error", err.Error()).
+			Msg("Could not use raster config, using default settings")
+	}
+
+	return rc.Settings(stage)
+}
+
+// rasterProcessDetail records the settings in the process data,
+// without touching the Data of the process we were given
+func rasterProcessDetail(pd pagedata.ProcessDetail, rs image.RasterSettings) pagedata.ProcessDetail {
+
+	data := []pagedata.Field{}
+	data = append(data, pd.Data...)
+
+	data = append(data,
+		pagedata.Field{Key: "rasteriser", Value: image.GetRasteriser().Name()},
+		pagedata.Field{Key: "raster-dpi", Value: strconv.Itoa(rs.DPI)},
+		pagedata.Field{Key: "raster-ppi", Value: strconv.Itoa(rs.PPI)},
+		pagedata.Field{Key: "raster-quality", Value: strconv.Itoa(rs.Quality)},
+		pagedata.Field{Key: "raster-colour", Value: rs.Colour},
+		pagedata.Field{Key: "overlay-mode", Value: rs.Mode},
+	)
+
+	pd.Data = data
+
+	return pd
+}
+
+// setRasterContents puts the image settings into the spread we are rendering
+func setRasterContents(contents *parsesvg.SpreadContents, rs image.RasterSettings) {
+	contents.ImageQuality = rs.Quality
+	contents.ImageUpperPPI = float64(rs.PPI)
+	contents.Bilevel = rs.Colour == image.Bilevel
+}
+
+// opticalExpandAt gives the optical expand in pixels for a page rendered at
+// dpi, because the shrink (from --box-shrink, or the optical profile) is in
+// pixels at image.RasterDPI, and must cover the same part of the box at any
+// resolution
+func (g *Ingester) opticalExpandAt(dpi int) int {
+	return g.opticalExpand * dpi / image.RasterDPI
+}
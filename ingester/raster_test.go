@@ -0,0 +1,152 @@
+package ingester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/image"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+func TestRasterConfigSettings(t *testing.T) {
+
+	rc := DefaultRasterConfig()
+
+	assert.Equal(t, image.DefaultRasterSettings(), rc.Settings("marking"))
+
+	rc.Default = image.RasterSettings{Colour: image.Grey}
+	rc.Stages["marking"] = image.RasterSettings{DPI: 300, Colour: image.Bilevel}
+
+	assert.NoError(t, rc.Validate())
+
+	marking := rc.Settings("marking")
+	assert.Equal(t, 300, marking.DPI)
+	assert.Equal(t, image.RasterPPI, marking.PPI)
+	assert.Equal(t, image.Bilevel, marking.Colour)
+
+	checking := rc.Settings("checking")
+	assert.Equal(t, image.RasterDPI, checking.DPI)
+	assert.Equal(t, image.Grey, checking.Colour)
+
+	rc.Stages["moderating"] = image.RasterSettings{Quality: 200}
+	assert.Error(t, rc.Validate())
+}
+
+func TestOpticalExpandAt(t *testing.T) {
+
+	g := &Ingester{opticalExpand: -14}
+
+	assert.Equal(t, -14, g.opticalExpandAt(image.RasterDPI))
+	assert.Equal(t, -28, g.opticalExpandAt(2*image.RasterDPI))
+	assert.Equal(t, -8, g.opticalExpandAt(100))
+}
+
+func TestRasterProcessDetail(t *testing.T) {
+
+	pd := pagedata.ProcessDetail{
+		Name: "mark-bar",
+		Data: []pagedata.Field{{Key: "reassigned-from", Value: "X"}},
+	}
+
+	rs := image.DefaultRasterSettings()
+	rs.Colour = image.Bilevel
+
+	rpd := rasterProcessDetail(pd, rs)
+
+	assert.Equal(t, 1, len(pd.Data))
+	assert.Equal(t, pd.Data[0], rpd.Data[0])
+
+	values := make(map[string]string)
+	for _, field := range rpd.Data {
+		values[field.Key] = field.Value
+	}
+
+	assert.Equal(t, "175", values["raster-dpi"])
+	assert.Equal(t, "150", values["raster-ppi"])
+	assert.Equal(t, "90", values["raster-quality"])
+	assert.Equal(t, image.Bilevel, values["raster-colour"])
+	assert.Equal(t, image.Raster, values["overlay-mode"])
+	assert.Equal(t, image.GetRasteriser().Name(), values["rasteriser"])
+}
+
+func TestVectorModePageData(t *testing.T) {
+
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	mch := make(chan chmsg.MessageInfo)
+
+	logFile := "./vector-mode-testing.log"
+
+	f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0755)
+	assert.NoError(t, err)
+
+	defer f.Close()
+
+	logger := zerolog.New(f).With().Timestamp().Logger()
+
+	g, err := New("./tmp-delete-me", mch, &logger)
+	assert.NoError(t, err)
+
+	os.RemoveAll("./tmp-delete-me")
+
+	g.EnsureDirectoryStructure()
+
+	templateFiles, err := g.GetFileList("./test-fs/etc/overlay/template")
+	assert.NoError(t, err)
+
+	for _, file := range templateFiles {
+		destination := filepath.Join(g.OverlayTemplate(), filepath.Base(file))
+		err := Copy(file, destination)
+		assert.NoError(t, err)
+	}
+
+	exam := "Practice"
+
+	err = g.SetupExamDirs(exam)
+	assert.NoError(t, err)
+
+	rc := DefaultRasterConfig()
+	rc.Default.Mode = image.Vector
+	assert.NoError(t, g.SaveRasterConfig(exam, rc))
+
+	source := "./test-enter/Practice-B999999-maTDD-marked-stylus-enXd.pdf"
+
+	before, err := pagedata.UnMarshalAllFromFile(source)
+	assert.NoError(t, err)
+
+	err = g.CopyToDir(source, g.Ingest())
+	assert.NoError(t, err)
+
+	err = g.StageFromIngest()
+	assert.NoError(t, err)
+
+	err = g.FlattenProcessedPapers(exam, "entered")
+	assert.NoError(t, err)
+
+	// the previous page is placed as it is, but its pagedata must not be read back as the new one
+	flattenedPath := "tmp-delete-me/usr/exam/Practice/43-enter-flattened/Practice-B999999-maTDD-marked-stylus-enXd.pdf"
+	after, err := pagedata.UnMarshalAllFromFile(flattenedPath)
+	assert.NoError(t, err)
+
+	assert.Equal(t, len(before), len(after))
+
+	for page, pd := range after {
+		assert.Equal(t, "further-processing", pd.Current.Process.ToDo)
+		assert.NotEqual(t, before[page].Current.UUID, pd.Current.UUID)
+		assert.Equal(t, before[page].Current.UUID, pd.Previous[len(pd.Previous)-1].UUID)
+
+		values := make(map[string]string)
+		for _, field := range pd.Current.Process.Data {
+			values[field.Key] = field.Value
+		}
+		assert.Equal(t, image.Vector, values["overlay-mode"])
+	}
+
+	os.RemoveAll("./tmp-delete-me")
+}
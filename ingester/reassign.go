@@ -0,0 +1,382 @@
+package ingester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/rs/zerolog"
+	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+// Reassigning work: when an actor can't finish a batch, their unreturned
+// scripts are recalled from their ready and sent directories into
+// 98-reassigned, and new bars are overlaid for someone else, starting from
+// the same ancestor that the first actor's bars were put on. The export
+// register marks the recalled files as reassigned, so that if the first actor
+// returns them later anyway, ingest quarantines them instead of mixing them
+// in with the new actor's work.
+
+var (
+	turnaroundReassigned = "reassigned"
+	lateReturns          = "late"
+)
+
+type reassignStage struct {
+	ancestor  string
+	back      string
+	cover     string
+	spread    string
+	name      string
+	propagate bool
+}
+
+func getReassignStage(stage string) (reassignStage, error) {
+
+	switch stage {
+	case labelling:
+		return reassignStage{ancestor: anonPapers, back: questionBack, spread: "label", name: "label-bar"}, nil
+	case marking:
+		return reassignStage{ancestor: anonPapers, back: markerBack, spread: "mark", name: "mark-bar"}, nil
+	case moderating:
+		return reassignStage{ancestor: moderatorActive, back: moderatorBack, spread: "moderate-active", name: "moderate-active-bar"}, nil
+	case entering:
+		return reassignStage{ancestor: enterActive, back: enterBack, spread: "enter-active", name: "enter-active-bar", propagate: true}, nil
+	case checking:
+		return reassignStage{ancestor: enterProcessed, back: checkerBack, cover: checkerCover, spread: "check", name: "check-bar"}, nil
+	default:
+		return reassignStage{}, fmt.Errorf("can't reassign %s.\n Try: [%s]", stage, strings.Join([]string{labelling, marking, moderating, entering, checking}, ","))
+	}
+}
+
+// ReassignWork recalls the scripts that have not come back from one actor,
+// and overlays fresh bars on them for another actor. It returns the base
+// names of the ancestor scripts that were reassigned.
+func (g *Ingester) ReassignWork(exam, stage, from, to string) ([]string, error) {
+
+	logger := g.logger.With().
+		Str("process", "reassign").
+		Str("exam", exam).
+		Str("stage", stage).
+		Str("from", from).
+		Str("to", to).
+		Logger()
+
+	ancestors := []string{}
+
+	rs, err := getReassignStage(stage)
+	if err != nil {
+		return ancestors, err
+	}
+
+	if GetShortActorName(from) == GetShortActorName(to) {
+		return ancestors, fmt.Errorf("can't reassign from %s to %s because they have the same initials", from, to)
+	}
+
+	ready, sent, err := getExportStageDirs(stage)
+	if err != nil {
+		return ancestors, err
+	}
+
+	readyDir := g.GetExamDirNamed(exam, ready, from)
+	sentDir := g.GetExamDirNamed(exam, sent, from)
+	backDir := g.GetExamDirNamed(exam, rs.back, from)
+	ancestorDir := g.GetExamDir(exam, rs.ancestor)
+	recallDir := g.GetExamDirNamed(exam, reassigned, from)
+
+	back := make(map[string]bool)
+
+	backFiles, err := g.GetFileList(backDir)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not get list of returned files")
+		return ancestors, err
+	}
+
+	for _, file := range backFiles {
+		back[filepath.Base(file)] = true
+	}
+
+	decoration := g.GetNamedTaskDecoration(stage, from)
+
+	onlyFiles := make(map[string]bool)
+	recalled := []string{}
+	numErrors := 0
+
+	for _, dir := range []string{readyDir, sentDir} {
+
+		files, err := g.GetFileList(dir)
+		if err != nil {
+			logger.Error().Str("dir", dir).Str("error", err.Error()).Msg("Could not get file list")
+			return ancestors, err
+		}
+
+		for _, file := range files {
+
+			if !g.IsPDF(file) || back[filepath.Base(file)] {
+				continue
+			}
+
+			ancestor, ok := getAncestorName(filepath.Base(file), decoration)
+			ancestorPath := filepath.Join(ancestorDir, ancestor)
+
+			if _, err := os.Stat(ancestorPath); !ok || err != nil {
+				numErrors++
+				logger.Error().
+					Str("file", file).
+					Str("ancestor", ancestorPath).
+					Msg("Could not find ancestor, leaving file where it is")
+				continue
+			}
+
+			err = g.MoveToDir(file, recallDir)
+			if err != nil {
+				numErrors++
+				logger.Error().
+					Str("file", file).
+					Str("destination", recallDir).
+					Str("error", err.Error()).
+					Msg("Could not recall file")
+				continue
+			}
+
+			// so the ancestor can be overlaid for this actor again later
+			os.Remove(doneFilePathFor(ancestorPath, decoration))
+
+			onlyFiles[ancestor] = true
+			recalled = append(recalled, filepath.Base(file))
+			ancestors = append(ancestors, ancestor)
+
+			logger.Info().
+				Str("file", file).
+				Str("destination", recallDir).
+				Msg("Recalled file for reassigning")
+		}
+	}
+
+	if len(ancestors) < 1 {
+		if numErrors > 0 {
+			return ancestors, fmt.Errorf("%d errors in reassigning - see logfile for details", numErrors)
+		}
+		return ancestors, nil
+	}
+
+	err = g.markReassignedInRegister(exam, stage, from, recalled)
+	if err != nil {
+		numErrors++
+		logger.Error().Str("error", err.Error()).Msg("Could not mark recalled files in export register")
+	}
+
+	if stage == marking {
+		err = g.reassignAllocation(exam, to, onlyFiles)
+		if err != nil {
+			numErrors++
+			logger.Error().Str("error", err.Error()).Msg("Could not update allocation register")
+		}
+	}
+
+	mc := chmsg.MessagerConf{
+		ExamName:     exam,
+		FunctionName: "overlay",
+		TaskName:     "reassign",
+	}
+
+	cm := chmsg.New(mc, g.msgCh, g.timeout)
+
+	procDetail := pagedata.ProcessDetail{
+		UUID:     safeUUID(),
+		UnixTime: time.Now().UnixNano(),
+		Name:     rs.name,
+		By:       "gradex-cli",
+		ToDo:     stage,
+		For:      to,
+		Data: []pagedata.Field{
+			{Key: "reassigned-from", Value: from},
+			{Key: "reassigned-to", Value: to},
+		},
+	}
+
+	oc := OverlayCommand{
+		FromPath:                 ancestorDir,
+		ToPath:                   g.GetExamDirNamed(exam, ready, to),
+		ExamName:                 exam,
+		TemplatePath:             g.OverlayLayoutSVG(),
+		SpreadName:               rs.spread,
+		ProcessDetail:            procDetail,
+		Msg:                      cm,
+		PathDecoration:           g.GetNamedTaskDecoration(stage, to),
+		PropagateTextFieldValues: rs.propagate,
+		OnlyFiles:                onlyFiles,
+	}
+
+	if rs.cover != "" {
+		oc.CoverPath = g.GetExamDir(exam, rs.cover)
+	}
+
+	err = g.OverlayPapers(oc, &logger)
+
+	if err != nil {
+		logger.Error().
+			Str("UUID", procDetail.UUID).
+			Str("error", err.Error()).
+			Msg("Error reassigning")
+		return ancestors, err
+	}
+
+	cm.Send(fmt.Sprintf("Finished reassigning UUID=%s\n", procDetail.UUID))
+	logger.Info().
+		Str("UUID", procDetail.UUID).
+		Int("count", len(ancestors)).
+		Msg("Finished reassigning")
+
+	if numErrors > 0 {
+		return ancestors, fmt.Errorf("%d errors in reassigning - see logfile for details", numErrors)
+	}
+
+	return ancestors, nil
+}
+
+// getAncestorName strips the actor's decoration off a file name,
+// e.g. B999999-maXYZ.pdf -> B999999.pdf
+func getAncestorName(base, decoration string) (string, bool) {
+
+	ext := filepath.Ext(base)
+	stem := strings.TrimSuffix(base, ext)
+
+	if !strings.HasSuffix(stem, decoration) {
+		return base, false
+	}
+
+	return strings.TrimSuffix(stem, decoration) + ext, true
+}
+
+func (g *Ingester) markReassignedInRegister(exam, stage, actor string, files []string) error {
+
+	records, err := g.GetExportRecords(exam)
+	if err != nil {
+		return err
+	}
+
+	if markReassigned(records, stage, actor, files, time.Now()) > 0 {
+		return g.writeExportRecords(exam, records)
+	}
+
+	return nil
+}
+
+// markReassigned changes the latest outstanding export of each file to this
+// actor to reassigned, and returns how many records were changed
+func markReassigned(records []ExportRecord, stage, actor string, files []string, now time.Time) int {
+
+	short := GetShortActorName(actor)
+	count := 0
+
+	for _, file := range files {
+		for i := len(records) - 1; i >= 0; i-- {
+
+			r := records[i]
+
+			if r.File != file || r.Actor != short || r.Stage != stage {
+				continue
+			}
+
+			if r.Status == turnaroundOut {
+				records[i].Status = turnaroundReassigned
+				records[i].Returned = now.Format(time.RFC3339)
+				count++
+			}
+
+			break
+		}
+	}
+
+	return count
+}
+
+// isLateReturn is true if the latest export of this file to this
+// actor was reassigned to someone else before it came back
+func isLateReturn(records []ExportRecord, stage, actor, file string) bool {
+
+	short := GetShortActorName(actor)
+
+	for i := len(records) - 1; i >= 0; i-- {
+
+		r := records[i]
+
+		if r.File == file && r.Actor == short && r.Stage == stage {
+			return r.Status == turnaroundReassigned
+		}
+	}
+
+	return false
+}
+
+// quarantineLateReturn moves a file that has come back from an actor after
+// their work was reassigned, into 98-reassigned/<actor>/late, so that it does
+// not get mixed in with the new actor's work. It returns true if it did so.
+func (g *Ingester) quarantineLateReturn(exam, stage, actor, path string, logger *zerolog.Logger) bool {
+
+	records, err := g.GetExportRecords(exam)
+	if err != nil {
+		logger.Error().Str("file", path).Str("error", err.Error()).Msg("Could not read export register to check for late return")
+		return false
+	}
+
+	if !isLateReturn(records, stage, actor, filepath.Base(path)) {
+		return false
+	}
+
+	destination := filepath.Join(g.GetExamDirNamed(exam, reassigned, actor), lateReturns)
+
+	err = g.EnsureDirAll(destination)
+	if err == nil {
+		_, err = g.MoveIfNewerThanDestinationInDir(path, destination, logger)
+	}
+
+	if err != nil {
+		logger.Error().
+			Str("file", path).
+			Str("destination", destination).
+			Str("error", err.Error()).
+			Msg("Late return after reassigning, but could not quarantine it, leaving in ingest")
+		return true
+	}
+
+	logger.Warn().
+		Str("file", path).
+		Str("destination", destination).
+		Str("stage", stage).
+		Str("actor", actor).
+		Msg("Quarantined late return, because this work was reassigned")
+
+	return true
+}
+
+func (g *Ingester) reassignAllocation(exam, marker string, files map[string]bool) error {
+
+	allocations, err := g.GetAllocation(exam)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, a := range allocations {
+		if files[a.File] {
+			allocations[i].Marker = marker
+			allocations[i].When = time.Now().Format(time.RFC3339)
+		}
+	}
+
+	f, err := os.Create(g.AllocationRegister(exam))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return gocsv.MarshalFile(&allocations, f)
+}
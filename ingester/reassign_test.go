@@ -0,0 +1,56 @@
+package ingester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAncestorName(t *testing.T) {
+
+	name, ok := getAncestorName("B999999-maABC.pdf", "-maABC")
+	assert.True(t, ok)
+	assert.Equal(t, "B999999.pdf", name)
+
+	name, ok = getAncestorName("B999999-maABC-maDEF.pdf", "-maDEF")
+	assert.True(t, ok)
+	assert.Equal(t, "B999999-maABC.pdf", name)
+
+	_, ok = getAncestorName("B999999-maDEF.pdf", "-maABC")
+	assert.False(t, ok)
+}
+
+func TestMarkReassigned(t *testing.T) {
+
+	records := []ExportRecord{
+		{Batch: "b1", Stage: "marking", Actor: "ABC", File: "a-maABC.pdf", Status: turnaroundReturned},
+		{Batch: "b1", Stage: "marking", Actor: "ABC", File: "b-maABC.pdf", Status: turnaroundOut},
+		{Batch: "b1", Stage: "marking", Actor: "ABC", File: "c-maABC.pdf", Status: turnaroundUnsent},
+		{Batch: "b2", Stage: "marking", Actor: "ABC", File: "c-maABC.pdf", Status: turnaroundOut},
+		{Batch: "b3", Stage: "checking", Actor: "ABC", File: "b-maABC.pdf", Status: turnaroundOut},
+	}
+
+	now := time.Date(2020, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	count := markReassigned(records, "marking", "abc", []string{"a-maABC.pdf", "b-maABC.pdf", "c-maABC.pdf", "d-maABC.pdf"}, now)
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, turnaroundReturned, records[0].Status)
+	assert.Equal(t, turnaroundReassigned, records[1].Status)
+	assert.Equal(t, now.Format(time.RFC3339), records[1].Returned)
+	assert.Equal(t, turnaroundUnsent, records[2].Status)
+	assert.Equal(t, turnaroundReassigned, records[3].Status)
+	assert.Equal(t, turnaroundOut, records[4].Status)
+
+	assert.False(t, isLateReturn(records, "marking", "abc", "a-maABC.pdf"))
+	assert.True(t, isLateReturn(records, "marking", "abc", "b-maABC.pdf"))
+	assert.True(t, isLateReturn(records, "marking", "abc", "c-maABC.pdf"))
+	assert.False(t, isLateReturn(records, "checking", "abc", "b-maABC.pdf"))
+	assert.False(t, isLateReturn(records, "marking", "def", "b-maABC.pdf"))
+
+	// summary does not count reassigned work against the actor
+	summaries := summariseTurnaround(records, now)
+	assert.Equal(t, []string{}, summaries[1].Outstanding)
+	assert.Equal(t, 1, summaries[1].Returned)
+}
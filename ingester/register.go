@@ -0,0 +1,33 @@
+package ingester
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+// registerScannedPage lines up the image of a page that was printed, marked
+// on paper, and scanned back in, using the fiducials on its bar. It returns
+// the path of the registered image, and the optical boxes for the spread, taken
+// from the layout because a scanned page has no textfields to get them from,
+// grown by expand pixels (or shrunk, if it is negative).
+func (g *Ingester) registerScannedPage(imagePath, layoutPath, spreadName string, expand int, templateDirs []string) (string, []optical.Box, error) {
+
+	box, page, err := parsesvg.GetFiducialBox(layoutPath, spreadName)
+	if err != nil {
+		return imagePath, []optical.Box{}, err
+	}
+
+	registeredPath := strings.TrimSuffix(imagePath, filepath.Ext(imagePath)) + "-registered.jpg"
+
+	widthPx, heightPx, err := optical.RegisterFile(imagePath, registeredPath, box, page)
+	if err != nil {
+		return imagePath, []optical.Box{}, err
+	}
+
+	boxes, err := parsesvg.GetImageBoxesForTextFieldsFromTemplate(layoutPath, spreadName, widthPx, heightPx, g.backgroundIsVanilla, expand, templateDirs...)
+
+	return registeredPath, boxes, err
+}
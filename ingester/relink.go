@@ -0,0 +1,245 @@
+package ingester
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/qr"
+)
+
+// Relinking: a page that has lost its pagedata, e.g. because it was printed,
+// marked on paper and scanned back in, can still be identified from the QR
+// code printed on it (see parsesvg.IdentityCode). We read the code, find the
+// file in the exam that has a page with that UUID, and put that page's
+// pagedata back into the scanned page, so it can rejoin its chain.
+
+const relinkedSuffix = "-relinked.pdf"
+
+// RelinkPDF writes a copy of the input with the pagedata put back on every
+// page, and returns the number of pages relinked. It fails if any page has
+// no code, or its pagedata can't be found, because a partly linked file would
+// be trouble later on.
+func (g *Ingester) RelinkPDF(inputPath, outputPath string) (int, error) {
+
+	logger := g.logger.With().Str("process", "relink").Str("file", inputPath).Logger()
+
+	tempDir, err := ioutil.TempDir("", "gradex-relink")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	err = ConvertPDFToJPEGs(inputPath, tempDir, filepath.Join(tempDir, "page%04d.jpg"))
+	if err != nil {
+		return 0, err
+	}
+
+	pages, err := g.GetFileList(tempDir)
+	if err != nil {
+		return 0, err
+	}
+
+	pdMap := make(map[int]pagedata.PageData)
+	cache := make(map[string]map[int]pagedata.PageData)
+
+	for i, page := range pages {
+
+		id, err := readPageIdentity(page)
+		if err != nil {
+			logger.Error().Int("page", i+1).Str("error", err.Error()).Msg("Could not read identity code")
+			return 0, fmt.Errorf("page %d of %s: %s", i+1, inputPath, err.Error())
+		}
+
+		pd, err := g.findPageData(id, cache)
+		if err != nil {
+			logger.Error().Int("page", i+1).Str("UUID", id.UUID).Str("error", err.Error()).Msg("Could not find pagedata")
+			return 0, fmt.Errorf("page %d of %s: %s", i+1, inputPath, err.Error())
+		}
+
+		pdMap[i+1] = pd
+	}
+
+	if len(pdMap) == 0 {
+		return 0, fmt.Errorf("no pages in %s", inputPath)
+	}
+
+	err = pagedata.AddPageDataToPDF(inputPath, outputPath, pdMap)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Could not add pagedata")
+		return 0, err
+	}
+
+	logger.Info().Int("pages", len(pdMap)).Str("output", outputPath).Msg("Relinked pages to their pagedata")
+
+	return len(pdMap), nil
+}
+
+func readPageIdentity(imagePath string) (pagedata.PageIdentity, error) {
+
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return pagedata.PageIdentity{}, err
+	}
+	defer f.Close()
+
+	im, _, err := image.Decode(f)
+	if err != nil {
+		return pagedata.PageIdentity{}, err
+	}
+
+	data, err := qr.Decode(im)
+	if err != nil {
+		return pagedata.PageIdentity{}, err
+	}
+
+	return pagedata.ParsePageIdentity(string(data))
+}
+
+// readFirstPageIdentity checks only the first page, so that we don't convert
+// whole raw scripts at ingest just to find they have no code
+func readFirstPageIdentity(path string) (pagedata.PageIdentity, error) {
+
+	tempDir, err := ioutil.TempDir("", "gradex-relink")
+	if err != nil {
+		return pagedata.PageIdentity{}, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	imagePath := filepath.Join(tempDir, "page.jpg")
+
+	err = ConvertPDFPageToJPEG(path, 1, imagePath)
+	if err != nil {
+		return pagedata.PageIdentity{}, err
+	}
+
+	return readPageIdentity(imagePath)
+}
+
+// RelinkExam returns the exam that a PDF belongs to, from the identity code
+// on its first page, so that the exam can be locked while it is relinked
+func (g *Ingester) RelinkExam(path string) (string, error) {
+
+	id, err := readFirstPageIdentity(path)
+	if err != nil {
+		return "", err
+	}
+
+	examRoot, err := g.findExamRoot(id.Exam)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Base(examRoot), nil
+}
+
+// findPageData looks through the exam's files for the page with this UUID,
+// starting with files for the same anonymous identity
+func (g *Ingester) findPageData(id pagedata.PageIdentity, cache map[string]map[int]pagedata.PageData) (pagedata.PageData, error) {
+
+	examRoot, err := g.findExamRoot(id.Exam)
+	if err != nil {
+		return pagedata.PageData{}, err
+	}
+
+	paths, err := g.GetFileList(examRoot)
+	if err != nil {
+		return pagedata.PageData{}, err
+	}
+
+	for _, path := range paths {
+
+		if !IsPDF(path) || (id.Who != "" && !strings.Contains(filepath.Base(path), id.Who)) {
+			continue
+		}
+
+		pdMap, ok := cache[path]
+
+		if !ok {
+			pdMap, err = pagedata.UnMarshalAllFromFile(path)
+			if err != nil {
+				pdMap = make(map[int]pagedata.PageData) // no pagedata, so no need to look again
+			}
+			cache[path] = pdMap
+		}
+
+		for _, pd := range pdMap {
+			if pd.Current.UUID == id.UUID {
+				return pd, nil
+			}
+		}
+	}
+
+	return pagedata.PageData{}, fmt.Errorf("no page with UUID %s in exam %s", id.UUID, id.Exam)
+}
+
+// findExamRoot allows for the exam name being shortened in the identity
+func (g *Ingester) findExamRoot(exam string) (string, error) {
+
+	if exam == "" {
+		return "", fmt.Errorf("no exam in page identity")
+	}
+
+	path := filepath.Join(g.Exam(), exam)
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	exams, err := GetSubDirList(g.Exam())
+	if err != nil {
+		return "", err
+	}
+
+	for _, dir := range exams {
+		if strings.HasPrefix(filepath.Base(dir), exam) {
+			return dir, nil
+		}
+	}
+
+	return "", fmt.Errorf("no exam called %s", exam)
+}
+
+// relinkIngestPDF tries to relink a PDF with no pagedata that has arrived in
+// ingest, if we are looking for scans, replacing it with the relinked version,
+// which it returns. A PDF for an exam that another process has locked is left
+// in ingest for next time, so it is reported as handled, but with no path.
+func (g *Ingester) relinkIngestPDF(path string, logger *zerolog.Logger) (string, bool) {
+
+	if !g.relinkScans || strings.HasSuffix(path, relinkedSuffix) {
+		return "", false
+	}
+
+	exam, err := g.RelinkExam(path)
+	if err != nil {
+		return "", false
+	}
+
+	if g.lockedByOther(exam) {
+		logger.Info().Str("file", path).Str("exam", exam).Msg("Exam is locked by another process, leaving scan in ingest")
+		return "", true
+	}
+
+	relinkedPath := strings.TrimSuffix(path, filepath.Ext(path)) + relinkedSuffix
+
+	_, err = g.RelinkPDF(path, relinkedPath)
+	if err != nil {
+		logger.Error().Str("file", path).Str("error", err.Error()).Msg("PDF has identity codes but could not be relinked")
+		return "", false
+	}
+
+	err = os.Remove(path)
+	if err != nil {
+		logger.Error().Str("file", path).Str("error", err.Error()).Msg("Could not remove PDF after relinking")
+	}
+
+	logger.Info().Str("file", path).Str("relinked", relinkedPath).Msg("Relinked PDF with no pagedata from its identity codes")
+
+	return relinkedPath, true
+}
@@ -0,0 +1,131 @@
+package ingester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/timdrysdale/gradex-cli/csv"
+)
+
+// The size report shows how much space the PDFs in each stage take, so that
+// the effect of changing the raster settings (see RasterConfig) can be seen.
+// Each stage is also compared, per page, with the first stage in the report
+// (usually the scripts as received), so e.g. bilevel pages can be seen to
+// shrink.
+
+type StageSize struct {
+	Stage string
+	Files int
+	Pages int
+	Bytes int64
+}
+
+func (s StageSize) BytesPerPage() int64 {
+	if s.Pages == 0 {
+		return 0
+	}
+	return s.Bytes / int64(s.Pages)
+}
+
+// PercentOf compares the bytes per page with those of another stage
+func (s StageSize) PercentOf(base StageSize) int64 {
+	if base.BytesPerPage() == 0 {
+		return 0
+	}
+	return 100 * s.BytesPerPage() / base.BytesPerPage()
+}
+
+// GetStageSizes returns the sizes of the PDFs in each stage
+// that has any, in the order of the stages
+func (g *Ingester) GetStageSizes(exam string) ([]StageSize, error) {
+
+	sizes := []StageSize{}
+
+	for _, stage := range ExamStage {
+
+		files, err := g.GetFileList(g.GetExamDir(exam, stage))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return sizes, err
+		}
+
+		size := StageSize{Stage: stage}
+
+		for _, file := range files {
+
+			if !IsPDF(file) {
+				continue
+			}
+
+			info, err := os.Stat(file)
+			if err != nil {
+				return sizes, err
+			}
+
+			pages, err := CountPages(file)
+			if err != nil {
+				g.logger.Error().
+					Str("file", file).
+					Str("error", err.Error()).
+					Msg("Could not count pages for size report")
+			}
+
+			size.Files++
+			size.Pages += pages
+			size.Bytes += info.Size()
+		}
+
+		if size.Files > 0 {
+			sizes = append(sizes, size)
+		}
+	}
+
+	return sizes, nil
+}
+
+func (g *Ingester) SizeReport(exam string) ([]StageSize, error) {
+
+	logger := g.logger.With().Str("process", "size-report").Logger()
+
+	sizes, err := g.GetStageSizes(exam)
+	if err != nil {
+		return sizes, err
+	}
+
+	s := csv.New()
+
+	s.SetFixedHeader([]string{"stage", "files", "pages", "bytes", "bytes-per-page", "percent-of-first"})
+
+	for _, size := range sizes {
+		line := s.Add()
+		line.Add("stage", size.Stage)
+		line.Add("files", fmt.Sprintf("%d", size.Files))
+		line.Add("pages", fmt.Sprintf("%d", size.Pages))
+		line.Add("bytes", fmt.Sprintf("%d", size.Bytes))
+		line.Add("bytes-per-page", fmt.Sprintf("%d", size.BytesPerPage()))
+		line.Add("percent-of-first", fmt.Sprintf("%d", size.PercentOf(sizes[0])))
+	}
+
+	reportBase := fmt.Sprintf("Sizes-%s-%d.csv", shortenAssignment(exam), time.Now().Unix())
+	reportPath := filepath.Join(g.GetExamDir(exam, reports), reportBase)
+
+	f, err := os.OpenFile(reportPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return sizes, err
+	}
+
+	defer f.Close()
+
+	_, err = s.WriteCSV(f)
+
+	logger.Info().
+		Str("report", reportPath).
+		Int("stages", len(sizes)).
+		Msg("Wrote size report")
+
+	return sizes, err
+}
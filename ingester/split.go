@@ -9,8 +9,18 @@ func ConvertPDFToJPEGs(pdfPath string, jpegPath string, outputFile string) error
 	return image.ConvertPDFToJPEGs(pdfPath, jpegPath, outputFile)
 }
 
+func ConvertPDFPageToJPEG(pdfPath string, page int, outputFile string) error {
+
+	return image.ConvertPDFPageToJPEG(pdfPath, page, outputFile)
+}
+
 func CropToQuestion(inputPath, outputPath string) error {
 
 	return image.CropToQuestion(inputPath, outputPath)
 
 }
+
+func ConvertPDFToJPEGsWithSettings(pdfPath string, jpegPath string, outputFile string, rs image.RasterSettings) error {
+
+	return image.ConvertPDFToJPEGsWithSettings(pdfPath, jpegPath, outputFile, rs)
+}
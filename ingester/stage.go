@@ -134,6 +134,14 @@ func (g *Ingester) handleIngestPDF(path string, logger *zerolog.Logger) {
 		// put in TempPDF in case it is raw script. If the other cases apply, it will ultimately be rejected
 		// and we can have a human sort it from there (TODO pagedata injection tool for these repair jobs!)
 
+		// pages we rendered have an identity code, so they can get their pagedata back
+		if relinkedPath, ok := g.relinkIngestPDF(path, logger); ok {
+			if relinkedPath != "" {
+				g.handleIngestPDF(relinkedPath, logger)
+			}
+			return
+		}
+
 		moved, err := g.MoveIfNewerThanDestinationInDir(path, g.TempPDF(), logger)
 
 		if err != nil {
@@ -164,6 +172,30 @@ func (g *Ingester) handleIngestPDF(path string, logger *zerolog.Logger) {
 			).Msg("Identified a PDF with pagedata, for ingesting")
 	}
 	t := ts[1]
+
+	// leave the file in ingest for next time, rather than moving it
+	// under the feet of another process working on this exam
+	if g.lockedByOther(t.What) {
+		logger.Info().
+			Str("file", path).
+			Str("exam", t.What).
+			Msg("Exam is locked by another process, leaving file in ingest")
+		return
+	}
+
+	// work that was reassigned while this actor had it goes to one side
+	if ValidStageForExport(t.ToDo) && g.quarantineLateReturn(t.What, t.ToDo, t.For, path, logger) {
+		return
+	}
+
+	// note the return against the batch it was exported in
+	if ValidStageForExport(t.ToDo) {
+		if _, sent, err := getExportStageDirs(t.ToDo); err == nil {
+			unmodified := g.IsSameAsSelfInDir(path, g.GetExamDirNamed(t.What, sent, t.For))
+			g.matchReturn(t.What, t.ToDo, t.For, path, unmodified, logger)
+		}
+	}
+
 	switch t.ToDo {
 
 	case "flattening":
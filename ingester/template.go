@@ -0,0 +1,296 @@
+package ingester
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/timdrysdale/gradex-cli/image"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+)
+
+// The spreads that the stages render from each layout, so that a layout can
+// be checked for all of them before it is used (see parsesvg.LintLayout).
+// Bars are drawn beside the previous image of the page, covers are not.
+
+var overlaySpreads = []parsesvg.LintSpread{
+	{Name: "label", Previous: true},
+	{Name: "mark", Previous: true},
+	{Name: "moderate-active", Previous: true},
+	{Name: "moderate-inactive", Previous: true},
+	{Name: "enter-active", Previous: true},
+	{Name: "enter-inactive", Previous: true},
+	{Name: "check", Previous: true},
+	{Name: "merge", Previous: true},
+	{Name: "flatten-processed", Previous: true},
+	{Name: "addition"},
+	{Name: "final"},
+}
+
+var ingestSpreads = []parsesvg.LintSpread{
+	{Name: "flatten", Previous: true},
+}
+
+// LintOverlayTemplate checks the current overlay layout, and its ladders,
+// as the exam would use them, if one is given (see ExamTemplate)
+func (g *Ingester) LintOverlayTemplate(exam string) ([]parsesvg.LintProblem, error) {
+
+	layout, templateDirs := g.examTemplate(exam, g.OverlayLayoutSVG())
+
+	problems, err := parsesvg.LintLayout(layout, overlaySpreads, templateDirs...)
+
+	g.logLint(layout, problems, err)
+
+	return problems, err
+}
+
+// LintIngestTemplate checks the current ingest layout, used for flattening
+func (g *Ingester) LintIngestTemplate(exam string) ([]parsesvg.LintProblem, error) {
+
+	layout, templateDirs := g.examTemplate(exam, g.FlattenLayoutSVG())
+
+	problems, err := parsesvg.LintLayout(layout, ingestSpreads, templateDirs...)
+
+	g.logLint(layout, problems, err)
+
+	return problems, err
+}
+
+func (g *Ingester) logLint(layout string, problems []parsesvg.LintProblem, err error) {
+
+	logger := g.logger.With().Str("process", "template-lint").Str("layout", layout).Logger()
+
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Can't lint layout")
+		return
+	}
+
+	logger.Info().
+		Int("problems", len(problems)).
+		Int("errors", parsesvg.CountLintErrors(problems)).
+		Msg("Linted layout")
+}
+
+func (g *Ingester) PreviewDir() string {
+	return filepath.Join(g.Var(), "preview")
+}
+
+// PreviewTemplate renders the spread of the current overlay layout (or
+// every spread the stages use, if spread is empty) over sample pages,
+// and returns the paths of the previews, see parsesvg.RenderPreview.
+// If an exam is given, its own template files are used where it has them.
+func (g *Ingester) PreviewTemplate(spread, exam string, debug bool) ([]string, error) {
+
+	layout, templateDirs := g.examTemplate(exam, g.OverlayLayoutSVG())
+
+	logger := g.logger.With().Str("process", "template-preview").Str("layout", layout).Logger()
+
+	spreads := []string{spread}
+
+	if spread == "" {
+		spreads = []string{}
+		for _, s := range overlaySpreads {
+			spreads = append(spreads, s.Name)
+		}
+	}
+
+	// show the optical boxes as flatten would read them
+	shrink := -1 * g.opticalExpand
+
+	profile, err := g.GetOpticalProfile(exam)
+	if err == nil && profile.Calibrated {
+		shrink = profile.Shrink
+	}
+
+	err = g.EnsureDirAll(g.PreviewDir())
+	if err != nil {
+		return []string{}, err
+	}
+
+	previews := []string{}
+
+	for _, name := range spreads {
+
+		paths, err := parsesvg.RenderPreview(layout, name, g.PreviewDir(), debug, float64(shrink)*72/image.RasterDPI, templateDirs...)
+		if err != nil {
+			logger.Error().Str("spread", name).Str("error", err.Error()).Msg("Can't preview spread")
+			return previews, fmt.Errorf("can't preview spread %s: %v", name, err)
+		}
+
+		previews = append(previews, paths...)
+	}
+
+	logger.Info().Int("previews", len(previews)).Bool("debug", debug).Msg("Rendered previews")
+
+	return previews, nil
+}
+
+// BuildTemplate makes the layout described in the spec file, and its ladders,
+// in the overlay template folder, see parsesvg.BuildLayout. If an exam is
+// given, spreads with no questions get those in the exam's questions.csv.
+func (g *Ingester) BuildTemplate(specPath, exam string, overwrite bool) ([]string, error) {
+	return g.buildTemplate(specPath, exam, g.OverlayTemplate(), overwrite)
+}
+
+// BuildExamTemplate is BuildTemplate for the exam's own template folder, so
+// that only that exam uses the files
+func (g *Ingester) BuildExamTemplate(specPath, exam string, overwrite bool) ([]string, error) {
+
+	if exam == "" {
+		return []string{}, errors.New("no exam given for the template")
+	}
+
+	dir := g.ExamTemplate(exam)
+
+	err := g.EnsureDirAll(dir)
+	if err != nil {
+		return []string{}, err
+	}
+
+	return g.buildTemplate(specPath, exam, dir, overwrite)
+}
+
+func (g *Ingester) buildTemplate(specPath, exam, dir string, overwrite bool) ([]string, error) {
+
+	logger := g.logger.With().Str("process", "template-build").Str("spec", specPath).Str("dir", dir).Logger()
+
+	contents, err := ioutil.ReadFile(specPath)
+	if err != nil {
+		return []string{}, err
+	}
+
+	var spec parsesvg.BuildSpec
+
+	err = json.Unmarshal(contents, &spec)
+	if err != nil {
+		return []string{}, fmt.Errorf("%s: %v", specPath, err)
+	}
+
+	if exam != "" {
+
+		questions, err := g.examQuestions(exam)
+		if err != nil {
+			logger.Error().Str("exam", exam).Str("error", err.Error()).Msg("Can't get questions for template")
+			return []string{}, err
+		}
+
+		for idx, ss := range spec.Spreads {
+			if len(ss.Questions) == 0 {
+				spec.Spreads[idx].Questions = questions
+			}
+		}
+	}
+
+	built, err := parsesvg.BuildLayout(spec)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Can't build template")
+		return []string{}, err
+	}
+
+	paths, err := built.Write(dir, overwrite)
+	if err != nil {
+		logger.Error().Str("error", err.Error()).Msg("Can't write template")
+		return paths, err
+	}
+
+	logger.Info().Str("exam", exam).Str("layout", spec.ID).Int("files", len(paths)).Msg("Built template")
+
+	return paths, nil
+}
+
+// examQuestions reads the questions in the exam's questions.csv, e.g. 1,2,3,
+// in the same way as the check report does, but without any blank entries
+func (g *Ingester) examQuestions(exam string) ([]string, error) {
+
+	qfile := filepath.Join(g.GetExamDir(exam, config), "questions.csv")
+
+	required, err := GetRequiredQuestions(qfile)
+	if err != nil {
+		return []string{}, fmt.Errorf("Error opening questions file %s", qfile)
+	}
+
+	questions := []string{}
+
+	for _, q := range required {
+		if q != "" {
+			questions = append(questions, q)
+		}
+	}
+
+	if len(questions) == 0 {
+		return questions, fmt.Errorf("no questions in %s", qfile)
+	}
+
+	return questions, nil
+}
+
+// An exam can have its own template folder, in its config dir, for layouts,
+// ladders, chrome and fonts that differ from the shared ones in the overlay
+// (or ingest) template folder. A file there is used in place of the shared
+// file of the same name, and anything it doesn't have comes from the shared
+// folder, so an exam that only needs a different mark ladder only needs to
+// keep that ladder. Pagedata records a hash of the files each spread was
+// made with, see templateProcessDetail.
+
+// ExamTemplate is the exam's own template folder, which needn't exist
+func (g *Ingester) ExamTemplate(exam string) string {
+	return filepath.Join(g.Exam(), exam, config, examTemplateDir)
+}
+
+// examTemplate returns the layout to use for the exam, which is its own if it
+// has one of the same name, and the template dirs to look for the files the
+// layout refers to in (none if the exam has no template folder)
+func (g *Ingester) examTemplate(exam, layoutPath string) (string, []string) {
+
+	if exam == "" {
+		return layoutPath, nil
+	}
+
+	dir := g.ExamTemplate(exam)
+
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return layoutPath, nil
+	}
+
+	dirs := []string{dir, filepath.Dir(layoutPath)}
+
+	examLayout := filepath.Join(dir, filepath.Base(layoutPath))
+
+	if _, err := os.Stat(examLayout); err == nil {
+		return examLayout, dirs
+	}
+
+	return layoutPath, dirs
+}
+
+// templateProcessDetail records the template used for the spread in the
+// process data, without touching the Data of the process we were given
+// (as for rasterProcessDetail)
+func templateProcessDetail(pd pagedata.ProcessDetail, layoutPath, spread string, templateDirs []string) (pagedata.ProcessDetail, error) {
+
+	hash, err := parsesvg.TemplateHash(layoutPath, spread, templateDirs...)
+	if err != nil {
+		return pd, err
+	}
+
+	data := []pagedata.Field{}
+	data = append(data, pd.Data...)
+
+	data = append(data,
+		pagedata.Field{Key: "template-spread", Value: spread},
+		pagedata.Field{Key: "template-layout", Value: layoutPath},
+		pagedata.Field{Key: "template-hash", Value: hash},
+	)
+
+	if len(templateDirs) > 0 {
+		data = append(data, pagedata.Field{Key: "template-exam-dir", Value: templateDirs[0]})
+	}
+
+	pd.Data = data
+
+	return pd, nil
+}
@@ -0,0 +1,262 @@
+package ingester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/rs/zerolog"
+)
+
+// Turnaround tracking: every file that is exported is recorded in the
+// exam's export register, with the batch it went out in, who it went to, and
+// when it is due back. When ingest routes a returned file, the matching
+// record is marked as returned, so we can see what each actor still has.
+
+var (
+	turnaroundOut      = "out"
+	turnaroundReturned = "returned"
+	turnaroundUnsent   = "unsent" //came back unmodified, so went back to ready
+)
+
+type ExportRecord struct {
+	Batch    string `csv:"batch"`
+	Stage    string `csv:"stage"`
+	Actor    string `csv:"actor"`
+	File     string `csv:"file"`
+	Exported string `csv:"exported"`
+	Due      string `csv:"due"`
+	Status   string `csv:"status"`
+	Returned string `csv:"returned"`
+}
+
+type ActorTurnaround struct {
+	Actor       string
+	Stage       string
+	Outstanding []string
+	Overdue     []string
+	Returned    int
+	Partial     []string //batches with some, but not all, files returned
+}
+
+func (g *Ingester) ExportRegister(exam string) string {
+	return filepath.Join(g.GetExamDir(exam, config), "export-register.csv")
+}
+
+func (g *Ingester) GetExportRecords(exam string) ([]ExportRecord, error) {
+
+	records := []ExportRecord{}
+
+	f, err := os.Open(g.ExportRegister(exam))
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return records, err
+	}
+	defer f.Close()
+
+	err = gocsv.UnmarshalFile(f, &records)
+
+	return records, err
+}
+
+func (g *Ingester) writeExportRecords(exam string, records []ExportRecord) error {
+
+	f, err := os.Create(g.ExportRegister(exam))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return gocsv.MarshalFile(&records, f)
+}
+
+func (g *Ingester) recordExportBatch(exam, stage, actor string, files []string, due time.Time) error {
+
+	if len(files) < 1 {
+		return nil
+	}
+
+	records, err := g.GetExportRecords(exam)
+	if err != nil {
+		return err
+	}
+
+	batch := safeUUID()
+	now := time.Now().Format(time.RFC3339)
+	dueStr := ""
+	if !due.IsZero() {
+		dueStr = due.Format(time.RFC3339)
+	}
+
+	for _, file := range files {
+		records = append(records, ExportRecord{
+			Batch:    batch,
+			Stage:    stage,
+			Actor:    GetShortActorName(actor),
+			File:     filepath.Base(file),
+			Exported: now,
+			Due:      dueStr,
+			Status:   turnaroundOut,
+		})
+	}
+
+	g.logger.Info().
+		Str("exam", exam).
+		Str("stage", stage).
+		Str("actor", actor).
+		Str("batch", batch).
+		Str("due", dueStr).
+		Int("count", len(files)).
+		Msg("Recorded export batch")
+
+	return g.writeExportRecords(exam, records)
+}
+
+// matchReturn marks the latest export of this file to this actor as returned,
+// or as unsent if it has come back unmodified
+func (g *Ingester) matchReturn(exam, stage, actor, path string, unmodified bool, logger *zerolog.Logger) {
+
+	records, err := g.GetExportRecords(exam)
+	if err != nil {
+		logger.Error().Str("file", path).Str("error", err.Error()).Msg("Could not read export register to match return")
+		return
+	}
+
+	file := filepath.Base(path)
+	short := GetShortActorName(actor)
+
+	for i := len(records) - 1; i >= 0; i-- {
+
+		r := records[i]
+
+		if r.File != file || r.Actor != short || r.Stage != stage {
+			continue
+		}
+
+		if r.Status != turnaroundOut {
+			return // already matched, e.g. a second copy of a return
+		}
+
+		if unmodified {
+			records[i].Status = turnaroundUnsent
+		} else {
+			records[i].Status = turnaroundReturned
+		}
+		records[i].Returned = time.Now().Format(time.RFC3339)
+
+		err = g.writeExportRecords(exam, records)
+		if err != nil {
+			logger.Error().Str("file", path).Str("error", err.Error()).Msg("Could not update export register")
+			return
+		}
+
+		logger.Info().
+			Str("file", path).
+			Str("batch", r.Batch).
+			Str("status", records[i].Status).
+			Msg("Matched return to export batch")
+		return
+	}
+
+	logger.Warn().
+		Str("file", path).
+		Str("stage", stage).
+		Str("actor", actor).
+		Msg("Return does not match any export batch")
+}
+
+func (g *Ingester) GetTurnaround(exam string, now time.Time) ([]ActorTurnaround, error) {
+
+	records, err := g.GetExportRecords(exam)
+	if err != nil {
+		return []ActorTurnaround{}, err
+	}
+
+	return summariseTurnaround(records, now), nil
+}
+
+func summariseTurnaround(records []ExportRecord, now time.Time) []ActorTurnaround {
+
+	// only the latest export of each file counts, in case it was sent twice
+	latest := make(map[string]ExportRecord)
+	order := []string{}
+
+	for _, r := range records {
+		key := r.Stage + "/" + r.Actor + "/" + r.File
+		if _, ok := latest[key]; !ok {
+			order = append(order, key)
+		}
+		latest[key] = r
+	}
+
+	byActor := make(map[string]*ActorTurnaround)
+	batchOut := make(map[string]int)
+	batchBack := make(map[string]int)
+	batchActor := make(map[string]string)
+
+	for _, key := range order {
+
+		r := latest[key]
+
+		actorKey := r.Stage + "/" + r.Actor
+
+		if _, ok := byActor[actorKey]; !ok {
+			byActor[actorKey] = &ActorTurnaround{
+				Actor:       r.Actor,
+				Stage:       r.Stage,
+				Outstanding: []string{},
+				Overdue:     []string{},
+				Partial:     []string{},
+			}
+		}
+
+		at := byActor[actorKey]
+
+		switch r.Status {
+
+		case turnaroundReturned:
+			at.Returned++
+			batchBack[r.Batch]++
+
+		case turnaroundOut:
+			at.Outstanding = append(at.Outstanding, r.File)
+			if due, err := time.Parse(time.RFC3339, r.Due); err == nil && now.After(due) {
+				at.Overdue = append(at.Overdue, r.File)
+			}
+
+		default:
+			continue //unsent files are not the actor's to return
+		}
+
+		batchOut[r.Batch]++
+		batchActor[r.Batch] = actorKey
+	}
+
+	for batch, count := range batchOut {
+		if batchBack[batch] > 0 && batchBack[batch] < count {
+			at := byActor[batchActor[batch]]
+			at.Partial = append(at.Partial, fmt.Sprintf("%s (%d/%d returned)", batch, batchBack[batch], count))
+		}
+	}
+
+	result := []ActorTurnaround{}
+
+	for _, at := range byActor {
+		sort.Strings(at.Partial)
+		result = append(result, *at)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Actor == result[j].Actor {
+			return result[i].Stage < result[j].Stage
+		}
+		return result[i].Actor < result[j].Actor
+	})
+
+	return result
+}
@@ -0,0 +1,49 @@
+package ingester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummariseTurnaround(t *testing.T) {
+
+	now := time.Date(2020, 6, 10, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-24 * time.Hour).Format(time.RFC3339)
+	future := now.Add(24 * time.Hour).Format(time.RFC3339)
+
+	records := []ExportRecord{
+		{Batch: "b1", Stage: "marking", Actor: "ABC", File: "a.pdf", Due: past, Status: turnaroundReturned},
+		{Batch: "b1", Stage: "marking", Actor: "ABC", File: "b.pdf", Due: past, Status: turnaroundOut},
+		{Batch: "b2", Stage: "marking", Actor: "ABC", File: "c.pdf", Due: future, Status: turnaroundOut},
+		{Batch: "b3", Stage: "marking", Actor: "DEF", File: "d.pdf", Status: turnaroundReturned},
+		{Batch: "b3", Stage: "marking", Actor: "DEF", File: "e.pdf", Status: turnaroundUnsent},
+		// e.pdf sent again later, with no due date
+		{Batch: "b4", Stage: "marking", Actor: "DEF", File: "e.pdf", Status: turnaroundOut},
+		{Batch: "b5", Stage: "checking", Actor: "ABC", File: "a.pdf", Status: turnaroundOut},
+	}
+
+	summaries := summariseTurnaround(records, now)
+
+	assert.Equal(t, 3, len(summaries))
+
+	abcChecking := summaries[0]
+	assert.Equal(t, "ABC", abcChecking.Actor)
+	assert.Equal(t, "checking", abcChecking.Stage)
+	assert.Equal(t, []string{"a.pdf"}, abcChecking.Outstanding)
+
+	abc := summaries[1]
+	assert.Equal(t, "marking", abc.Stage)
+	assert.Equal(t, 1, abc.Returned)
+	assert.Equal(t, []string{"b.pdf", "c.pdf"}, abc.Outstanding)
+	assert.Equal(t, []string{"b.pdf"}, abc.Overdue)
+	assert.Equal(t, []string{"b1 (1/2 returned)"}, abc.Partial)
+
+	def := summaries[2]
+	assert.Equal(t, "DEF", def.Actor)
+	assert.Equal(t, 1, def.Returned)
+	assert.Equal(t, []string{"e.pdf"}, def.Outstanding)
+	assert.Equal(t, []string{}, def.Overdue)
+	assert.Equal(t, []string{}, def.Partial)
+}
@@ -60,8 +60,9 @@ type OverlayCommand struct {
 	OpticalBoxSpread         string
 	ReadOpticalBoxes         bool
 	AncestorPath             string
-	OmitPreviousComments     bool //this is for the checked stage, where we don't want earlier comments
-	PropagateTextFieldValues bool // this is for enter active - copy textfield values out of pagedata into enter bar
+	OmitPreviousComments     bool            //this is for the checked stage, where we don't want earlier comments
+	PropagateTextFieldValues bool            // this is for enter active - copy textfield values out of pagedata into enter bar
+	OnlyFiles                map[string]bool //if not nil, only overlay files with these base names (e.g. an allocation)
 }
 
 type CoverPageCommand struct {
@@ -71,11 +72,25 @@ type CoverPageCommand struct {
 	ConfigPath     string
 	ExamName       string
 	TemplatePath   string
+	TemplateDirs   []string // set from the exam's template folder, if it has one
 	SpreadName     string
 	ProcessDetail  pagedata.ProcessDetail
 	PathDecoration string
 }
 
+var (
+	opticalReadingSuffix     = "-optical-reading"
+	opticalConfidenceSuffix  = "-optical-confidence"
+	opticalReadingConfidence = 0.9 //trust handwritten readings at least this confident
+)
+
+var (
+	opticalFillSuffix           = "-optical-fill"
+	opticalFillConfidenceSuffix = "-optical-fill-confidence"
+	opticalUncertainSuffix      = "-optical-uncertain"
+	markUncertain               = "mark-uncertain"
+)
+
 var (
 	textFieldPrefix = "tf-"
 	markDetected    = "mark-detected"
@@ -141,6 +156,7 @@ var (
 		reCheckerFlattened,
 		reCheckerProcessed,
 		finalPapers,
+		reassigned,
 		reports,
 	}
 )
@@ -164,6 +180,7 @@ const (
 
 	//>>>>>>>>>>>> INTERNAL >>>>>>>>>>>>>>>>>>
 	config               = "00-config"
+	examTemplateDir      = "template" // in the config dir, see ExamTemplate
 	pageBad              = "01-page-bad"
 	acceptedReceipts     = "02-accepted-receipts"
 	acceptedPapers       = "03-accepted-papers"
@@ -232,6 +249,7 @@ const (
 	reCheckerFlattened   = "93-rechecker-flattened"
 	reCheckerProcessed   = "94-rechecker-processed"
 	finalPapers          = "95-final-papers"
+	reassigned           = "98-reassigned"
 	reports              = "99-reports"
 
 	inactive       = "inactive"
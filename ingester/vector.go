@@ -0,0 +1,108 @@
+package ingester
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/timdrysdale/gradex-cli/parsesvg"
+	"github.com/timdrysdale/unipdf/v3/annotator"
+	pdf "github.com/timdrysdale/unipdf/v3/model"
+)
+
+// In vector mode (see image.RasterSettings), the pages of the previous stage
+// go into the next stage as they are, rather than as images, so that typed
+// answers stay sharp, and their text can still be selected. Textfields,
+// comments and other annotations are flattened into the page contents first,
+// so what the marker wrote can't be edited afterwards.
+
+type previousPages struct {
+	f      *os.File
+	reader *pdf.PdfReader
+}
+
+// openPreviousPages flattens the annotations of the pdf, keeping the file
+// open because the pages are read from it as they are needed
+func openPreviousPages(path string) (*previousPages, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := pdf.NewPdfReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	isEncrypted, err := reader.IsEncrypted()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if isEncrypted {
+		_, err = reader.Decrypt([]byte(""))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+
+	// make appearances for any textfields that have a value but no appearance,
+	// so that they are not lost when flattened
+	err = reader.FlattenFields(true, annotator.FieldAppearance{OnlyIfMissing: true})
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("can't flatten annotations in %s: %s", path, err.Error())
+	}
+
+	return &previousPages{f: f, reader: reader}, nil
+}
+
+// Page returns the page, counting from 1
+func (p *previousPages) Page(n int) (*pdf.PdfPage, error) {
+	return p.reader.GetPage(n)
+}
+
+func (p *previousPages) Close() error {
+	return p.f.Close()
+}
+
+// placePage puts page n in the spread in place of the image of it
+func (p *previousPages) placePage(contents *parsesvg.SpreadContents, n int) error {
+
+	page, err := p.Page(n)
+	if err != nil {
+		return err
+	}
+
+	contents.PreviousImagePath = ""
+	contents.PreviousPage = page
+
+	return nil
+}
+
+// placeHalf puts the left or right half of page n in the spread, for a page
+// that is being split, see flattenPages
+func (p *previousPages) placeHalf(contents *parsesvg.SpreadContents, n int, half string) error {
+
+	page, err := p.Page(n)
+	if err != nil {
+		return err
+	}
+
+	left, right, err := parsesvg.SplitPreviousPage(page)
+	if err != nil {
+		return err
+	}
+
+	contents.PreviousImagePath = ""
+	contents.PreviousPage = left
+
+	if half == rightHalf {
+		contents.PreviousPage = right
+	}
+
+	return nil
+}
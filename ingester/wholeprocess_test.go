@@ -2,15 +2,15 @@ package ingester
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"strings"
 	"testing"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/timdrysdale/chmsg"
+	"github.com/timdrysdale/gradex-cli/image"
 	"github.com/timdrysdale/gradex-cli/pagedata"
 )
 
@@ -723,26 +723,40 @@ func TestAddBars(t *testing.T) {
 
 }
 
-// for zsh (escaping of [ not needed on bash https://askubuntu.com/questions/1104907/convert-single-page-from-pdf-to-jpeg-and-getting-error-no-matches-found-binde)
-// convert ./tmp-delete-me/usr/exam/Practice/05-anonymous-papers/Practice-B999999.pdf null: ./expected/visual/Practice-B999999.pdf -compose Difference -layers composite -format %\[fx:mean\]\n info:
-
+// visuallyIdenticalMultiPagePDF renders both PDFs with each rasteriser in
+// turn, as image.TestCheck does, so the check holds whichever backend an
+// installation uses. The PDFs are copied first, because the page images are
+// written next to them, and we don't want them in ./expected
 func visuallyIdenticalMultiPagePDF(pdf1, pdf2 string) (bool, error) {
 
-	//out, err := exec.Command("compare", "-metric", "ae", pdf1, pdf2, diff).CombinedOutput()
-	out, err := exec.Command("convert", pdf1, "null: ", pdf2, "-compose", "Difference", "-layers", "composite", "-format", "%[fx:mean]\\n", "info:").CombinedOutput()
+	dir, err := ioutil.TempDir("", "gradex-visual")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(dir)
 
-	result := true
+	copy1 := filepath.Join(dir, "actual.pdf")
+	copy2 := filepath.Join(dir, "expected.pdf")
 
-	diffs := strings.Split(string(out), "\n")
+	if err := Copy(pdf1, copy1); err != nil {
+		return false, err
+	}
+	if err := Copy(pdf2, copy2); err != nil {
+		return false, err
+	}
 
-	for _, diff := range diffs {
-		//fmt.Printf("%d:[%s]\n", i, diff)
-		if diff != "" { //there's a blank line at the end
-			if diff != "0" {
-				result = false
-			}
+	defer image.SetRasteriser(image.GetRasteriser().Name())
+
+	for _, name := range image.GetRasteriserNames() {
+
+		image.SetRasteriser(name)
+
+		result, err := image.VisuallyIdenticalMultiPagePDF(copy1, copy2)
+		if err != nil || !result {
+			fmt.Printf("%s and %s differ when rendered with %s\n", pdf1, pdf2, name)
+			return result, err
 		}
 	}
 
-	return result, err
+	return true, nil
 }
@@ -0,0 +1,100 @@
+package optical
+
+import (
+	"errors"
+	"sort"
+)
+
+// Calibrating thresholds: given the fill of boxes known to be blank, and of
+// boxes known to be filled in, put Mark halfway across the gap between them,
+// with the uncertain band covering the gap, so that anything not like the
+// samples gets looked at. If the two overlap, Mark goes where the fewest
+// samples are called wrongly, and the band covers the overlap.
+
+type Calibration struct {
+	Thresholds Thresholds
+	Errors     int     // samples called wrongly at Mark
+	Separation float64 // gap between blank and filled, relative to their size; negative if they overlap
+}
+
+func CalibrateThresholds(blank, filled []float64) (Calibration, error) {
+
+	if len(blank) < 1 || len(filled) < 1 {
+		return Calibration{}, errors.New("need at least one blank and one filled box to calibrate")
+	}
+
+	b := append([]float64{}, blank...)
+	f := append([]float64{}, filled...)
+
+	sort.Float64s(b)
+	sort.Float64s(f)
+
+	maxBlank := b[len(b)-1]
+	minFilled := f[0]
+
+	c := Calibration{}
+
+	if minFilled+maxBlank > 0 {
+		c.Separation = (minFilled - maxBlank) / (minFilled + maxBlank)
+	}
+
+	if minFilled > maxBlank {
+		c.Thresholds = Thresholds{
+			Low:  maxBlank,
+			Mark: (maxBlank + minFilled) / 2,
+			High: minFilled,
+		}
+		return c, nil
+	}
+
+	c.Thresholds = Thresholds{
+		Low:  minFilled,
+		Mark: minFilled,
+		High: maxBlank,
+	}
+	c.Errors = countErrors(b, f, minFilled)
+
+	for _, m := range append(b, f...) {
+
+		if m < minFilled || m > maxBlank {
+			continue
+		}
+
+		if e := countErrors(b, f, m); e < c.Errors {
+			c.Errors = e
+			c.Thresholds.Mark = m
+		}
+	}
+
+	return c, nil
+}
+
+// countErrors is how many boxes would be called wrongly at this mark
+func countErrors(blank, filled []float64, mark float64) int {
+
+	count := 0
+
+	for _, fill := range blank {
+		if fill > mark {
+			count++
+		}
+	}
+
+	for _, fill := range filled {
+		if fill <= mark {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Better prefers fewer errors, then a wider separation
+func (c Calibration) Better(other Calibration) bool {
+
+	if c.Errors != other.Errors {
+		return c.Errors < other.Errors
+	}
+
+	return c.Separation > other.Separation
+}
@@ -0,0 +1,42 @@
+package optical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalibrateThresholds(t *testing.T) {
+
+	_, err := CalibrateThresholds([]float64{}, []float64{0.1})
+	assert.Error(t, err)
+
+	// well separated
+	c, err := CalibrateThresholds([]float64{0.002, 0, 0.01}, []float64{0.2, 0.05, 0.3})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c.Errors)
+	assert.Equal(t, 0.01, c.Thresholds.Low)
+	assert.InDelta(t, 0.03, c.Thresholds.Mark, 1e-9)
+	assert.Equal(t, 0.05, c.Thresholds.High)
+	assert.InDelta(t, 0.6667, c.Separation, 0.0001)
+	assert.NoError(t, c.Thresholds.Validate())
+
+	// overlapping, so at best one filled box is called wrongly
+	c, err = CalibrateThresholds([]float64{0.01, 0.02, 0.06}, []float64{0.04, 0.08, 0.1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, c.Errors)
+	assert.Equal(t, Thresholds{Low: 0.04, Mark: 0.06, High: 0.06}, c.Thresholds)
+	assert.True(t, c.Separation < 0)
+	assert.NoError(t, c.Thresholds.Validate())
+}
+
+func TestCalibrationBetter(t *testing.T) {
+
+	clean := Calibration{Errors: 0, Separation: 0.2}
+	wide := Calibration{Errors: 0, Separation: 0.6}
+	wrong := Calibration{Errors: 2, Separation: 0.9}
+
+	assert.True(t, wide.Better(clean))
+	assert.False(t, clean.Better(wide))
+	assert.True(t, clean.Better(wrong))
+}
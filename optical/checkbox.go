@@ -71,7 +71,7 @@ func CheckBoxFile(inputPath string, boxes []Box) ([]bool, error) {
 	return results, nil
 }
 
-// future aim is to read handwriting - currently behaves same as checkbox
+// behaves same as checkbox - see ReadDigitsFile for reading handwritten numbers
 func DataBoxFile(inputPath string, boxes []Box) ([]bool, []image.Image, error) {
 
 	var (
@@ -109,6 +109,15 @@ type SubImager interface {
 
 func CheckBoxDebug(im image.Image, box Box) (bool, image.Image, float64) {
 
+	checkImage, markedPixelFraction, aspectRatio := markedFraction(im, box)
+
+	return markedPixelFraction > DefaultThresholds.Mark*aspectRatio, checkImage, markedPixelFraction
+}
+
+// markedFraction returns the fraction of pixels that are not the background colour,
+// and the aspect ratio of the box (short side over long side)
+func markedFraction(im image.Image, box Box) (image.Image, float64, float64) {
+
 	checkImage := im.(SubImager).SubImage(box.Bounds)
 	cum := uint32(0)
 	bounds := checkImage.Bounds()
@@ -135,27 +144,25 @@ func CheckBoxDebug(im image.Image, box Box) (bool, image.Image, float64) {
 	sizeY := bounds.Max.Y - bounds.Min.Y
 	pixelCount := colourCount * sizeX * sizeY
 
-	markedPixelFraction := float64(cum) / float64(pixelCount)
+	if pixelCount == 0 {
+		return checkImage, 0, 1
+	}
 
-	thresh := 0.02 // this is a "good" thresh for a square, in practice
+	markedPixelFraction := float64(cum) / float64(pixelCount)
 
 	// we "derate" the threshold for a wide, or tall, box
 
 	aspectRatio := math.Min(float64(sizeX), float64(sizeY)) / math.Max(float64(sizeX), float64(sizeY))
 
-	thresh = thresh * aspectRatio
-
-	return markedPixelFraction > thresh, checkImage, markedPixelFraction
+	return checkImage, markedPixelFraction, aspectRatio
 }
 
 func CheckBox(im image.Image, box Box) bool {
 
-	result, _, _ := CheckBoxDebug(im, box)
-
-	return result
+	return ScoreBox(im, box, DefaultThresholds).Marked
 }
 
-// This will do handwriting recognition in future (wishlist!)
+// see ReadDigits for handwriting recognition
 func DataBox(im image.Image, box Box) (bool, image.Image) {
 
 	result, img, _ := CheckBoxDebug(im, box)
@@ -0,0 +1,126 @@
+package optical
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+)
+
+// The digit model is a small neural network, with one hidden layer, that
+// takes the glyph features and gives the chance that the glyph is each of the
+// digits, or something that is not a digit at all (a letter, a tick, a
+// slash, or a crossing out). The weights in digitWeights.go are trained on
+// handwriting generated from the stroke models in digitModel_test.go, with
+// random slant, shape, and pen, and checked against the real stylus marks in
+// the tests. To train them again, run
+//
+//    go test -run TestTrainDigitModel -train
+//
+// in this directory.
+
+const (
+	notDigit     = "?"
+	digitHidden  = 96
+	featureScale = 16 // the square root of featureCount, so the features are about one in size, not one in total
+)
+
+// digitClasses are what the model tells apart; the digits come first, and
+// everything after them is read as notDigit
+var digitClasses = []string{
+	"0", "1", "2", "3", "4", "5", "6", "7", "8", "9",
+	"A", "B", "E", "H", "M", "N", "P", "R", "U", "V", "W", "X",
+	"scribble", "slash", "tick",
+}
+
+const digitCount = 10
+
+type digitNetwork struct {
+	hidden     []float64 // digitHidden rows of featureCount
+	hiddenBias []float64
+	output     []float64 // len(digitClasses) rows of digitHidden
+	outputBias []float64
+}
+
+var digitModel = decodeDigitNetwork(digitWeights)
+
+func newDigitNetwork() digitNetwork {
+
+	inputs := featureCount
+
+	return digitNetwork{
+		hidden:     make([]float64, digitHidden*inputs),
+		hiddenBias: make([]float64, digitHidden),
+		output:     make([]float64, len(digitClasses)*digitHidden),
+		outputBias: make([]float64, len(digitClasses)),
+	}
+}
+
+// weights lists all the weights in the order they are stored
+func (n digitNetwork) weights() [][]float64 {
+	return [][]float64{n.hidden, n.hiddenBias, n.output, n.outputBias}
+}
+
+// classify returns the hidden layer, and the chance of each class
+func (n digitNetwork) classify(features []float64) ([]float64, []float64) {
+
+	inputs := len(features)
+
+	hidden := make([]float64, digitHidden)
+
+	for j := range hidden {
+		sum := n.hiddenBias[j]
+		row := n.hidden[j*inputs : (j+1)*inputs]
+		for i, f := range features {
+			sum += row[i] * f * featureScale
+		}
+		hidden[j] = math.Max(0, sum)
+	}
+
+	chance := make([]float64, len(digitClasses))
+	top := math.Inf(-1)
+
+	for k := range chance {
+		sum := n.outputBias[k]
+		row := n.output[k*digitHidden : (k+1)*digitHidden]
+		for j, h := range hidden {
+			sum += row[j] * h
+		}
+		chance[k] = sum
+		top = math.Max(top, sum)
+	}
+
+	total := 0.0
+	for k := range chance {
+		chance[k] = math.Exp(chance[k] - top)
+		total += chance[k]
+	}
+
+	for k := range chance {
+		chance[k] = chance[k] / total
+	}
+
+	return hidden, chance
+}
+
+func decodeDigitNetwork(encoded string) digitNetwork {
+
+	n := newDigitNetwork()
+
+	buf, err := base64.StdEncoding.DecodeString(encoded)
+
+	if err != nil {
+		panic("digit model weights are not valid base64: " + err.Error())
+	}
+
+	for _, w := range n.weights() {
+		if len(buf) < 4*len(w) {
+			panic("digit model weights are too short for the network")
+		}
+		for i := range w {
+			w[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:])))
+		}
+		buf = buf[4*len(w):]
+	}
+
+	return n
+}
@@ -0,0 +1,548 @@
+package optical
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The digit model is trained on glyphs generated from the stroke models below,
+// with the common variants of how people write each digit (e.g. a 1 with and
+// without a flag, an open and a closed 4), and some things that are not digits,
+// but are found in data boxes (letters for sections, ticks, slashes in marks
+// like 6/12, crossings out). Coordinates are in a box about 0.6 wide and 1
+// high, with y increasing down the page. Letters that are written the same as
+// a digit (O, I, S, Z and so on) are left out, because the model can't tell
+// them apart, and they are more likely to be digits in a data box.
+
+var train = flag.Bool("train", false, "train the digit model, and write digitWeights.go")
+
+const (
+	trainSeed      = 1
+	trainPerDigit  = 4000
+	trainPerOther  = 1000
+	trainEpochs    = 40
+	trainBatch     = 32
+	trainRate      = 0.001
+	renderCanvas   = 64
+	heldOutPerType = 200
+)
+
+type point struct {
+	x float64
+	y float64
+}
+
+type stroke []point
+
+var digitStrokes = map[string][][]stroke{
+	"0": {
+		{arc(0.3, 0.5, 0.3, 0.5, 0, 360)},
+		{arc(0.3, 0.5, 0.3, 0.5, 80, 450)},
+	},
+	"1": {
+		{line(0.3, 0, 0.3, 1)},
+		{stroke{{0.05, 0.25}, {0.3, 0}, {0.3, 1}}},
+		{stroke{{0.05, 0.25}, {0.3, 0}, {0.3, 1}}, line(0.05, 1, 0.55, 1)},
+	},
+	"2": {
+		{join(arc(0.3, 0.28, 0.3, 0.28, 160, -30), stroke{{0, 1}, {0.6, 1}})},
+		{join(arc(0.3, 0.3, 0.3, 0.3, 150, -20), stroke{{0.05, 1}}, arc(0.4, 0.92, 0.2, 0.08, 200, 340))},
+		{join(arc(0.3, 0.28, 0.3, 0.28, 170, 0), stroke{{0.02, 0.95}, {0.62, 0.97}})},
+		{join(arc(0.25, 0.27, 0.23, 0.27, 170, -30), stroke{{0.02, 0.97}, {0.8, 0.95}})},
+		{join(arc(0.3, 0.27, 0.27, 0.27, 160, -30), arc(0.1, 0.88, 0.08, 0.08, 45, 380), stroke{{0.45, 0.97}, {0.8, 0.9}})},
+	},
+	"3": {
+		{join(arc(0.3, 0.25, 0.28, 0.25, 150, -90), arc(0.3, 0.75, 0.3, 0.25, 90, -150))},
+		{stroke{{0, 0}, {0.6, 0}, {0.25, 0.42}}, arc(0.28, 0.7, 0.32, 0.3, 110, -150)},
+		{join(arc(0.3, 0.25, 0.25, 0.25, 170, -90), arc(0.3, 0.73, 0.3, 0.27, 90, -170))},
+	},
+	"4": {
+		{stroke{{0.45, 1}, {0.45, 0}, {0, 0.7}, {0.6, 0.7}}},
+		{stroke{{0.08, 0}, {0, 0.65}, {0.6, 0.65}}, line(0.45, 0.3, 0.45, 1)},
+	},
+	"5": {
+		{join(stroke{{0.55, 0}, {0.08, 0}, {0.05, 0.45}}, arc(0.3, 0.7, 0.3, 0.3, 140, -140))},
+		{line(0.08, 0, 0.55, 0), join(stroke{{0.08, 0}, {0.05, 0.45}}, arc(0.3, 0.7, 0.3, 0.3, 140, -140))},
+		{join(stroke{{0.6, 0}, {0.35, 0.02}, {0.15, 0.08}, {0.07, 0.42}}, arc(0.3, 0.7, 0.3, 0.3, 140, -140))},
+	},
+	"6": {
+		{join(stroke{{0.5, 0}, {0.2, 0.25}, {0.04, 0.6}}, arc(0.3, 0.75, 0.27, 0.25, 180, -180))},
+		{join(arc(0.6, 0.6, 0.56, 0.6, 100, 180), arc(0.32, 0.76, 0.28, 0.24, 180, -180))},
+	},
+	"7": {
+		{stroke{{0, 0}, {0.6, 0}, {0.2, 1}}},
+		{stroke{{0, 0}, {0.6, 0}, {0.2, 1}}, line(0.15, 0.5, 0.55, 0.5)},
+		{stroke{{0, 0.1}, {0, 0}, {0.6, 0}, {0.3, 1}}},
+		{stroke{{0, 0}, {0.85, 0}, {0.5, 1}}, line(0.45, 0.45, 0.85, 0.45)},
+		{stroke{{0, 0.05}, {0.9, 0}, {0.6, 0.4}, {0.5, 1}}, line(0.4, 0.45, 0.8, 0.45)},
+		{stroke{{0, 0}, {0.8, 0}, {0.4, 1}}},
+		{stroke{{0, 0.05}, {0.85, 0}, {0.55, 0.5}, {0.45, 1}}, line(0.25, 0.55, 0.85, 0.5)},
+		{stroke{{0.05, 0}, {0.7, 0}, {0.35, 1}}, line(0, 0.62, 1, 0.56)},
+		{stroke{{0.05, 0.02}, {0.75, 0}, {0.5, 0.6}, {0.35, 1}}, line(0, 0.64, 0.95, 0.6)},
+	},
+	"8": {
+		{arc(0.3, 0.25, 0.25, 0.25, 0, 360), arc(0.3, 0.73, 0.3, 0.27, 0, 360)},
+		{join(arc(0.3, 0.25, 0.25, 0.25, -30, 210), arc(0.3, 0.73, 0.3, 0.27, 90, -270))},
+	},
+	"9": {
+		{join(arc(0.3, 0.27, 0.28, 0.27, 0, 360), stroke{{0.58, 0.3}, {0.45, 1}})},
+		{join(arc(0.3, 0.27, 0.28, 0.27, 0, 360), stroke{{0.58, 0.3}, {0.58, 1}})},
+	},
+}
+
+var notDigitStrokes = map[string][][]stroke{
+	"A": {
+		{stroke{{0, 1}, {0.3, 0}, {0.6, 1}}, line(0.12, 0.6, 0.48, 0.6)},
+		{stroke{{0, 1}, {0.35, 0}, {0.6, 1}}, line(0.1, 0.65, 0.55, 0.6)},
+	},
+	"B": {
+		{line(0, 0, 0, 1), join(stroke{{0, 0}, {0.3, 0}}, arc(0.3, 0.24, 0.22, 0.24, 90, -90), stroke{{0, 0.48}, {0.32, 0.48}}, arc(0.32, 0.74, 0.26, 0.26, 90, -90), stroke{{0, 1}})},
+		{join(stroke{{0, 1}, {0, 0}, {0.25, 0}}, arc(0.25, 0.24, 0.25, 0.24, 90, -90), stroke{{0.05, 0.48}, {0.28, 0.48}}, arc(0.28, 0.74, 0.3, 0.26, 90, -90), stroke{{0, 1}})},
+	},
+	"E": {{stroke{{0.6, 0}, {0, 0}, {0, 1}, {0.6, 1}}, line(0, 0.5, 0.45, 0.5)}},
+	"H": {{line(0, 0, 0, 1), line(0.6, 0, 0.6, 1), line(0, 0.5, 0.6, 0.5)}},
+	"M": {{stroke{{0, 1}, {0, 0}, {0.4, 0.6}, {0.8, 0}, {0.8, 1}}}},
+	"N": {{stroke{{0, 1}, {0, 0}, {0.6, 1}, {0.6, 0}}}},
+	"P": {{join(stroke{{0, 1}, {0, 0}, {0.3, 0}}, arc(0.3, 0.25, 0.28, 0.25, 90, -90), stroke{{0, 0.5}})}},
+	"R": {{join(stroke{{0, 1}, {0, 0}, {0.3, 0}}, arc(0.3, 0.25, 0.28, 0.25, 90, -90), stroke{{0, 0.5}}), line(0.25, 0.5, 0.6, 1)}},
+	"U": {{join(line(0, 0, 0, 0.7), arc(0.3, 0.7, 0.3, 0.3, 180, 360), line(0.6, 0.7, 0.6, 0))}},
+	"V": {{stroke{{0, 0}, {0.3, 1}, {0.6, 0}}}},
+	"W": {{stroke{{0, 0}, {0.2, 1}, {0.4, 0.3}, {0.6, 1}, {0.8, 0}}}},
+	"X": {{line(0, 0, 0.6, 1), line(0.6, 0, 0, 1)}},
+	"tick": {
+		{stroke{{0, 0.6}, {0.25, 1}, {0.8, 0}}},
+		{stroke{{0, 0.7}, {0.3, 1}, {1, 0}}},
+	},
+}
+
+func line(x0, y0, x1, y1 float64) stroke {
+	return stroke{{x0, y0}, {x1, y1}}
+}
+
+// arc goes from angle a0 to a1 (in degrees, anticlockwise as seen on the page)
+func arc(cx, cy, rx, ry, a0, a1 float64) stroke {
+
+	s := stroke{}
+	steps := 24
+
+	for i := 0; i <= steps; i++ {
+		a := (a0 + (a1-a0)*float64(i)/float64(steps)) * math.Pi / 180
+		s = append(s, point{cx + rx*math.Cos(a), cy - ry*math.Sin(a)})
+	}
+
+	return s
+}
+
+func join(strokes ...stroke) stroke {
+
+	s := stroke{}
+
+	for _, part := range strokes {
+		s = append(s, part...)
+	}
+
+	return s
+}
+
+// renderStrokes draws the strokes with a round pen of the given radius,
+// scaling the unit height to fit the canvas, and returns the inked pixels
+func renderStrokes(strokes []stroke, size int, pen float64) []image.Point {
+
+	margin := pen + 1
+	scale := float64(size) - 2*margin
+
+	ink := make([]bool, size*size)
+
+	for _, s := range strokes {
+		for i := 1; i < len(s); i++ {
+
+			x0 := margin + s[i-1].x*scale
+			y0 := margin + s[i-1].y*scale
+			x1 := margin + s[i].x*scale
+			y1 := margin + s[i].y*scale
+
+			steps := int(math.Ceil(math.Hypot(x1-x0, y1-y0))) + 1
+
+			for j := 0; j <= steps; j++ {
+
+				t := float64(j) / float64(steps)
+				cx := x0 + (x1-x0)*t
+				cy := y0 + (y1-y0)*t
+
+				for y := int(cy - pen); y <= int(cy+pen)+1; y++ {
+					for x := int(cx - pen); x <= int(cx+pen)+1; x++ {
+						if x < 0 || y < 0 || x >= size || y >= size {
+							continue
+						}
+						if math.Hypot(float64(x)-cx, float64(y)-cy) <= pen {
+							ink[y*size+x] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	pixels := []image.Point{}
+
+	for idx, isInk := range ink {
+		if isInk {
+			pixels = append(pixels, image.Point{idx % size, idx / size})
+		}
+	}
+
+	return pixels
+}
+
+// distort writes the strokes the way a person might, with a slant (in
+// degrees from vertical), a wobble, strokes that start and stop early or
+// don't quite meet, and a wider or narrower hand, then scales the result to
+// fit the unit box
+func distort(r *rand.Rand, strokes []stroke, slant float64) []stroke {
+
+	shear := math.Tan(slant * math.Pi / 180)
+	wide := 0.75 + 0.5*r.Float64()
+
+	wobble := [4][4]float64{}
+	for i := range wobble {
+		wobble[i] = [4]float64{0.04 * r.Float64(), 0.5 + r.Float64(), 0.5 * r.NormFloat64(), 2 * math.Pi * r.Float64()}
+	}
+
+	// each way of wobbling bends the glyph smoothly, mostly across, or mostly along, the stroke
+	warp := func(w [4]float64, along, across float64) float64 {
+		return w[0] * math.Sin(2*math.Pi*w[1]*(along+w[2]*across)+w[3])
+	}
+
+	out := []stroke{}
+
+	for _, s := range strokes {
+
+		if len(s) > 4 && r.Float64() < 0.5 {
+			s = s[r.Intn(3) : len(s)-r.Intn(3)]
+		}
+
+		dx := 0.03 * r.NormFloat64()
+		dy := 0.03 * r.NormFloat64()
+
+		d := stroke{}
+
+		for _, p := range s {
+			x := p.x + warp(wobble[0], p.y, p.x) + warp(wobble[1], p.x, p.y) + dx
+			y := p.y + warp(wobble[2], p.x, p.y) + warp(wobble[3], p.y, p.x) + dy
+			d = append(d, point{x*wide + shear*(1-y), y})
+		}
+
+		out = append(out, d)
+	}
+
+	return fitUnitBox(out)
+}
+
+func fitUnitBox(strokes []stroke) []stroke {
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	for _, s := range strokes {
+		for _, p := range s {
+			minX = math.Min(minX, p.x)
+			minY = math.Min(minY, p.y)
+			maxX = math.Max(maxX, p.x)
+			maxY = math.Max(maxY, p.y)
+		}
+	}
+
+	scale := 1 / math.Max(maxX-minX, maxY-minY)
+
+	out := []stroke{}
+
+	for _, s := range strokes {
+		f := stroke{}
+		for _, p := range s {
+			f = append(f, point{(p.x - minX) * scale, (p.y - minY) * scale})
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// generateGlyph writes one glyph of the given kind, which is a digit, a
+// key of notDigitStrokes, a slash, or a scribble (all of them are in digitClasses)
+func generateGlyph(r *rand.Rand, kind string) []float64 {
+
+	var strokes []stroke
+
+	slant := -10 + 25*r.Float64()
+
+	switch kind {
+
+	case "slash":
+		// slashes lean further than any 1 we have seen
+		angle := 15 + 30*r.Float64()
+		if r.Float64() < 0.3 {
+			angle = -angle
+		}
+		strokes = []stroke{line(0, 1, math.Tan(angle*math.Pi/180), 0)}
+		slant = 0
+
+	case "scribble":
+		// crossing out goes back and forth many more times than a 2 or a 7
+		s := stroke{}
+		turns := 10 + r.Intn(8)
+		for i := 0; i <= turns; i++ {
+			s = append(s, point{0.6*float64(i%2) + 0.1*r.NormFloat64(), float64(i)/float64(turns) + 0.05*r.NormFloat64()})
+		}
+		strokes = []stroke{s}
+
+	default:
+		variants, ok := digitStrokes[kind]
+		if !ok {
+			variants = notDigitStrokes[kind]
+		}
+		strokes = variants[r.Intn(len(variants))]
+	}
+
+	if kind == "1" {
+		slant = -10 + 22*r.Float64()
+	}
+
+	pen := 1 + 2.5*r.Float64()
+
+	return glyphFeatures(renderStrokes(distort(r, strokes, slant), renderCanvas, pen))
+}
+
+type trainingGlyph struct {
+	features []float64
+	class    int
+}
+
+// generateGlyphs writes glyphs of every class, with perDigit of each digit,
+// and perOther of each thing that is not a digit
+func generateGlyphs(r *rand.Rand, perDigit, perOther int) []trainingGlyph {
+
+	glyphs := []trainingGlyph{}
+
+	for class, kind := range digitClasses {
+
+		count := perDigit
+
+		switch {
+		case kind == "slash":
+			count = 4 * perOther // slashes are the most like a digit, so need more examples
+		case class >= digitCount:
+			count = perOther
+		}
+
+		for i := 0; i < count; i++ {
+			glyphs = append(glyphs, trainingGlyph{generateGlyph(r, kind), class})
+		}
+	}
+
+	return glyphs
+}
+
+// trainDigitNetwork fits the network to the glyphs by minimising the cross
+// entropy with the Adam optimiser
+func trainDigitNetwork(r *rand.Rand, glyphs []trainingGlyph) digitNetwork {
+
+	n := newDigitNetwork()
+	inputs := featureCount
+
+	for i := range n.hidden {
+		n.hidden[i] = r.NormFloat64() * math.Sqrt(2/float64(inputs))
+	}
+	for i := range n.output {
+		n.output[i] = r.NormFloat64() * math.Sqrt(2/float64(digitHidden))
+	}
+
+	weights := n.weights()
+	grads := [][]float64{}
+	first := [][]float64{}
+	second := [][]float64{}
+
+	for _, w := range weights {
+		grads = append(grads, make([]float64, len(w)))
+		first = append(first, make([]float64, len(w)))
+		second = append(second, make([]float64, len(w)))
+	}
+
+	const beta1, beta2, eps = 0.9, 0.999, 1e-8
+	step := 0
+
+	order := r.Perm(len(glyphs))
+
+	for epoch := 0; epoch < trainEpochs; epoch++ {
+
+		r.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+		// slow down towards the end, to settle in the middle of a good minimum
+		rate := trainRate * 0.5 * (1 + math.Cos(math.Pi*float64(epoch)/float64(trainEpochs)))
+
+		for start := 0; start < len(order); start += trainBatch {
+
+			for _, g := range grads {
+				for i := range g {
+					g[i] = 0
+				}
+			}
+
+			end := minInt(start+trainBatch, len(order))
+
+			for _, idx := range order[start:end] {
+
+				g := glyphs[idx]
+				hidden, chance := n.classify(g.features)
+
+				for k := range chance {
+
+					delta := chance[k]
+					if k == g.class {
+						delta -= 1
+					}
+
+					grads[3][k] += delta
+
+					for j, h := range hidden {
+						grads[2][k*digitHidden+j] += delta * h
+					}
+				}
+
+				for j, h := range hidden {
+
+					if h <= 0 {
+						continue
+					}
+
+					delta := 0.0
+					for k := range chance {
+						d := chance[k]
+						if k == g.class {
+							d -= 1
+						}
+						delta += d * n.output[k*digitHidden+j]
+					}
+
+					grads[1][j] += delta
+
+					row := grads[0][j*inputs : (j+1)*inputs]
+					for i, f := range g.features {
+						row[i] += delta * f * featureScale
+					}
+				}
+			}
+
+			step++
+			scale := 1 / float64(end-start)
+			c1 := 1 - math.Pow(beta1, float64(step))
+			c2 := 1 - math.Pow(beta2, float64(step))
+
+			for l, w := range weights {
+				for i := range w {
+					g := grads[l][i] * scale
+					first[l][i] = beta1*first[l][i] + (1-beta1)*g
+					second[l][i] = beta2*second[l][i] + (1-beta2)*g*g
+					w[i] -= rate * (first[l][i] / c1) / (math.Sqrt(second[l][i]/c2) + eps)
+				}
+			}
+		}
+	}
+
+	return n
+}
+
+// encode stores the weights as little-endian float32, in base64
+func (n digitNetwork) encode() string {
+
+	buf := []byte{}
+	word := make([]byte, 4)
+
+	for _, w := range n.weights() {
+		for _, v := range w {
+			binary.LittleEndian.PutUint32(word, math.Float32bits(float32(v)))
+			buf = append(buf, word...)
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// heldOutAccuracy is the fraction of freshly generated glyphs that are read
+// correctly, as a digit or as not a digit
+func heldOutAccuracy(n digitNetwork, r *rand.Rand) float64 {
+
+	glyphs := generateGlyphs(r, heldOutPerType, heldOutPerType)
+
+	right := 0
+
+	for _, g := range glyphs {
+
+		_, chance := n.classify(g.features)
+
+		best := 0
+		for k := range chance {
+			if chance[k] > chance[best] {
+				best = k
+			}
+		}
+
+		if best == g.class || (best >= digitCount && g.class >= digitCount) {
+			right++
+		}
+	}
+
+	return float64(right) / float64(len(glyphs))
+}
+
+func TestTrainDigitModel(t *testing.T) {
+
+	if !*train {
+		t.Skip("run with -train to train the digit model")
+	}
+
+	r := rand.New(rand.NewSource(trainSeed))
+
+	n := trainDigitNetwork(r, generateGlyphs(r, trainPerDigit, trainPerOther))
+
+	accuracy := heldOutAccuracy(n, r)
+
+	encoded := n.encode()
+	lines := []string{}
+
+	for len(encoded) > 76 {
+		lines = append(lines, encoded[:76])
+		encoded = encoded[76:]
+	}
+	lines = append(lines, encoded)
+
+	source := fmt.Sprintf(`// Code generated by TestTrainDigitModel; DO NOT EDIT.
+
+package optical
+
+// digitWeights are the weights of the digit model, as little-endian float32,
+// in base64. They classify %.1f%% of generated glyphs correctly.
+const digitWeights = `+"`\n%s\n`\n", 100*accuracy, strings.Join(lines, "\n"))
+
+	err := ioutil.WriteFile("digitWeights.go", []byte(source), 0644)
+
+	assert.NoError(t, err)
+}
+
+// TestDigitModel checks that the bundled weights still read generated glyphs,
+// so that a change to the glyph features is not missed
+func TestDigitModel(t *testing.T) {
+
+	r := rand.New(rand.NewSource(trainSeed + 1))
+
+	assert.True(t, heldOutAccuracy(digitModel, r) > 0.95)
+}
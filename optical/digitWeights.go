@@ -0,0 +1,1910 @@
+// Code generated by TestTrainDigitModel; DO NOT EDIT.
+
+package optical
+
+// digitWeights are the weights of the digit model, as little-endian float32,
+// in base64. They classify 99.4% of generated glyphs correctly.
+const digitWeights = `
+TyMQv7l3Ob4azkw+/CB2PqH7tz1Fy9o9WcBmvZhhj707eTM/DLfrPjMNuT5adtQ+4APxvXKS971N
+iJY9sWOzvVgnFD/ql84+vqrUPh2VQj7LU8W80NzSvaVLoD3Oa4I+5UYLPrINSz6qqfo9tUp2PZNl
+/TwbVv48VV3BPbT9dz7e8eO9eO/bvLHnKL4M9hW+9KSXPUYCmr0PDu49pwkQvPDJUb57I6++HX2e
+vsVTzTup+92939kQPX2UCz5oK969nDcNvv/Jj746HjO+RXsyvY6bTL6x6c69PhTVPNvmP76oiuW+
+w0XTvMTP7j1w0re9rxi9vqaNvr68/F++GYTbvXqXp726koO9zgtSPD54m77QzDa9zFRRPRFB9j0O
+jUu+N9nQvZ8Eiz0Wwe87YjIKvutjcr73SA2+dscgvtcWuL7kRCK+oHP6u3png71NaBw+QS5ovktY
+Jj0NHg2+TgJFvl8Mrr1YV2c9y5m6Ps0cgj4x9rW7j4LnvPqZUT2ORai8GjOGvds/eD5YOXg+fXBY
+PrFyzD217Oi8jxATPnjPHj6l6Iw+2fyfPVKBpb3eqDS+CKjpveSsBT2xPjQ+O9W2vQk/Cj74tFa7
+aokAvmWS/L2PYEQ+6C/yPRCvAT6qcuy9veqaPE4KFb4qNAq+ct+xPX8C1L3jaS2+6BAUPoAdDT3i
+eWw/v9oxvr2uu77QACy+qQiLvXdJVL5qEIo98EyAPjDLAEDsYR4/zOhLviP+IL7RU4O+THUavsgk
+pbwvSBY+JbM2QL8VYT/90kM+7tyTveGseDzZz4m9OfNJPcb3T76QYzJAguSPP2lJaD0XqQS8sQyg
+PUtiKj4BIMK+KMCXv1MTsD+7Hik/hn8EvkPmPL5ETLE9occfPa2npL7xKne/CovPPjdNmj2qrnk8
+QVKQPZNZFr7nvoS8NSCevdUFBL6Cxz491YphPQj91j3AtpE95e6uPe81sr2QaZW+TazCvkOCBL52
+P4+9RFNePLxbbj339Nk9xPoRvsPAgb4o+wW//9k9vjt2AT7vRb68stmIvk/aX76zJJW9TcAAvVEg
+ir2R4ag+X/AOP/b0Tz6PgpG+S3dRvjrwNL2BxB6+Z2+VPfiQXD9SQSo/jMi/Ps6dH74gXFK9tXqO
+PNBhA71J87g9viuCPu6cJz7IQyU9HCoavrDpb75iwqs86OOyvH5rhD24Rq+7UrHMvbT2kTwtwOw5
+NKUpPWWkhb5khW6+KfhTvtHWYj4oco28jlYLPisSzL0djby8iujuvYWfDr5NZvi+fV9aPjup0z3X
+Sjo+2WcgvlKnsr3wx5y+94++vo4N8r41ALI9Sn6qPd5Wyz1erNA9W15AvrMUvr0YKgS/KC72vooq
+mD1GjLY+8H3MPXDmG75iEdS97y00PgLBVD5qBAs+cKWbvYf2FD7UeQW+aegZvjjihr1ziYk+lUop
+PkXLQLzNIaq+/3UhPs9kEz4m13C9djtTvpvopj7Nn0M+LiCAPYP0yb6lmUS8dXTMPY1IZT2tlAQ9
+29jVPRJImD1y2Fq+0ooHvQycQj7sY6c+2zswvnHiEb6Gurs99ucCPCCZmb3QX/w9HLcsPjis0z6z
+LvY9hBpHvYNnlb0P9AK+ZDd4vmLz9D3cbp4+eIgaPgSDBr7hX/S9ojDcuwun4DzKZyy+BRehPsK4
+/j2e7rS9/u7vvdJjGz3oCxk9XAfQPSrOIz3g6TO9KjPUO/90pL0FPLi9/q8MvjBBOL4O1H6+pA49
+vpGPfb0mk+I9pZwpvjqjHb6u3Ay+g+uBvuMLeL5r//e9KDgjvmHgar3nb2e9mKKxvTEBC77cEMW8
+V6mXPILIxL1oLcU+v8L3PgFhRz0Oj4K9+dYhPhRoaD6wdy8+vpjDPXB0Ez8fBTQ/4Y0YPjHhE71a
+Sb284kVcvFNkEzz0jUy+teUbP2jdzT6gLSy94NajvQU7Zr3RCCK9LM2lvXYreL76jEs+H/iTvUAx
+or7KsbS+AHwCvm6rEbxapU6+Eyxyvs+zfT5TXyW+u2mcvjkdib67Gay91F+cPetmBj67QcC+7c+6
+vt9NPz6Da2Q+MuPqvfGRB74xATq+LBOWPjjaCL65F7u/SCI+vlc5/b0e0J+8iL2iPdQbrz1BEqw+
+RHRVvsNl37+7BVa/WZtDvoZ3ibxtutE9t2y7PRGC7b2ngaG+dq1zvz9HGL/3Cyk+b9iBPu3TWD4c
+W/Y9OaG7PKuELb7P2rA9vQBQPvNlJ7zjY188khxUPgWnYz3oewY/0K7VPJTZij54PCY9a7uAviSD
+Z77+4q29NUY/PpzpDz78G7c+d+5yPTc4371+3ky+UJOKPG46FL1lUxy83ihKPTJXGz768lw6Q97G
+PZ3xFz3fwfK8VL6XvW06TL0en009JWxnPdj9xb75UQG/doCtveZSmL0IkZC9gxR/vavQhTx/ueO5
+nTfdvtFS6L6C2d282s05Puwk3jxEvLm8tjzSu1Fatz0VBh+/YVeEvvK7Br4gFZw7Fcwuva8rRz1P
+tpO79hCrvfxRbj4POwA+NycIPZ+kuz2sFAS+hyz0vcJf1zxuepe9LAWFPmF97zsUUGc+W1ibPc2q
+eTwRMUS+hxIpvchtHTv5DQk+fUOQPSOrujsoxFs+ejwtPrkUKT16NS69e2VsPtYxCj4XUsY9Yg4N
+PfF0pbtdOzw+XMQuPkGQbT29lxk+xDSAvbQ8Hj3Qx6E96onePd77DT3WkCQ+hq+FPkUxqb3mkw+/
+sGbEvXNCNb6eOjQ+vn+oO70VlT5WgJ8+oqBAPebc4r4ekiG+lS1hPG67nz1Ts509ZGtGPjZIiT7z
+eRW+am3gvpktkz18wEK+RJCZPQVmJ73CLxk+ui9TPmS4I7ww0EE9FABVPhFZbDx6rsg81vEjvL9A
+XT3EKsQ9WohaPuz8Cz4Mbjc+Pl5NPsn0tb1CFqE8TEaDvaPZGD7a1c09Ve8uvNzYYj7/Pgo+oUmM
+PQPryb0WFqi8ECEQPjTOxb2ZwXa+XYhYPko75j2/2Oc9l5GVvnPqAD4CzGw+XIE/vnFTML7jE5Y+
+W2SuPlyHqD1QpIO+v1urvS0mTD6EfYC+GMpTvJJkKr7SfmO+XkYhvqlUNL4oUXC+nlHRO4Q2ZD1h
+kkU9iv6PvlDkKL4cjKO9w6oTvvPpIj6Als+8zMnIPU0hhzwjaSi8yPdQvjeVYL6d8rW9cEI7PK74
+FT79zJC8ArPdvUAIw72+uAa+tVgFPTf9ZD65ybc9YgM/vsOpW77HeZw9l9tUvrFmJL4qfua9m7jP
+PVvB6r03PUo9K9+mveZ0Qz7ujo696UypvN02zj0zZWi9fieZvZYTjD1iXuq89tQnvTf5pD1Ju7a7
+cl8rPey9wrwAFew9QXgUPZpwGz2zM8m8qV8EvfodSD3BOMU9gbiLvE/hCD6j9dE8vWocPK1Vx71g
+6n28GKFbPU5SMz7rHhc+4VocPoIwi71NyQA+oYhTPbtyRD6Reaw+3G6tPnyEnD5By1m8AMISvsyq
+lD1/0rE+Y0aIPk6Kqj3f7U8+zWQ6PkAHMb6PIz++repIvt6amj5HOWw+ZEDDPoCYKD7wD5E+6lBK
+Pi9mb74NHV29LjV7PoRxcj5Xri8+LW2LPd0gTT7wIHI+N65yvhHkTr3cWIw+2nNVPmQlsb3IJnO9
+LcHFPOKrMr0qKLC+t9zQvfFilD4Jst69BbcEvjN5h76djHa9Teu4PfnQcr6LWDa+TAMzPjVvVb5b
+BcG9f/OgvRTT+7uU9Mu9gpl3vkbMrb4LPw+/Mpz2vU4oqD2+Aoa+6lbTPYPzuT1r0i0+vppiu3z0
+3r5HcJO+Wxa4vdbgizxgRPu9T2I7PsDKkjpDSBC80cAAv0fH/76irnO+KCKOvnnMSD3tDRA+G5X6
+O8jXY72Wpvi+JUI6v8Fmgr6//xi+uiVvPTDcDj1L6JQ90cHRPYqFBb7nNIS+hWNlvnpETj0zVBI+
+1RewPha8h7z2C0E+YNVavkGE9b71gPS+WTVVvvdbvzxgM4Q+I3JSPSu6ir6gb7a+A7bQvgqZhb53
+VmO+lBikvWFIbz1FP5C+UeG3vUZMqL7BQwe+jzGEvgNU2L6lwZc8QaIbPhsIlL6sKrG+R7IqvUXe
++73KR3W9jZi+PPuaAr7yA5K9c6n/vTDurz1g07y9dcKZO+6onDoY/i88ZTCmvSfXBD1xRzq9j834
+vS/VQr7VSmE7AtC2vUI5iD13kgK9NHAfvt+gqD3Ilri9dsrWvRLDoT5Xe+88NmWSvPX1Cb7LKSC9
+O4iKPeGZUz0J7CO9h/gevdPO7r3JwWK9nOa+vSzjnLyJZJO9kPAivTP1RL3XR1s8od5vvQsDEL4f
+/i47m0G4ve17Nj0FK7c9cloPvtVSnL02NYg8YfPXvSzNAr6QjSu9lWeAPaAI5zxyHj6+uvxlvQLa
+Cb2i1Cm9852lO5raKLww280902bpvRTztbzE5tW8wXlcvaTpZr7j3WC+54YoPZmEBL7DuKU9jlIA
+Pc8yLL4XwQK+8OoMPuCE/bz2XFe99w0lOy0LxT3zEo29Ziv2vWRubDvRDCS5k+xBvkoD6r2tRCU9
+En1IvZJvNb3+zTM91Zi9PbtOYj0egxI83T3APfDkvj3xMw++KkyDPVtNbz0fkfu7Cy40vQ57TL6x
+tbu7wDe4u6zmF7w5BOS940VSOwi/Mz2PpaO9UidzvheWxTz0XVa70jmuvdH6jT7AiGw9PirlPR/Z
+Wr6mvSC91k+NvYc1+7yoLha95AxkPSejTL5ADeU8XJvyvGiDRT1UvPC9WX0/vWU+Br6GVqW8JaMc
+vnX2lryiqwW+UoRRvufipDwhqQ8+0M+/vR9tVL1dFgi++/LHvNKLqT3V0xm+hpK/vFeGD71oT5m9
+IDMYPMAxar0SgV89lpP9vFrMJ74KVAW96R4+PrrQBz08K0a9DCbsPT0Iy7yD+ho9LshxvPziUr1q
+UOa91csIPoFntz1/8sU9TTUfvWZ6Cj2J2ws+V53kvXoSDL0OBc09xK7BPTvU9z2Br5e7Z4GOPQ1y
+Rj2p7tq90QoKvr0Zy71la5Q9N+OYvR/6nD2jv347lg6IvRw1n72YiLg9kYOtvVt70js5Xi89HBEB
+vt9euT0zwY27zxJ3vtGOIb6PuYY6u91cO7dejj24u3E9IAdiPUyGn71saLW9OoOhPPLovr1EJLK7
++kwmva0xwjzXFkS+jSPEvQSDKT3YqCO+GbGAu7DB871Bwy6+kxg8PcGQ/LyF/pc9o/jovLchaj2w
+idu9zUgGvesazz0UNve8QV2cPVNqCL3kR+S9VVmuvUwshz3ogCe9yPrhvGCDUb7NTHu8vpgmvbMC
+BL7gPyU+gnk9vhYNgb0weME8OZBHvY+uFL5Q/NS9Gp4rvX87C77Umxg+ikU/vmGk5D3ZNM098nmq
+u5GlcL3Z7iO8Y4q7vcv29jxFWhE9vwuGvZTrTr6DiRC9LK32PWxYF70fog6+tNyJPYnYdbzXR9g9
+jE+Rvi1+mbzIH9O8NCp1vP+T3rw3gAO+JRiXPK7+5bw/Vi69QUePPVBgaj0vKmu9UgyWPA/CoD1w
+0Cc97aiZu6lPHr7WNRq9cLkavqVvCz36wng6Y+/ZPE27Pj5/X688EMwMvieBG70n55w9IRifvWlh
+jL2nowi+BCGIPUwdCD4r1FQ6rb3dOy9bF71z2Ek9IkmbvLUNjb3OjY49L8KNvIkzg72kd84845uO
+PeGH4L30zdm8W3d9vZAEF717kYq9tQcbvtOdm7125qI8Y7kNvoOWhDuZrnC9Ey4uvUsPIj47FlO+
+7TMBvRrREL4tioK+ym0wvhhLjDwWEn07F/sTPYAedD3cHq09hjy3vUPHrL17Sdk7Zdk0vYsmZL0R
+96u9syzvO9pnU70k1gO+1OqMPbNdaj1uhYK9vycyvcVDN7yxZf29a4Y+PGmwFL4cBhy9RCKrvK/v
+br1RM+S9JTKTPZErQr3YSXi7F9lWPkFZKD1HOpu9vuxuPVvEtTzFwx+9RNTZPORNlb2c4PU8Fuo3
+vfkkbz2pIwY+IEdbPc5fwr00gRU9DyIyvQfzEj3otdq9+oDrvdwfwrxRPYc93xNMPUvd2DoqLr67
+Fk3kOUiDk71XYtY9ILyBPn7dHb7nENi7KGXjvaiGTz3obhi9uhccPmKMFT3B9L866ZgKvQCJQD1F
+fOu92wnovbBXcb12Bpa8fuLdvEo5pDxLaWG9Tg/Tu+LxtDiPO549jygUvsLGCb5nJTC+Eh13vR88
+GD0vuKe87nLcvZIPGj6zR+g94znmvTRJWL0sh6c90wLUPbOaIr69cvE96biKPbj1ur0bEqa+wdGI
+PWwyCD38JMY9Q/K5PW0j4Tvt5I699mnsu3rcob2XCgK+x05nPWxPmTwJ7P88nYyNvVWfRL5RSzG+
+QapHPuOPQ73D4vI7/h+nPiVnzbw4hE+9g6sHPJl9tL2AOcO9uiNxPOoyfL0IlfO9wNvPvV1KDr7O
+OAM9+ao0vOXxDL5tq6A8SvvZvB+D0jy5AV09CrcWvYPTNj3l9iI7SplYvt3kbLxavTE9sPl/vZJY
+Lbx2Hou74OI0vW3l1rwOOnK9maeOvcrR/Dxkw0i+xKKfvYR0wb1PLpg9wRgiO10LKr4iJ0i9L98I
+voV0mb0BRpG8ABCePYEKQ70QJpM9H+ycPYwpNb5pffY9yuQJPVw9jT0ESaY9cbekPMX8zz2+vie9
+hCqKPcqf/r0pAPe9ahDkvahrBb7dRga++YVIvA4kcz36HIS9Qumeu1isZb3NToI98KMrPgyMK76I
+3gi+WX2bvdslzb0FU2s9DSxwvVQ6YD2HLvQ70vUEvuL+AT3tQzi+p7AYPaYHlbzYPT4+zQ2KPsk9
+jz6mGLU+RrykPl4pVz3O/iA+qs6KPt+nQL541BO+un/WvcAvKTydomE+ZQefvbU3KD6jezs+58EL
+PRlTs71Ocsi9TitdOh2DjD1XhFK8PQTJPRuqlT1cjdw93NYaPc6yC77fcCW+ssCSvdOAKb6bh3G8
+9zk7Pju7hj2jJJw9JbIxPuh/Q77kOZe+EZWIvXpHJz6GyXY92cAkPuJccT5/pII+/LGkPWlBRL5A
+s169DUNBPujxhz2x+R4+G8UGP86rJz6rQdk9ekoQvpewqrubyyw+TgNbPg/jrj6logg/7HEPP0SI
+pz6SxY695LCSPhF6yD7jYfM+16JoPr1dKL6NXqO+cesFvh2boT6+Jts955Z8PmPgWT5TzGQ9ofEA
+vrY6z7655NC+/8xnPoxIaD1OtHY93RfiPYnrfD33aAq+ZSScviQswL7jbJI+S5PUPSLbUD6Z+o4+
+aQADPuIScj2pxbq+A6itvsDKNT66sao94v8kPoL7Yj4XsxA+B1W3vgWD174d37S+I7T/vU4LBD5Z
+lAY+vpSHPlAVpL0/jRK+kny5vtdqtb4/bSO+17wJvlMdIT7cN/k+CuOUPo+heD4a4xU73bUxvouJ
+JL71SzG8Jzt7PbsoeD4FDCQ+eWm+Ph1boz6jBO89CLChvW27RL5hi7+9emBaPXbrQb36fo0+Y1aa
+PDtCuT21pic+AQjYPbaAhz6VFVq+TP0cvz/vAj2n2hs+zKERvt13Sb3QWRs93tcKPuKNWr7D0ZW/
+CjKevkovHT7Hyd49mL/nPRUeHT4yfYo+ED9iPvBOoL+BT8m+55RjPn5agj5Ib6E9ADyRvRcqOj7P
+pjk/I8Bvvl4Tl77hJR++JVEmPhENnj3ue3w9elZEPvMUDj8xvO69XPCOPR3rrj17NMe8U/kCPTU3
+TD0tV8888KwHvastez6rhZo+wUZ2PVau6z3dCy8+nKCLvdNEYj4ymIi8JdZkPsBBjj6AVZs9H74J
+vm4YWj0gHlg9BOnYPQOgbj4FjKg9jwLhPU2u/D5bO3o+dv4bvVg3OT2s/fo89Y66PiN/sL7Q53o8
+7CKrPvDIgD2JhjC9aAiLvdb1cr3Q6EU+cPIHv/dEj76LBZQ9FFh7vBGAXb07/QG8VZksvKwghb3T
+wEc9dkrOvNzqyz1V0aA+uLq3vTHw4Tszk7w9NkoXPi1h1T5FhmU+I5SjPaFdQD0TFTe+hmcQvv5X
+mr0egy48xgRDPvEhvTzqwLC9wft6vsL9y747MAO+sCTzvbWs+z3YGkU8Eji0va4ezDzuuJ++e+Jg
+vVU7k74QJda9qJk3vrX5sz0f2My7KMoFviSr+r0kx7q+QU3evEMHgj5N8Mg9zQ6ZvQMm8T2migu9
+oFe3vp1xYL7dJAm9y6ZsvXqMnz72rSE+9JYBPvyFfz0BGo2+aNRWvmZewz1+3KA+S3ECPqnxwTxU
+O1w+5HCePlJ0j70vmuY9JIMmPhUNOD4p+RU+KhisPVru8j7CpW8+Ais8vaZapLu3VlK95zsLPpky
+8r2m0oy8tzdIPrAwvD2ctGi9lz2OPVW2Wr1wQEU92Xw8vo5VczzCPWS9+UXMvD8VwD3piVI9spGf
+PRXg7b1t2ea+YjdRvHW0AT5G65E+rEj0vH+jIz5HuBo+30sevf/yNLwFslg+bAvkvH2Sxz4elE4+
+oJO6PbBRbT6QABs+rozXPg757jyihdY8koGkvdOClr7d48i9riCrvcxb0TwbT8s94Qb2u39pIz6Z
+SlU+wXfivaBRnb0bncs9AZIdvlbEcr3LRky9LM1TPnstYD5+Asy9UYA4vIapM72KDpg93NmVvRJB
+Nr62DpO6htELvtH3CL5Z9ly+EO4UPc3zhr1x4PC9gtQKPWCcTD3KEwW+z8qNvWlU1b0dXtK9dPzd
+PQ/9qb7ZT6k+3IsXPuuHAz7gwwG9lyWWvb7w2b56rJm+00CbvuKTjz1IX68+dO0TPrQwBD2kjBW+
+WfamvlYMAr84NMS+KuWEPkAE3z5bURw+MeWTPMWTM76Ygzy+jpYmvZIYgDybOPI9x3zSPQwTkr2G
+Cxq8pgGavqWmC73yo8I96XQ2Pr67ob2VJKw9+C2ZPcVzJz53CIo9q0rxO0vlX73oMrq9IlhJvsgi
+eD2PmvY+j6fWPmATPz4c9pQ+j7FAPtlgnb2R3fs9Ccb8PrAcIj+pmg4/Dw+wPiAv6D72EcI+I2rS
+PsA2wj6USdA+96JzPsiAXD7ZLv0+zRubPuBiWj7hhrQ+YBy/Psf93j3sEE6+dUxDvuKXU77Sk4u9
+Ee8VPiMr/j7D4tE9qRbgvWn2pL542dm+gAfcvsecZr55gI887CgXvZCFMz6y35W99IagvlSgyr6Z
+cNe+TpXgvW6igz0PsXe+G5ulvZw+szxwMzm+6tIlvlMAiL57WTi+2bdqPb/3SbwGLba8k2k6vspf
+D7xi5Ui+SoIwvlfjpbzKCkC6UkknvtVy7LzJVpU9gnq5uwsW8T1NjAC+4nxyvgFhKL7Xop6+miZz
+vnZpSz0JSwA94H0wPXB7hzsej0u+95vEvtk2LL8DxAS9WK9EPWSQsjx1wS++Ji2lvWK0br47ZcS9
+No3lvkewVL6+NKK9d5WSvb1fBD1ZeO09Z+QWvY/+TT5fQhS9n7W2vfEaK74zREe8cXmaPZNJaT5y
+H68+a7DNPlBijT0r6fy7Tmv3vfzxhzxrmdQ9dlVjPjgfMD4xTmk+OpOPPRD1PT0EEio9vj3BvRfz
+hL2vrJu9NInGPX6Q0DwWOLC9nYPIvfv0jz3/soa9xVyvPSIEi70yfhC+37cHvqz11rvRpKW9jqCK
+vZ2rrz1D1lW9jhXdPDwXM71fRm29G9sbPoF5373VHO49RnLSvOA2mD0qMTM9iNz9PK5axb2tZY46
+6Iw8vXO5mj5lhd09Qxtgvnwpwr2GYl48Rcm1PFK3mr3C4Be9Y6IGPkYLk72e8va957WLvCeZjbwq
+q+a9EqIlvVDr+D3jn2S8KmctO6uGob30M02+VOC5vdaG8Ds5xQc+m1MKvbgl6j2rKRy9tI36vbAs
+yL2Nby++nFauu1CksLwydJK9EC6xva10EDvOC1C9+qyePfkKhT2o0xs9GGvcvG3rwLv1mbE4awNi
+vTLcErtutny9Nl7sPT7n2LybXTC9SKqZPPtJG76p4LQ98I1PvoFNj70U7B89HRX7veBDpLwNmkC8
+UlmnPD1qM70X9Qe9RUa2PcGYs71IRn2886DkOxBlir1XdwW8IyNBvhNK6b0L8SO+PVadvTv3eD3h
+S7C9UjqbPWwr7D1VHvA93pYuO2kw4D0I7+I8irfTPMtYhL3+u5+9ff1Ou2/Oaj21Au29iogDvm5I
+pzyy+GW9EYV2OxnX0DynE529QC8VPqT6Ub0BmjO+b32qu5AuYL3JzxM+uVqQvZk2DbwQOAa+5wTm
+vAUlLL2z9CA+UmoTPecLF77WM/i9x/GKPWjHY70mMQs7QojCvAXwhD2FcTY8xMHAPZiYPT0Ev7C9
+aw9ZvmmRXr0sAgS+QSkuvqvLMz0IC1A9e/GPvBbRvrwId0M9aYazvUyZxbwxgSm+baqNvQjHDT1j
+RHu84fmfu0QoUz2RDr68h73qvTtrSL2cJsa9Gp2sO8AE8z1FBYE9Br6ePI3BAr1nvQq+Q8GtPZg3
+jb07sfG94os4PINEdLypj3E8mt7NvGPI7Dz7pbC7joQwvh3RR7ynCIE9IKXQvX3S5L2gdJm94sOt
+PcgxljwPrDm+gxjtPXtqsTzDnsm7Y0qjPfCvLr2nFTC9SG3XPSML+rz2mIy9Yhw6PcBrzz2w8s+9
+AVSFvb9APDy0PQW+WjAuPaphBb4FIuu8TJaePamZo73BFgc9rs0uvBok/L2Nt8i87WhHPfG4GT3g
+2aq9VWLdvf9gorw41fS90sPqPWDBdj3tbAi+lKO5vGddCD3Yyoa8YScKvsO/M74StgS9eaYuvWsb
+ij3u1aa9F0AKPb0/VL2xqcq98UtFvmk4sLs3jpq9kEyRPVNA3T2ECr+9f7U+vY/UgT1jG2C8/tsj
+vWzVoj340Bw98iFhPUCxYL6nJJa9QYNoPdzZDL0mAdC9RlrUvE/XNb7a5q2+Bnv8vU8sCr/AFge+
+jLnvva0WKb1fJ7W7ZILnviGTmL1OhPG9QA+gvvfweL61b1g9XQwIPaD3JL35x62+dKfAPXeF7T1N
+zsm90FkbvpjVAL4DDhm+a0ZgPPMlU75wR6m9GJtZPvUynz7SYEe98tATPXh5Gr1QnrG9Veesvf9D
+QLxbO0M+lnaLPo4CZD2m04e76gBwvVIEkr3E1qy9c/nSPN8qnj1cq2Y+1yX4POAbV71gu6M82YCO
+Pvf8Sb6PTDm+cGmPPdtJCz4sC4894W41vX778D1QdbM++UvvPXETvL7CFYM9oEWJvX/2kz0AM7y9
+V0+TPf+yuz4o73A+ycJkvo6wg71QR8W+640vvgSSfz2lhAE+1BaNPXrOaL2AO1S9Fql3vHE93r7v
+5nG+DctvO+6D8jwQ77u9/IiivSEu/73hdrC8obkKvuOJNL3NjUA8RqqJPQ/8uL0xr5K+YxRavQvv
+Bz5OXFe8ZiodPkG9Dj66urK8bqNbvK06Tb2y6ZG9xg0lPiltMD4Dsd87138CPZgIwb39eqm9SLOC
+PZ5FWr1sg0c++8eJPqstIT56lAY+YGPmPGekXT09YZC9qU9KvgP4SL2zOZA8XbWWPaz7zD34DQ89
+ghyXPcz4Cb3+/8A8d29MveWm2b3lKo8+WRqQPUIpgb0v6wM97HWJPVTZ/b253sg8B+0vvgFKp76o
+jPu+ZW24vv8yRT0MsG4+mMR6PlRkLT5TTxI9rU7Fve0wRDvTyZ+9ZQCcPv+9Dj5fdKg+bTgRvQgc
+Ej6cjqw8Jc0gPt/EAr7VZDw+iDiZPQt5uz4QBzQ9oCWTPidzcz4nJqc+hIoOPkNgIb4C5jC9Q/Vx
+vsfX2L4cLb89c8XjPudS3D4IA949HPJBvUTUob7LimO9lfxSvRjeED4nekA9blsdPvI9P76/yUC+
+9TlAvtLNqj3z1SC+SyFFvfUmcr5AOga+HPDwvad5i77qsB6+rMaePL5EJb6/Qoi+z9jevbZIXb0p
+E0q6G5cBvm1QUz7jJeA89fgmvguJkr4bFQG/e2UBv5jm0r4sN2O9pWFrvnEDf77tvaK+C/qWvuzJ
+7b7xugO/WKmfvvPw176uRra+/iGxvlADdb4JZxW+toS+vc5hf75rrJW+0e8uvjDQQz1Avku+G44S
+vgaZEL6pd4U96G6bPBfVEL6mcw2+X5IhvkU/i71r4oc8obY+PWPjaz5Ju+E9uyNFPr3UNb2WyI6+
+M1AkPhstUT5eIHQ+z7ScPn1xID6LIqE+lnevPuhb8D1hvRC9fqeJPsIByz6j8lQ+uzEWPqHZuj7k
+htI+mGhsPkfphr7kTxQ+y0i7Pj2dnjy9Fua9WcxTPj/LHD9OltA+lXV3PnYVkr63YZ29E8YhPjHD
+wj3gUR4+gCeZPFnqyD5C0tw+hHw5PRaF9z0fZP88zKxSPmg4Oj7Y0Xi9ZWYmPhiRoT4Nkh8+ATyk
+vRXDhz2uRXU9rYwPPp+IVj45FL+4R//9PRa1fT3UufM8rwNQPgbLbz0Wls49Ug5hvTDlw7z9iZU9
+I5vpvY06fjxzhO096VQDPsLdxTzuKRW+KVPpPQhrBL7uLb89HvXHvD29bTwZtdO8FbfTPH16B73K
+NKA+C3eiPeyabL3Py4u9zyxQPgJNFb2ftZi+t0jqvdQMfz5VwL09Hlx+vZNd/z3Zud491ssgvrBW
++L7IKgK/KcctvveGsT4V/B8+2haSPkgBQTw3MLc9pfENviU0az5JzkQ+uAimPmGLbj4EbAc+BOvY
+PfIabr4EqgG+mkIZPoGUlz5thY89iqXtPSDFMD5QvUC+pB2CvgQjob4QMRY+9IlsPt8J870Sovw9
+vZw5vp24Ar5AmbK+ft7Wvo7lrL7fVzC+c+s1vhybsTvQCCi+ihDavYJi377QqoK+umSkvreLgb7g
+BAU+ViMjPiwbSr7lh46+USxkvtqulbpmJIk85EU3vtyksz5DR0s9rpM4vrrAM74+tqq9xn2FPuxa
+zz2UFjo+XzHzPoUogLpKjg46fuFCvkjL5rzQoKE9ZgjQPVqfMj7ackA+iEANvWLT37xAFAs9dHxk
+vhwyNb57pSc8XVQMPyf8sj1Q0fs9YSbRPYDbmb1oFnq+4wWNvty08TzzjrY+Pc4LPbMmJz5Bh3o+
+dbvlPZQBF76KcRu+aqEkvWS0Q76GW8k9NKDwuiY7jT6Kytg+TTi8Pq5/wbzqzw2+ORqPvkGmsb4O
+HV69h4wiPvjomT6IC4w+6d2ePsXJzr2RBom+/PFGvusjrb707oC+ONfsvXDWGz6mEBc+N8sqPmjl
+bj7OTXS9kFnUvhdYcb6kc8W9znwbPhFZiT0If309dkpuPuBKIz7PO5S+s4kKPf47uD0qExU+CiTA
+PR9lbb2G05i9aww6Ph41ab68Fda+jt5nvnkTnr0b4Sm+ypMyvpWZW736B2Y+pKVUvna4nL42N3K+
+2ZmTvuxeBr5UOwS/42kauh9ynz7Y10g+c0zWvQnDpb1R0vS9Jp3vvSa/sjwUPgm+rgSZPo90rz7l
+Lw4+/tD4vHqlRj16jhM+g82svZwgtD2Ji8U9fSGqu2DItzvCqXy9YBOdvfn8wz6IKEs+U/SGPte+
+ir6xGcq8ojfJPRpFmj1nLOm9Ny4nvnwEGT494bc+ITAMvhleeL4eO4Q9SpM2PgTGI77Yv46+2DdP
+vpBDgz5xa4u+I579vTB4ED4JwDk9ipohvo2SwL7espa+C7s2PggLVb8tIBW/HkmwvNT9Bj8kxkK+
+9OTTvjyr9L5vXEe+/uniPnHgrLwCyUc+fARXPugsfL6DHQC/f1IGv6wW+L5OB/E+8DvWPXvOYD0a
+bi89k5tVvidLMb6Ps9c7ThlTPLe7xD1cLfY8B4bIvfTTpD1yp968BoKqvdckmT04RNQ9HQOivpuM
+br4dvPu+oA/8PEt3NT4ouEw+dxNTPSFkDD4axcK+FX7tvkt0bL4I4Im+AWesPIbWHb6zusU8KN/o
+up/UdL21Q3m+bEumvRyxAj6psTY9JNfdPRjzpLyy4wW9ypaFvj6ecT6IFAk/vc3ZPnsRej7CLRK+
+SZ+Wvt+Z9r46wHS+wLPEPR3IOz4KqAk+nk8bvsIT0z3SRAY+7nQrvuuPbr0LpyU9nm/aPsn6Qj7m
+lj6+qX4XPWlXPr4AtxC/fNOJvaOaGj7miqc+S6Y3PlOZa74TFeq+P8sRvvOzmL4O7Di+4KToPby2
+wT6Dw2k+dfU/viYAL75C3La9etvwPGqXNL43OuY9mWuYPdIl6j2jfNY9up7oPTEGPr3YGqa9d6Ki
+Phappj7DA4M+i+cNPm3UEz4u+jQ8guK4PE8RV77N+Xk+1KTWPkKRij483EM+rgRIvbcf/71ktSK+
+f8mBPalNPz1HC80+VoKIPvAUuj1niBW9kbWiPIfHjLmkFOC9zUoxPzLblb4t91a+RrWzvRe6oD09
+Dyu9RVOXPAvMRj4osMI/5h6oPpzHrD30Noq8spmPPZE/FjweMOo8Bd2oPl/IEED3bT4/7pAmPgFP
+m7yoPyk+usL6ParGAD57z609gYcTQBuZiz8k55o9IUPCvd4O17wFtIo+CwKfvQClGr+lLVk/4Q0f
+P9teFj5m/Y++dJikvu1wBb7tL7u9V9zHvhn39D69/ls+1UvKPaodobtIUDS+Urklvvecf75GRue9
+HJK0Pbq8cD2EnNc9o2rgPQA/lT3MSU68zuKpvU0TEr1QStu9/vCEPfQEeT6Hddw9WWmEPrgrMDwE
+ZCC9RnqrvvB9Hz5haIO92Zw+PZGHsz3khnW9k9iCvcwy472lGc+9FyBfP77yIT/KsQs+cvyuPUFO
+MD3T1N69O55KvqgYJ75VKlA/fMEYP6C4A73U3sU8sZ4ZPoCDFj0e0kI+dNBYPhh2ODszC2u+PBOy
+vpsUjb4iMD4+yMXLPYvU3Tw4IU49gDyfvi/Wib42rVC+mgycvSqDzrtZRSw++1HdPVaXRr5dvY2+
+bI/6vRa4gTzFB8m95zaXPcKjaz1udTE+UYlgvhNgmb3y8Mq9Nk6kvdRl6T0vbc89sGtbvq2yqr5Z
+qwW+cnIQvTRpArwPMGk8PFvXPVNjXr5QVN29ni8lv246C79+R2O+1skRv4lQyj2Y2ec9xEY9PVkG
+Db5C7YO+0euePjgz4b2QPfK+pr8FPWQsoT0LUl49w8pTvjBCmL4++/k983TPvevDDr+8ORE9j5KP
+Pj9Svbz7pLa8QbVEvgtYiL2F242+MRFEvyc9kr7JLj0+mZ8WPU6axryLnzi+rZVBPCxHE74U5N6+
+cyc8PQoJOT2mpaI9coquvUa1gb4rCua7vGCJvE7Qv74iJYU9hLhxPMOLMz7GlAk9Py2svh0xfr4J
+xIY+IqmWvn5TvD0qCns+FGZuPl6Mt71Hh6W+tmxzPAKD4j2d/Ru+bdktvb3oXz6kRso9TcclvqGJ
+yr4Bf5w9h3hsPvx5K72PI0Q+ImeJPg3nYLwOGrm83DmMPUVNlz4irnI+65cBvhIn/D5Ewmk+St6Y
+PUsfjD3H66G9I/RHPpjUET44sBu+4WwIPk3Ebz5mupE8TvwPOzotOr3qS+Y9xkePvrcy2r4LUE++
+vhswvUNMMb4hjHO9gtklPvzpMz2OvnY93B6RvgRlB76QlSg6qkFovkEOZr6GzvK9G2w3PBxzSj57
+NDU+IpJNPauMJD4lgei8l6Zzvt7w971qIMq9+Bl9PqXVvbzPWoa7RFFoPrjfDjwbe42+1H8PvvL/
+m7zGVNI+wae+PWdpFD5DYk49GnMFvhaB7bzUsfK9qDkbvsDcuz3JRLs9gjAXPB1K4TzED4U+rLsE
+PS68EL5maqU9dGc5PTT1sz6gNRU+wFnLPixBp7yM3RC+9YKnved+Dr7IBIW8OdYnPsK+kT5oCRo+
+gMhHviRovr4Ot4O+8A8xPUMg3T30Kls+UDj6PqW9iz4/PHG+YreevYlzNT1SUI0+E7D7PkUTjj5W
+oro+k27CPu4vtT6F9P4+5mELPhA/mj5d3do+hFjGPp1JND5RfaM9B7OnPsCSAz83LAY/pJ3XPYMg
+Kz6WeB8+/vcFPi32ET2G+Uk+YrgrPuZKPz4pGog89Qw1Pu+Tlj2JqYQ94FC9vQ5otz3FnAO+BTQ5
+PiQZi70j8e47TXeivg7YRb6iNp49zIs9PlGj2b3PJ5G9ikWFPYqTDr12S12+PkbAvm5gvbxRTME8
+2lEuPHGJ/L1w2Rm9M3KJvrJqzr4s0Q++D8xAPXWHdj5ii5I9cFIqvr9oT702Jpi+EhBlvuvJHr6r
+8l++K0LNvUeL+r1whdq9373QvcXyf76Gl9S+2aHqvnPVB756D429ViBivvKz7LxRsZm8Gal5PUVV
+m75W4MS+LU7Xvk+lPr7aVrS9VyXYvmWG071vBCs9txm3vjb/U77+e0q+RUhpPSyl5LzIeM++rZkd
+PWhO3D2JIV++SIgAvp4nKj2WxZc+oAsEusSqib7zV2w9HnUmPbHAk7758V6+0rvMvHFfBT4ClxW+
+rQNtvk5iAj91RHG+FQmsvv2SDL7fjJy9XvvxPOorAb4ob7K8EN28PlxS9b7yG2K96sWNPYyE37xO
+XBi+jE6cvXPeFz6YLdK8bb+hvu2Hoj0ft8c+d0KyPErxOjzJUbw+6YJXPhBee72xhfW9nmsiPrNA
+vT6fmcE9BQAvvr1HjT5ECcY9TtiBvisERT2vfsg+AV2OPqBuiz1Lz7M9ovqIPmHFyz0/TY2+bXic
+PuvYUD5AVmg+06hHPm440z3qI5s951+kPeM0eT04RCM/EBnfvR8cH77twS4+6/VnPf5HGD0QYkM+
+ulEkP7B6OT4DOwG/fWmUvg/FeTx1Ike+ehiMvoywYr44Ewo+swblvb7wnb6C7JC+1xOdOxB3Eb69
+eIq+l1iLvq2S8j3N3/q+4GaJvvChor7JXju+dcgCvvUQHr4XVGg9CaOBvZiaNb5qLza9V/mevPY5
+WL7oT1i+veBIO8Delj0HzmU+aDqaPiPFrz7If4S9zmCYvob5X75hpQu+TDiMPeisDrkWgJ0+gC/g
+PRs+d74pGae+KkO6vWYKoT0vZbO75SUgvvYtXj6COyK9FJ7cvSwqqz01c+I9sFE+PpUivD2aUJG+
+Yg+nPi2JY70RJwm+9ia1vcDBKj6x/mo+UaJQvIUenb39Y8m83m55Pnh6IL4ZAD2+mpmLvodtrTwu
+woI+Tlj2PT18A77nk1C9doe8PewDQr75yU299YYFPdvRND6uWCi+O9fbvhsk0ryZLLE+0VlZPvxt
+iT2OpLw9N3erPUXpAr68hl6/jWo0PXEiCj9Bhg8/DNmHPldCub1TnGw92eVEvsm5I78ziG6+++d4
+PoIRLz6XS3o+mWfFvW/eiD6E4aK9nIVfvbvJcr49Kte8VBLMPYzZAT7ypa8+NMTxPviglj4ucTI+
+kQGNvb2Cwb2XOgq9IxIUvUNwvD3Paa4+LiaePVDzBD8FXXM+1G0dPMdioL24gqk93iTpPjREXT6s
+np09gOwUPrBGwb1aXby+WBdNvvcSPr6zSUa+fIlWvsKA0j7xeDy+RN+pveTZyr5eLjy+f7TMPGH1
+Xb2blkK+pfCKPQZ7475XEKW8kqSyvTNPE77YpII9H2uBPZsTTL4pYXK+64L8vDTn1j5meqw+uhj5
+PTvCqr23ND0966/Ivabmjb4jcS8+l6/OPvbvUD2dQxq+y/+OvhxrBr4ccQG+sobXvu6qJL4skrO9
+gMB1vdsJhL4OH7q+sDmtvZ3zcj4Pyh67qBp1vp+I0b7cNFy+4EmAvXuKXD0VuTm+B8O0PgzbjT57
+LNy9OVfBvludnL1oq9+83Nc0vhNX671QevA+iiaQPpsvx770wri+0jTNPYYHoT6KK+A9IZgjPTaG
+cj0vIQc+96wevqtiUr758j49X2IYPvQbdz47te89P/dxPhvQcj4GRxA9vrzKPcZEaboWrA4+m1tO
+PbE3CD/58GU+hfVTPvpK2L2LzYU9otKqPUbmKL4JgQK9SOx5Pn0lnz4DJiU+ZsZ2vRw+rz0LqeI9
+++w5vrYALb6ZmRq90DNnvjviOb5xBxq83iQ5PmkZzz71S5c9j8JgPYtV2juPFx674Yf7vYWeHz7b
+YCM+T0qLPsH/rb6QFp6+6BMGvowlOD0nC/g9iF9xPUgySj4jlVQ9HLTGvr2y0b4qWry+z5sMPnfm
+2j3Fha0+UuyqvG6iyr381mc+4ZPQverulb6Up+u+kHoXvzbMwb0Sztm8VQE4vkXPlj23ESi+0mIf
+vitd477BAry+dSqPvsQAOr7aiEO+O70ZPdXssb1aPYK9Ct63PZ5CEj4nuHu+MxuWvTCjw72u0aS+
+bcTwvZFoQ73PGdo96JpfPgorxT7fELA+HEXkPVG5jDyWCQW+UqCEvSCWfD0sSDY9U7k1P0g5oz4w
+i1i9Fyj0ve3Xv72SFz2+TQRDvhBDyb5SAe496iiTvRBhQL6LAEO+zmvhumvSBL0I5aa+UTKZvklH
+3724jtu9y/6mvoPXYL5Zihu9eCJEPRf0Br3n4ui6SUSZvmf2Ij59I9u9a9VAvn6AYb0kuKI9tKCn
+PaOwyL1cqDu+onkOPpzJ3b3ZjZ6+VsSFvWUApb04ECs9GpLOvTjpuL2STT8+K60WPPsdoj03AYI9
+SJMkPZjJlryH8R++hW7jPr43XT58oeY+XokIP0nMET8sLI4+q75svIm3wr4BPc89c57qvOTSpz2+
+AZM+c9bMPvLz8T4Yxlw+oyyOPB68Nj4xDKK+ToRlvtYdbb6P1+G9Q7oWPtzRmz5U42E+NquaO/Yj
+p77RO5i9cskAvpCqKbzPzg++aRRwvXSWfD5wX1g+7CLlPZ8Lmz3pxm8+ewLYvQ/rGr3futy7krxD
+PiPyTb9isaK+oFERPj0p3z7UDas9tuJ/PuyAtry4d5I92MHQvj4F9r7I+KQ90mijPsxVgD0eOqw9
+AtfaPH30LT7C+1m+BhOqvq+02r39o1s+bWVdvbB7dr3jTNW8Uvvuu0p6QjzhHh++lUOxvZ8KVbuG
+tNA+8nnKvfmriL3n+JC+3bw1vqtlX74bjYe+hcRevT/C1z5w4qM+UeOLPRJzbb78LIC+V8CVvve+
+A75Q18u98B9evdSKY70pm5M9lKjmPOmeQL718AC8Qbc6PXwZxLwbYnK+dizlviWrT75YLx++yh6T
+u35VBz2C3zQ8U39Wvg20Ab9SnJm+QoydvnmIsL4npJg+OFIKPrRrWL1MdAU+zgaPPRZMU73NxYA+
+Q2i/PhtMoT4mf5Y+WUwaPhwvKz6UdFS9osfzvGQ7tr3naxU+DeuNPtmfgz0PMoA9zovyPf17g77a
+6ca+fAGEvTRubT1hel49XsqdPmZbDT7svAC901+qPD8rNb6HDSm+0W24PWi5Ur3ebxk9q79lvuEc
+Ib7/9y8+5BgNPtofij1gF+09uhY0vmTbyb6NvQm/n36JvWI2Wz1+S8g+lMqvPpAKez66Epi+ovS+
+vjng2b4nUQy+PJ6ZPYBuNj6CQnE+VjqgPtrt3L0nOOC6hXarvhXYl7xOMiY+BkuWPhGq9T1YmUw+
+Vbcdvdk077pW8y29p7iRvU3YoL2xSp0+dh2IPrW5zT7U7r69Y3NUveZCMb5HQZc5oTt+vIeYAz7N
+IhI+wq8ePYlGpT1bK/49F8aTvBukjj3KX6M8BvSLu64ENz1/wsg9nG8vPhxpcT7nlsM+YMWZPjBl
+BT7jQs6+8fa6vkxS6L1LQbo9BjRtPLJeiD5UjIw+WOTTPe9HuL16J4C+OwgJvXx5Cb1IKEe+CqNT
+vp3t3r27Zom9E7QXvnusrjz5ngW+TkqKvdd0S70zc4S+mqC/vi/BOLvAdus9NdQnPYtle727GZq9
+WV3CvbQOq70M5DG+q/YNvg0E3b11Prg96TvSPWuiBD6K6XU95xgevakpjT0btAU+j7yfPcnMJ7v8
+YLM+U7gbvdg5kb32b9k9OWG6Pf9aEz7lIAM9yHVEPhm+wT05oda8O7GlPH+PobzjSgm9+RDXPc4i
+2jxF/DU9Xv/WPCh6Nb3lAzo+iC88Pj6YWjudmlk+eYqiPnsuDj0PWsU928ciPhH4zj7nga0+MbBz
+O1/agLwgHwW+gWxWvJAkWT7AVMi9FqGZPe8GTz54Pz+9P0SRvo3bA79mu4q+eTGWPGeCG75VaZa+
+9u6VvTFF8jwkPy++CP64vZ8Nor4Lj5i+ufdCvqL/Yr7DITm+lZ13PXB2mT1VNm++Qbddvtrlkr6x
+sYs+ZUwOPhi5vjyC+Sm9gxiHvsT8rL4+SkG+gLifPZXBuz2gA8k9asjbvOa1ibzDPqq+eL4qvjWb
+db6CtQm9SvmvvUK53r1RBjW+/31OvrofuL16oz++yMR5vu6xU75ETbG98Hm5vrcBdb5/9rS8zmCZ
+PYDZcD5Z6Xs9dy9BPUJ0y722VDe8cn+fvZcxNj6YgFU+ebY0PrGlmT6HbH0+9LvQvtS81b1O1ja+
+oKM9vhW/Wb73Q+m9mRcmO9mvZz7tbHi+KbtGvlOlfr4ZaVW9WTrSva+dUb0XSy6+ydcevuApW76F
+4kO+xDVnvq5qDr5fuzW+xFhNOjUlg733RKe+ZaKLvtyukb77HKI9qnAXPn+lbj6t6/g9VJ/bPcXj
+iL38K8W+u/mKvkJxLDzSSxo+mjNUPX7Y17yslVa+voOPvrdEtr723YC9UZ2gPUkp7TsqrHY9siSy
+O7HfWr6QK3S+52rJvvEcq71G3ti8QhB3O3on+DsVIxU+V3fKPCq5Lr5TXJ6+U8/BPd5oKj0XOkO+
+Sz96vdQuxr3NOBG+55DrveCX5r09GNS8CZAsPscPAD4U9mq+/X5VvhJ6N75ehYy+YjkDPapaBL4U
+IOg9CqofPTHb+708tIK+/YMuvuBc6L2UW/08dYvfPbquJj7eG+i8H0QHvtnuc77oFhS9RQhlvRl4
+l71mrjO+9q7LO0L+Cj6zPHI+s/D0vYiOpr1ffi++j5SmvuhkBr/Ci0Y90FORPR0q2jw9VPk7ud0g
+vnjEWr7vHbO+s1tgvtPK372PQ5K+CxHZvUipIb6XfGu+G6SLPffdnr4QTe283WMxvthgcL4soJG+
+QYfUvZe1LL3z0eq92TgyvFwDYjyeplc9kd3LPPRDp71c7BM9Jr/ZPUdHNL6YQCS+4d/rvJC8SD4Z
+ehk+ZOm7PRaqkr0xcBO+6qVmviMrEL1eUY+9CLl0PiKY1DzxZsg9njUoPWdDu70p3Yq+h3swPQtY
+iz6AV6g9FAO5vHV8oL077QK+CpRMvlcKab5/ZJw9DZ6DO/6ghz6+WBe+6cQIPbhgdT5tKBW9sBWX
+vg9r173JC7E9xdOhOw8Csj3PVR0+srq4PlgDMb1ochS+SlTFvCKCobzcLzO+X7XvPbeT6TwdkQg+
+oWYHPrTUQj53H/69Z+4+vSk7lj6/QpU+w1WsPcmxrT4ghRE/cvLFPllqeT4hGao+QgPxPm23SD6p
+juE9qq3pPiQQOj9kgfY+pcpFPlwVcT7s/Jc9B+yVvM7Atz0+k14+UpZ4PsORIT5Aubw+jOFAvuuI
+Vz0YUvy7zpQNPp0bbz7THC8+JmSOvEZalD6nQIi9RhIZvY8K3D2GFzi9MZMNPvykkz5jND8+jfmE
+vv+lwjwcJQs+u3DAPh2iGT7kWBc8aazHPKCmMj7X7iK+5ReevbMZhT6nh1g+pEw7PpGQGj7E2ye8
+u8wJvvYP3r4jLyM9ZnAIPvYeYT5MGao+Mq5JPoJDpjxIysO+5T4vvpxvC73go6g9cBGqvERpiT7p
+624+wtzBPMrmEb1zjBS+QWJ2vdLZfD3sqTS+JTmlvdNvbz1kI/m8pioCvhz3774dH6q+d4o9voUp
+1r2kz4G+QSpcviSflb2m14u7EhgMv4a6mr6owwm+Pz0sPTS/xL0FO7G+LmWgvdIVuT2ZQ9u++omu
+vn48Kb7nNQQ92wWUPdHSOL5pIz2+kut8PdpvEj9STa0+Ti6LvnMMRb5iSU09UwMFPrtFwz4tnog+
+FDyCPq8TED6cEUe+a5ekvuFJxT0KJDe9M6J2PUyncT6Gtuu9l9vvvY+ir76fZZK+1jr2vf5LXD0h
+fYU9nbJ+vdBwBr5opIG+I/+NvlgONb6IwWG9JXWPPcnA0T09gQi8Mi1jvfayg75RwYG+wEUjvsG6
+1r3DoAw+rcCwPeR9c74OCIq+WM8fvjjS3r2E2Mu9B6kPvi16Yj2WxSi9B0CjPQLAy75lez29uJGE
+vXFCvzwPhr87xncmPXRtCL6Y6JS9BAaCviagjb64Zwa+aQlrPG3VFT7m3s09sf9dvRu4Db7bnC0/
+hJGQPnMMR76IRD6+1/B/vlp5sz3axSk9xRXIPVjhzT66f/M94+BdvrSDE75iFF8+x97bPUr5RD75
+CRM/TdkTPnR3WL0UadW9xqlrvv4ckT7Zk1g+LlWCPhk41T5arUk90tqDvt2BpL6AZrs89PqZPuup
+Wz5phVQ9V/5yPp/ilL2pwbm+9CeXvuxDpzr7Q7M9AJbpPYT/2T0hgvY9M10ZvzWV4b4IhJK+MUYP
+vlc+ZT37wNI9TK0FPjNvBD54iom+7udtvmkEI75v5Ro+UTYRPvTSR766QZe9HxUBPq4ekr5zc6++
+Lyz1vUqE9j0SA5A+E2uyOxNXDL6yXPO9yWWZPO70BT+P0+Y+LlvvPU+iVj5mSa8+VgjZPqv09D1T
+uV+/xawvPjMMjz4sK4Q+p5pDPuTrKj5W8mE+zwgZvZTlsr/H63W9v+zZPgpHPj63vIQ9vhM3PqxO
+MD4zs+8+JZLJv0ffqr6Qq3G92byIPfgTgD5qkTg+rxvSPvJ4TD+hMAi/g38tvq7Qnzw15Tm7T2bD
+Pa6L/j1L9b09PCIwPp04RL5ap2O9MDjCPfwgAj5f3BU+dffxPQqeBzt7Xx2+OENQvlVsn7wyVme9
+cGVJvSOs2bxtDAa+WRzFPCyGD73pQ/S+UWEcvu5qqLykCSm+Uqojvlegt74ft9m9MYkXPZL/Lz7t
+zTe+u0YPvi7WNL10Jj++E2mJvea+Gz7O76A+kFH7PUhWOL5BHPW9/w/9vBs+Xb3JBTc9jKHNvJhS
+V71g+Am+8VruPeiIlb3XDJ2+pa9mvqLvUL7XnkS85KkKvjgE0j4riYA+gvRkPhc6Wry98PW9twIK
+vlUP771Owwu+PCrvPoXCZj70nJo+YzZzPjCwnbzgVOi9uq8mvRvnc73Ewl09DVYBPiBzNz7a+C8+
+MW2OPYp8e7zjyZe9WiOSvbDRGL4GOGE8L48IPlYnOj6nIXS9pbFmvom7zb2IaQ6/zTlzvr5AEr5x
+EKc7CkAjvSoGoj0KrGC+8LiJPf3QO75zSIM9tHEcPpgQ/D3rqSy9pV6LvopR6b52RPC8BbzYvR8Y
+oj26zxU+BQyGPnshqL0eLTS+Vxfyvn5vlb2biEi+mPSfvQRnvj2kzDw+arxgvZSjM7ziil++fcjc
+PbiPp703N/a9zcrdvVwagb31u4W8uHS0vbWF5r0/gRc+FagXvgZ6Wb6sBLW90gkWvDj+g74KrME9
+9t2ZPNLV0z0anro7GFUMvqQ3d77Bc1m+oTWivV10Nj33/qW9hrtbvYo/C7rpggS+oL2Fvuows707
+ivS9aYEaPISpDj5Q8hi+nPMLvTXnkb6/8+u9xN6JvmuUyzptwRI+1fM3Pms1nzw6h6+7z/lpPt5Y
+Vj6+9H29ayWPvoAGab7NZrg8IGFfvXziPT6vQgo+Kj1VPXtMBr8CK7a+0+tovU337bpEIw0+FRwx
+PQOfXr1tYMu+bcDPvl5n2r7Ubiw+mKdnPiTxHzxCyhK+s57AvsRjDL/6Uee+Mt+APZkoBj66AuY9
+gWJMveywgL4hmCi/GTDDvvE+OL5RQ/c9SWOqvLm9sj1YE3S9tZIavHXDvzxguTy9EW12PSUKob2u
+9789ZFfpPTqhlb3BxUQ904crPg+JOT3HZMI+1A5ePdiYCD78r3o9O2lPvP2zbD15YiY+ChlePtdx
+Tj56f5U9POBqPl/ePr15whw+CpEavc0z4j14+4o+fc6dPh8Rij69wpc+Iwx4PnBLmD3ZozW9PGM3
+vfA6xz6dY5A+vB7OPhhYiD4Wcmw8vGJIu19xT745o6O+aNFfPhpd7zy1gUk+Bt1+PtQlfrz+T7m9
+g4h7PH/IG79eVRO9uHVePjTVRD7YvSw9j4CsvAmMl70pA8A+0C/+vpNiOr2S1xw+izzDPgGDiz62
+rp89R0i5vautUD0M87085eZUPTJFjj69Dgk9qnvOveg+2L1pgMO+S/azPcA6gD6fOSy9ve43PC97
+MD3UODW+hXn+vVH4C768Hsg8hY5uPiQbq7w1lX+98ZrWPEbSPj4QhzG+VKZIvFlYEj5L1S4+gaRh
+vYwoaT4kEBI+mASOPQpXfD4aHSk+2FN3vP1QbT3i/Ce943KfvOYOSD4n79I+aG5gPqXgbj45ksS9
+wV6RvRYyL73NGw0983FEPvyWnT6ge/I9wWqfOxe1Ur7g7Hk7ti2GPTIL4DyrhNA9qOJOPriZLj4i
+T428VfblvQ4caL2TnUk+gruRPZZqCT5/FvI8UpIIvq4HhrzjciG+Dd7vvNvKzzy91Fk9z4uxO83V
+iT0fgP89GI9gvKLA2D3Gq5a+09v0vZ2iQrzBQha+xBvWvLAZOD6btdY9dU0kPTDgNL4FSrG9OZUa
+vn1iFT0tDVk+ci8BPriHOb3y8wA+l0+vPq8v+T6Dj9E+sn8xPomSDj0n7Au+zqI8PcRuSr7bx6g+
+R9ajPqxHhD5hktw97o6TPFr0lL0RzGU9CpgDvj/9KD6Tgso9bhisPYM9kb3Yjtm9fL4fPRD9Vz47
+kBC86lxdvqRhRb4cEiA5s7OnvFAi37zRSAQ+9h4zPg6x7j3qpOW+ebgFv9kcwr6J64q+L+tNvaar
+oz5jq0c+USbsPaoQ3b5F5SS/gymOvhSMyL3/3Um+H5qYPjskrj5viZs95AMGvoqRDj0LbJs+zjqq
+uw/bAz5qJh4+JojPPsTuZT34cUw+cDaLPoHK7D7kf3c+frIFPnoYYT3tnaU+VwTqPD5Xkb2Vcvy9
+X+K/PcU6ib601bG+j/Xlve38J779/8u+OUaCvXj7xT3luc66ffEovqxtwL6kBQ2+qsmmvmPxfr6t
+S2e++jOPPXBaUTtNLwm+zBPlvvdGVr6s12y+n9ORvf1K6r4CBq++Nbm2PZx74DwwZeM9sMtuPlJ1
+WT5zywg9Jjnyvh2Kh75WBxk5MZOuPo+RpD52K4U+IUmFPctjRD3iUsG9Fk2GO50IWj71LqQ9ZKE2
+PolCIz3BUZI9/kCZPLNBCT7L0Vc+zSkSPvQ5+LxWn+M8ZV2qPC+wnT2eOL29qB0IPg0ULj7Y6zo+
+198NPvlJbz10OaM87R+LPWdU0z0quse9pO6dvdcJCz5A/0e9NWzCPXSC0L0TiyS+vV4uvPuFiD1M
+JIs7Ts0UvjyR47tAYuc9teravecTyrz3quY9n5WvPhi5cT0q8CQ9sSEKvdxkUj6FZ1Y+T1JmPA3e
+oD2iJt4+Wf6gPpfsqj0u/6c+2YLrPq0+kz6Ctyi+grGbvG6zzDxFvKc983EMPt14gD6vhlg9qg+U
+vW8p0b5BdL6+3Yy9vZMMQD5WCjs+8wr0PBc5JroN+PC9Lsp0viSByr2JBaG97W7lvANkkD3GuxQ+
+LGTVPdS7D7y/en0+gJ40PSrEQb4phHU+v9NWPk168T3/WgA9SXidPulKADufjrQ8tpWmu5d7YD2K
+S6U9Qw4HvtSUczwHMwi+gAFZvqSAtL4shmk8WzNsPv9mFr2chAS+y+cEvO5SQz3inVm9k7EevsbV
+DT8x7rg+HmPkvaNg9L2dgne9EQCxPX+2hjzcJcw9XlKAPhkD1L1p2jm+iKruvZ6pjTyiPUi93aPF
+uwqeID2Dc7i+YqFEvsJBkL6wwB2+3RW5vXiAVD7IUQ4+5OmpvegpGr5z+Jm7l0unvOCURb1oDxK8
+Y2OLubniwz1O5gC9vUyMPaJ0Pr00tgm9e9HbPR8xfT23bj+++WjUvUMQZTwiRYo+R4f1PbVgiL0p
+TKs9oIpiPckOOr2shY69yZRfvaPGtr4U7EO+7ICyvQYJBzwpDEq+5MHsvaZHqL2lwNO++VUEv84C
+qL6KpTK+wkBDvS7CQjzwPSu8VItwvjJEIb4CsB6/od/1vobXI75lApa8CSS2vcZtWb27BhU+t889
+vWwPKb7mwJ6+1zfDvkLDXb2JWA49OT8gPhOOcb2O4VG9qaTGvXtMLb40urG+0pPsPbY7ab38WFI9
+j5MJPdEwubtUOGm+bg25vttbjr4VJl49Tsc0PHYv8T1zo/292+Uzvi8gNL4UPHW+Ew/0vYATNT1g
+Szk9AFrxOmUaFL6Z5ty9mo+RvoWIu71J56G9pIHFPO6q2j1eezC9hWf9ParyDT7K1ea9+HbhvXzb
+oT0ZVpM+5QauPhY0gj6aJp+98Uukvgx/Dr5ykEa+nOOqPqnUCz8Y0Iw+7p8QPnRqJr6NOri+i0op
+vszTRb1cuPk9qpS4PuFjnD5jjt09lEPDvWXfdL5+J7w9VAxavilr6b2+qne+aeTDvcSER76+Xpm9
+uyMfvmzxez0kA8O+sygJvwHpA79aPPS9CVStPPRYd70jtB08OQc1vV6Dgr6+ZjW+cn5SvYPd2z24
+Gqg9jIH8PL3HbT1yUVY9XLoWvLTbFD6AR2U+5w+OPdaHF7zUNyM9Q5WZvS6LbD20i2I+E/6FPmBV
+Zj7zsfo7GZeKvApuL74ZsKa9kl+QvGE5PL6p7DS+NS/SvATgJr7rViS9IAzbvZzp+L1i5468y9od
+vlg+4zyIJwY+vAP5vR78e7xbPhq+6fptvgYKH77T8gC+KG6IPpexKz5AT5E95DcMvNab3T1i8LK9
+5G2Yvq0VED5Xh60+Bbc5PsS+L72jot89DEw0PgS/Hz73BWm+aMZ3PiRdCz5KL20+Dz9cvcGuiD0K
+8Kc9bZhTvT01RT1ra2w+MC79PUSsuT2el1c+e4oHPlnLej1qsru90hkSPh425z1ksmA+XQ5DPvRR
+ST66/Ww+r8iSPorvoz5Q2BM+F4CHPbHOIT56xL49gyLHPdrndD4PXpA+Q4lEPudEAD6PW3i96cfg
+vXQACL0QMLm80zVgPVJE9rsvVDQ+GxU0vbNAIr4nK7W9EAexPWb/WLyixkg8N2gNPvVWez63wbK+
+BooXPfeJUT7qvma9GEBcPZlWqzxeh8U9clMaPerwo76LIPm8fdx9PoYpsD39aM09z7DPvSZcZb0A
+Tka9CW9ovmWuKr1ECiQ9i/XPPWKkgrxlRgY88fxMvkFeF74f/iu+BBKzPWXINj0wg1e+0IhdPZMh
+e7xIyHq9QC5bPsDBTb7SoBm+VyW4vfbCsr06dDQ9+2EnvQ1gDT7GooE+wAtjvlygRL55VLm8PQxb
+PZtv2L1DoLk94xhgPoo3Kj4EJac+IcclPt2deL4az/O8aoV5Pr36Lz7ZCis8pdmdvF62o77MG+K+
+WFMMv/oOHb7wuD89pIODPjqNoj4OcQQ9PrZIv1sNPL+yywy/PVFXvqVAiz3tAJQ+NtaFPHNBgL6B
+LqG+kxQHv6KPrb5PC4W92GsPPto91709Ho+8tI6GvrtMb74qyT6+hVk9vKu2vj2qRC8+5Xb9vWKg
+Lb6P88e99IE8vpf6JzyXSr+7aa1ZPaHFpL2zmHa+17Pevc8fLjskti6+3DaUvR19CD7OPwc+shQq
+vanQNb3WKvy+ooU/vgW59L3hdRk+VBmFPTvI3T0zDzS+SmbDvuXLab4hUHu+Lf1qvjN9Bb4sbco9
+R7nNPZsKXjxEGC89YQOlPXszwz4iqku8gbCKPWbVFb0EZYc9C3UPvcV0ET5o5zM+1RnQPg3cNT5B
+eqk+kMFFPoNlqD2f4qu9UnXzPIs1ET7frWI+SFGhPpiOBD5nS8s98meiPlm1Hz62h2w+5GopPsfG
+gz4f5OY98PHsvXw2Mj3UltQ9LAUCPgOEqz7njJA9bwBCPuUZaj5YFoE9wwYiPh3uHjwJMag+8Ebw
+vMs8372W1a68WE7FPZ30Bj5aT1E+gQR3PnbN0zzUeJ69w6+IvRp1vL23QJQ+unpCPbIOID6vwEE9
+FQEDPve87L37i5294jMTvJq8W77+wEW+EJ9LvTTpJL4s1T0+cWE/vq6Uo7ymgtE+FIdGv/sAKr+x
+MmO9mv2pvbLvQT237cE8xCXsPThpMj+V64q/3ijivnR6Qj4A79O86P/LPRXSJr1kYoY+y2YrP+Dm
+j7/Oh2i+BacsPihVxrxocaW9CDRuPs+vvT7Pfc0+Zdj5vTZ/XT4rTRi9HJs9vi0F+b3fvpg+dnIS
+P4LXGz8U8gs+y+cIPveVNb00j569OjZnPcb3ED6TqOE+uXtfPnpzPz4qFqU9S/MJPiTrGT43yKI9
+hEb4PXjVHT4aqdQ+z1k9PsJP0717RSS90lFCPvMVPj6YoAy9BAqHvUxjjD5L3d0+S/5nPhuJer5D
+58e+hLJlu5mF7bpKjg491lobvq5Qjj56uGI+QyxxvIk0q75d2vS9Rl+rvTh4Z73td3u+JBeJPuF6
+TzxSfDY9yFmEvlIvY77gQh6+oQ/LveJjAj4G7no+m1c1PYW3xTvRPa29A8Z+vRXWvr1vU5I9t+Z7
+PNztTT4Q4sY+dRHTPlOjgbwNYsM8qL1SvAr4N72hhOg9RswnPhfCoD4cfMc+KBUdPU9DC7uHD9g9
+IvmJPpZ2zD6lNS27VGLpPSvdUT7ijcU9GUYlvizUID5bmDM+yH4OP3geIb5Qus28bt1jPl3dez3K
+jTe+RkyaPCulvz0SxcQ+OflyPqrTZTye8m6+GAZmvdlTDb5IrIO+ScUSvmTp+z34rUM+y9I1PVee
+3rwl5we+HRizvHLcur7GwrU9VKulPfq3qz7HCIk+Jj3TPUzVwL22VYm86I6ZPtPhUD5IYzc+EXrY
+vZmBjD5JRII+JC8cPRiCTT26bgY+O3eFPiMPyzuQyzO+/y0lOxqTbjyvUT49TF3LPTEziT21PK09
+1A2Lvl6oNr2jH8W8JGxpvS79Uz34ElS9BhhjPlsygj7QBUa9nV79PA6ZrTzOgTW903p8PScPLD7w
+50o+tDboPUCAMr4lR84+zVEtPmqGJz7q030+ZP+0PWzgrzzDXAU+l5krPr1cHb4/73G+kj10vpbf
+ob7Chbi+Rqckv2aWQr7QrPY+vkHcvkVjqb6l6ei9Y8uAvh746b7HqzG/lULWvhmRxzy9WMK+CdYu
+vtVXiz17SQo9p6ScPSMgsb2gcaK9HfMtviRrnr60Xwm+KfvwPeXqzrxuJ349+Qy9PnNYgD3Ql6q9
+4OlavgqOQrwQaoq+3QV9vtRHpL0BVhg+Hsz6vKkgIL52V7I9AHvcPqPopTyhIWK9w9FDvJq1BD6q
+xdW8hZSHvdWlDz+1ISQ/B2NhPtk37D1Buzw+DNCFPmll1zwrtMQ9NezVPgMHFj+a5uk9+0MVPTpd
+mzyg0AE+kmSwPRHAJT5t3wI+oIRZPgqW5r0AnVC+zjyqvog5kLxDKiK+Vq3LPQ9rHj/Nfjc+i9TW
+vYpATb6vfRy+3aqKvU46ZL2NoDW9K9YkP/Ec+j6R0js+QhNdPvEDHD40wMY9meXpu5deeL6bk4M+
+hS+UPkWw3D7tnbM+cGRMPprsJz5/Xv69p3nOvqEqSD66GZQ9GORKPiDvyz6Gbjk+PMebvm3UhL78
+I0q+aTZGPl1/Gby7lMK9PaeFPJZGkD2y942+jeM7PW0ruL2mL0A+hefPvTwTnD78rGQ8PUhMvidl
+EL1ZqUs+NDT8vUtiSj46Aau82X18PNvji73n9Qe+kcs+vvmOFj6AkIS+QV+xPbEMib52dEK+IN8E
+vgx/fL6AdJG+Tbgxvnt+sr2YhA+97jmMvmbAZb7IFIa+6gUKvpc6BD2M3No87YSlvWCpEL6wn2i+
+jqOlvmdlwL15kuq9yORvPgYh2T3RWbW9+YKYvYFh1z1wAj2+Kt5GvhbQF77hIwC9YD08PabEo76v
+NVa+gpqxPT82uLt47uy8MkJ1PaBHgTyivAq92H0UPRUnW763HD2+fn2JvpMDUL2tkkA9KOvgvLHV
+az4xIhO+uWYHPfqt075DxrC+Jg2PvY0tLr1u87I98qBvPrjRAD2R49a6evPrvvZkyr1/OBo+PGOD
+vZwMojq9KB8+u96ovskUaT1fhkI+iWpfPqB3wD27ZrS+eLb4Pd28OT3dabs+p9YPvfRODT4vvaY+
+dUOSPUnuuz3raSM+Y6/APhhy/D0eiwA+ZcCLPodWBT5YLJC9qJiXPdL9bj56Ma8+tcQhvtWHvzw1
+4lg+j7h+PpQ6AztaE8u9glWiPeJUhj0tA9a9hKKnvRXVTz78HBW9wfFQvYgjHr4oxyc+2z4fPvhl
+ij3Yb/e+c4AMvjOx2Lo3oMc8u4tCvNJ0PD4gX0k+668fvtaR275wlaA9SpVlPmxcQjy0QSk+gyKN
+PlQVar3609i9aWOavF4zHj7ooHg+yCY7Ptrcnr2XDWU+LXwpPTn1g75t/nE8h8covtiSwL4bHAC/
+TFrRvrE59r4G7ty+uPKIvjcAhT0vXLC+G2LuvtGj8L4OuYy92tLAvVa3dbyjere9DFrNvi+yNL4i
+oPw6L0KdPS2Dhz6BMJQ+dOVwPlJYlb6FhuO+bpaMvuTNMb4Fbs49lbqHPtYwnz7CPfy8PYaCvhTL
+kL6PHvW+EwpsvoAU6r0HFwY7rFTlPRSds7zc9dC9HWUOvizvIL6ckjq+x+RBvecyI76LNrq9Dn8S
+PqJIoD16N6k9hy8qPk7d871XPmS9ZpxgPEHd7j0JCJQ+UxORvKAlxb1zcBS+xfD2vFZeIL5rxBe+
+3U0gvTr6673OCBa+HoqCPjyVHj3BVyu9EXoHPspl7z2w8DM+QbJPPSZoOL4OuoY+4WOQPqBpzj7q
+RhU+K0l9vAjtRL3dsZK+5z+8vXqDuT19wLQ+2JupPkpZTz4Kh1I9Dz6JvgyDAL+T1p++rvhIPn05
+4T3hkK0+LHLCPktBCz5yS/67LoTAvZRSsD0zjM4+OPKEPtkJnT6iTQ8/sqUUPkdvXj4iGZ89G35r
+PsRxjT76DIY+MvCoPt7bcj41whQ+sDImvpczOb3JPs49fp0cPhm+fj6afNg9fDZJveiMML7eugu+
+JKulvpxUk72ydZo92A+TPLS69r002eW9xGdxvoo6NL6b1dq+DkF1vj2/mL4rWhY+c3itPjC9tDxP
+r8g9pkfzPG7xTT52OGI9U9YhvuZ23TwqFPI9/AWyPf6lHzx1YxE9l3uRvQkMJz4PKwe+kNxCvmTf
+tr0FQpq9cP5EvalbYb4IURW++hdUvvKy1r5vlJu8b8LbPLGeyr3Iwiw93uBDPtFGIj5emrk7+dnB
+vjp4A77yBMe64Zr9PVTlVj5+isI+sYx2PpHbvz2HExu/hvcEv9wzvL6LWvW+py08vKvv3T2oiAY+
+U5VePrK9Fb+WvEK/Yhk5v6lXCr90WhO/rrTZvUrfor7tmZG7WG7evoZ+B7/QGTG/nhUWv+vNwr7l
+XLW+yCBYvvLym76BRQo+4+jzPZHPTD69WAo/h40UPolzkDwpTLk9DVfFPqMblj4WEhQ+AcztPd4/
+TD6JSxM+qeDsPVQxMD7k/aE+XiouPd383T0R2Vo+IWWgPVAN4T28rf07OJmyPYjMBT4N1Rc9S5eM
+PifQFr0yM949cBe0vXY90z3eScA9k+29PdC6Mz0gHx8+mR58PrwIzbsZXRa+iPamPeZwMbw0cFW+
+Enx/PhFGgT48k4e9Q5CGPeQ/ez4ruOU9AheSu7nAEj3vfaQ+BYYbPmcxr70EMrg8ozpyPp3g5Dsl
+NkQ9+TW7vDhImT5Tl/u8B9HNvX8ZIL5dhSs8PZYiPe8Inb7qPdq5ZpnqPVXJ+rwevG88Yg0vvqLT
+q77/H14+nqrgPjN9Ej/EDFe9s+9mvUk7tr2gCy69hxWEvhVMgb6rspY9b5mVPRwOVL7S58M97QJz
+u2Kelb2Hbpm+1zsJv1XAiL7P05M9OP8IvuFPBz7dAR0+aNcdvjkcur77bs++Zao4vpOZd7y0Iig8
+aItgPpVRSTyK6ua9dYBBvhP1cr1IOTi+Tb0+voYYuj6vsQo+LwhjvSDhEL7NYPa8aEo8PlnlyT3u
+3by+WgxxPvEJ571GP2O+Mk2Gvp3thT43e5U+GBgsPmNUpr4MLKS97f5jvku9tr6cu5C+X8c5PmZo
+4D136Ic+u901viOlbz63z1Q+dW8dPlf7br6lCIK+q9zSPevHWj7s54Q+ol3oPqiJqj4RexO9h8eV
+vtRPib7Mkac9CewOPjnrCD/xlPs+7x7SPoOSkL1/oEO+XEYVvbvQfT3MQD0+tjQVvnIjmT0lDnQ9
+BG4fPlvfCD7ma+I+K0uSPsxFZL4AWLm+xGQqPslKOb2VzXA+ceBYPlPHuz4JuxM/S2gzPtSSsL7h
+xag+BWWXPWcbOD4rU+Y9WZIXPnAboD54Hb49skVfvpIalj2Q/TI+LwfIPFQrnj2O0+49QAwoPV/H
+Cr1TKlG+fGeFvXozXz5fSiM+86/rPbxmTD6HOu49Ul5bvgdtxr6kl4U+OnV+volc8zkoAwy+URC7
+viM/hL4z4gq+limIPtHxhT5VWg6+u65Evr8u4L6leCG/RRf3vjYcjL5mdQ8+bVw2Pls3ub26nmu+
+2vWKvsyZ5b4dJN6+HNYHv9j4nr4KG+09iNz5PbcKk74c39G+qOiSvnRSiL6HhH++KP48v1rEob6L
+9Qu+h/UhvlAMh74da1K+sp82vm/dW75yjPm+7t6cvcgL873cSs8906hdvqFrCr7sXTE+5GdpvIWT
+Kb04lX09jiHAvbE7QD7+9yY+qJ62vZbfIT03bJc8khbKPpHdzT4jdVo+FDqhPtQ2YT0y/UQ8qASr
+vSIUH75inNs9d34+P6nbhz6Pagi+r/+Av1D/dr/g8l++0UDLPXctzT11816+O8devtVyor6891K/
+GIWivtsTx7zhBoW9TLYNPT+/AL/89Ci+ZAUlvlre2L4cda6+4y+PPK66nr3whow9dFSIPCTHCb0F
+/Aq7gdiTvpAnvL4pIfK8YbmbvTLNYLsDjgk+6U4sPvuqwrsjQTy9Pp+LvWoKhzyN/HQ9CJ8dPtM5
+pj4VBKU+iEt9PYNhb75zsMm+LOiuvTrAdD7QiPc9kBf7PcohPT5kp06+yE79vq3Z5r71fSO+F2z4
+POtJ4j5QdPQ90m0Hvle3SL6o5w6/0oT8vglMcb4/TH0+UdnmPsE0mz6qWxo+U9qWvUnMS77aVpe9
+ueavvFKah73zvtg+ft6MPgHlyzzxXJe+LwcWvsoALz4nJVw+ETBqPScCXj5sy6E+b+g9PpQHA74L
+Oqe98nLlPQs0nr1UsCm+zHELvgJWMD7kyLE8tZmRvnTN2b3Omfo8InHovYyC2709HgI+bbA4vZ1x
+gL4C6am+l+mgvp0VyL6D8va9B4hPPqEErT6kmM6+PbnxvtbLCr4zkcC9Rm46vhMrDz36wOs9Miex
+Pj046L0kOY6+W1wNPR97z71sP0m9NRElPTGSSj4N18o+Ta2KPpQ6jz3VpXc+qLEhPF5iwb1Pe8I9
+HEyhPb1nHD5QoRi/46eMPqOCdD5W+kE91zRJvRyY4LsAQ909pA3LvtLZ97/85eu+iOjhveUbtz2r
+Wfw82WWhPnTaxzsCa86+lywqwMS0gb8dALi9klHPPAHkfr3K6709k0VIPC1uHj6MsjXARDGVv5ym
+NL7AEYG9ysQpvuYGfT1eCwQ/qyZLP9+8Qb9xaYS+wnwrvqEkHz6x9JY+vjCru3uwYj75nEI/4hKo
+vS8CwT1/DTs8qwYfPUtIrD3VQcM9cIBMPpQ55j0cq4g+4KUrPkHODj66MS4+H+LQPVcuWT3ANrU+
+RohkPYw8eT2rfG0+J/kLPkurRD3SqAg+20eePksYOj4x0Aw+4sZ3PeOXmb24mTM/SwKQPZLiP777
+K8I9z8X8PQfFGz31DGG/ePtsv0/2Nz4siO89xZYIPtufwb2mEHO92PenvR2Uj7/XEVe/xk6bvlw7
+IL2Ca7A9uWAbPl/exj1hJYG9g4EgvZXEbr1dNYS9d7V9vgRk3r2QHd+9UfDovRrkYD3Jwvc9DJjt
+PeZ7Bj668Zy982FVvtMYKr4+Wvq8G4WSPklr1TyZ1jQ+htY4Pp/X6jwm4JO9rZBbvu2M1L6ERZQ9
+nPOHPJqhvLwYyo296x6JPM5Vjr3ofp27bWiJvzHNs78i+SQ+GS0lvUL1mD0gpiE9sOcZPaBxhz6J
+ZjA/wZ5JvjoQzT2dVem8OYZtO57d8D0Htye+r10nPnydZz2YY9a9auuAvhGTlj3vdt28i77juy3H
+HrwQcuM87K8rPG9iJ75pC0K+sffGvWkOnr2Hti48D0TQPBKa4D33Vn698Z+MvhIem73afmS9AdTW
+Pa63OLzpHLu9T8UCPSvrkz3bOGK+s3MHvljJRj6Pwi4+sjmtvNOmJb6LmZu97LupPaIPGbzHr0+9
+5wkXPQUdyj2kCC29p7TlvM/j77zt9Dw+63EYvud1Fb2SqaQ9THAkPrn59z0x3Y09ltsZPvZCgj41
+DoK+IrEivbDx1T0wU/A9MkOqPauXMzwZK2S92mIFvgO0Tb63vwS+IP8APEXTgb4kmlq+w/BBvlB5
+Gr5R6sy+1OOOvrxO+rzH1vG8dHArvi8k6L3bLdQ8FdsVvucpQb55CqW+cAOLvpROizx5EA89R082
+Pq2peD6lXhI+X20+PWufODxPxwa9LrFZvCDbdb3H5Uw+OenJvSoTFT30klQ9OTjOvEo1o779S46+
+5yQCvu16ir1rRcG9yKXiOhXC7r2UyzG9FDR0vWJlML6YKIy9rwf0vKAHtD0jQqc9DcmAvUekB74d
+mWk7/luDuhp9/718/uk98NdqPW+pdrz8xzm9kopKvmROWL45B/K9+2KdPVkiID4KxO+7PPbtugiB
+i7yFYrG+xcUNvmAkSz7yqMk+TpgOPuun7jwdvCk+WcMrPJcNqr6R/UK/bM21vSxcaD6LfDY+lSvw
+Pd1fhT4MM7c9ZruAvoGgnb+HWRy/P8IpPuiQMD7DJFo8bLsoPtYZTD7kj2M8vfWtv79B674wN689
+MOmnPX5jsb2aAYo+iYjuPv9J6j5+CDC/oqRKvhaYrj2VSA4+ntxZPWj/kz5Mk5E+8yOwPu6IH77n
+j2e8NEZoveLUhrxt8/k98AlHvK5aVD5qRI09chmwvr9wJb3Vo5i9K/CUvbKpIDwb6Rk9RJVuPBfp
+Sr5zD0++qTglPgPU9bvoDu+91icDvtDAQL5bmoW9BBb+vfcdy70ZW7E95d5vPWIEwjx7Bli9J/w0
+PN9q0L3Zl46+07aCvdpMUj2onR4+jBXMPcDINj4lZAs9p6H5PGRH2b7jg4a+ha+FPBHkdT0Z1v89
+mT/ePWX8Fz2C95o8h703vm7XZr75pL+8PbGZPussBT74bS09DaIEPi6PcD0VfR26PTf7vM9eAj6y
+rH8+1XGiPT0upDr0DgQ9UVrGPKx0uD2kPie+wWj3vUh4kb3fmmC+hr4Ivjwf1rxXXSO9oJ9Ovtou
+UL6Tnji+V7kpvsqZer4GAD690LKIva+2mL5mAJS+iHdAvjLnOr6tzAG+vSqFvqhQlL2dGf28PfqR
+vtpusr4R3788TY2GvVPUA7+TXRW/wOmOvQ7Jjz2I8hs+snYQvZysg77zHza+UkfvvZKxWL5dlqo9
+sn1APtiwPz7i+wi9M61MvsRvm7zjLGE9Z+XLPZxoaT63jKg+Yi/iPSlQRL6hrVS+WqDfvW/AeDx3
+CPC8p9yrPaSljT2UWAS+mWAuvnng6D2kWOU9+Q6kPcXVAr04d9y90lWpvc+Xq75xXxy+RlKUuwtY
+DT4Q5ec82HJKPvAcob1XDWG+l9lvvji8HDwRHA6+QzkMvSo3dj5bHgS+NYrVvqXVnr4iUs69dcSK
+vdEfM76herq90SoOvvFWib5kBBy/sbGNvunYF77UHrk9Tuk8PszPYzzzrHS8BPGVvNjDo76my0e+
+AXqUvv0Ggr3VEKy9kxbpvTuelr4Jw3W9+Ls/vhr3vb1Mx2q9vylJPnPXp71aSpu+7qnEvuf99L2D
+j0o9vMiVvbDWqz2gkH4+Hp6FPlZ7FL5G/4e+4bfJvXcYOT4H9K0+cpqNvNgtlD0ABqQ+xu5sPs+e
+Sz7k/pC8zhQ/Pfl6lTxwiwC+pnGhvj6G1D5CmAm9KVWLvH/JLr4FBbE9nCcRPbKpa71H/Ya9h8iU
+PaNiur1XQhC+3BoSvthJiz7h5hc+Ld/QPV5qsT2mFgQ+3N3KvefMBr7R6KS8BQQNvShtgz4okbY9
+9vmSPuQ02749i+S9sIMJPvPlhD3UZ7i8Ny5+vusbM751YUw+zQXiviVPRb6n9Lk9bM6gPSDVHL4H
+tUK+Ra/LvhLjhz460wq/bd9HvppZFTsd7Zc9cfEHvhVyA76i4GW+VJ6uvLs65b7XQOi9wBFjPmjQ
+iD5W6cg+xqMNPp4dX77owue9aUmevXWG3DxSW3o+rdgDPk8OoT6e65C815UHPUPNhjophC8+CBDP
+vYbFnz0lnKi9tloIvs1RHD2IZFo+nVUVP9DBLD5dKiy9wrcFPV3/Tz1odVw8G+MJPq5aVLwh3pQ+
+niKCPrfmQr7Fvba94Jb4uybv3D3oMu89aP3GvX/m9D3ZzLm+JsTPvtY6675C5QS92y9iPr+5oD5/
+tSk+5e3JvcL75r6WFuW+EmeQvsZiDr62Bi8+EeiyPo65Tj2/8ZK91qDnvtZh3L72rHg931cQPXB+
+cD1Npuo9cgwfvS4Epr2YLXu8jPCrvLNopz7fcjc+/eeUvhTsLj5hFAM+tzTMPQXimT1hg7Q8X/AM
+vHKSLTzzMp0+WxfAPoqVgT6PUJs++VcRvfhqAb34C5i9oMEoPsyqwDxAZD8+yAalPmSluj5DxWi9
+8ZQpvYpddD3OFzM+zxOJvanGyb6WYPW9/KjgPi6dPb5dGi2+yCnPPVw29jzT9KS+KLTSvvfkjr6L
+nUg+k9XGPgJPSz7X9J0+VRbJPrZklrx/qYq9sYsMPaD5iT5LPgi+WeeRvWk/Xj1YQA0+zsplvexs
+wz1d6BM+MhCdPjkUz76vzUy+3CcTPchEmj2RXSG+zaFGPHKVID7rJ5M+a4SOvsmJi7wB5+k9zoMq
+PboEDL7fh28+AzEyPqWGNz3LqDW+gwavPdk1Xj6OtiE9i7cqvorruD1+Beu9WmfsvVxnyr1W5Yw9
+6jkLPqnFIj6CPy2+Oad1vI5mjz2EbHu9qKwLPT9ROj07OtA+RFaPPYj1OT0L5lU+0LDMPqEWqT40
+NMM936ixPvQ1gD5Pjqe8Ntv9vbe+Ej7lgJQ+SrDEPh+Uf752Jii+34f3va+0fDzw/kg8cSLXPBhZ
+4r2BOjY+lli9vnPTdL6WZYy9NQgvPoCp5T1Zo0y+dRvAvN4EKD7hUxS+CBJFvb/PlT0G+Ka9UOmg
+vcyTnrwIWb49zmMXP+x3Tr3ytww9hRz5PMZdjb27YYO+S8BCvd1hGD73kjA+iaydPEkc07yDi1A7
+HWq8vcyCQL6nsZw9eQ8Dvo39mr7VY0A+IGZYPjJttjz5dJO9twF1PKtdcr7oiYm+lisAv13PDz5U
+NuQ+8dImvvyzIb4yM/q92c5FvgZpFr41W2W+NBawPrLZ5T3RLji+x67mvU8cFb57kJQ9FMGoPWBp
+Bj2yeEU9NgcKPjZcdTxTPIm8ROVEvWBE4T2qwi4+ekI8PXyHsb4KJDK+kveZvYm/5j0sKCQ+q1uQ
+PrYKpT6IebA+iLYKv+tibb5mm8Q88W2EvGQ9sb2a+nY++JbQPsursj3F6QK/ei18Pagv3j3qvg++
+bQ2Fvm+tpD7F0w0/idlIvS57b76RdS8+zSzNPktpMr7zzEm+jhbRPl+KQj8BsRY+rRdvPUXZOz46
+oEc++JtDPgBofj7rke0+cOgvP98bRT5aq8A+zFNTPvGuSj0+xzi718BSPhLwpD7AiCM/JLe6PtTN
+iT79IF49aqJqvCeVNz64P9s91+BBPYZeGz9tc9w+K4SXPqmVZD6d2cW9EBUDPpvMrL0v21C+nNPK
+vSm7cD40ZZw+1Wd3PQXwwLzGN0m9j1LOPG/s172or648UgOaPmbLh73lcBo+3TaOvLOFEb7uIXM9
+lhUWvkAG2b3j0Os86/zKPbXQmT5s1mg+zXgVvuhgpr5rcci+wLdhvomkA79hwjE8B4CnPg5ylD5f
+fug7Gvy7vpzocL7GIl2+ZFHGvmV2nb6p3cu+z8APvfo1rjs1LKG9zCfZPJXiDz7rgjg+yrF7vi12
+YL7FxQW+s8QMPZpXBT7qW5U+7ACRPo6CAT9zbGK9dNfiveRWWr54o8O9NaUDPqBVlD40NC6+s2w+
+vXSebD819RU/gvfVvbB4hr9F59e+C3thvUZ6pz5d+7E+5T83vvCDRbw3jqy9FOuqvmlbmb7/SRY9
+itAoPnGzFz7DIoi+FtW1vdJ9TTwTWua9cTTTvaHZr71ujeI9pWAuPlbXzj0Uo6Y9LFsKvRfPo763
+PGm+2ccZvpJLb73ALl6++FFAPsl1Mj5agB49PUE3vjNwIL5HYno9p/1LPQFfXD2u9co+xkt5Pnom
+gr1x4oW+U3qsvYs6WD6GbIo+RNeJPmv0BT4nlgO+WfiYvjgzH79rN6+9iaYkPU5rKj4cDe49PLx6
+PivMML5yVPW+VOKwvs8Ylz66BYE+s0W1PhvRuT6TknA+ytgdvdNAvz2TNNM99YL0vFVszDuTu18+
+/FCvPiLbyD0f5sI9qU3IvalAODwXE549hl2NPsFmlj5OtBo/fFUwPg/jwT1ugzy+iC1CPRzPRj4o
+eZE+pHy9Pg4X4z5cVRM+hRFevW8s5r0g1Bc9CYNOPvkUhz4cooY+T/UCPsGviL4RYYq+W5iKvoU9
+OT7A+rQ9YnAYPg2TBj4cEbg+IK8Av5MYCb9R1669r7/+u4fut73RZyY+dBkhPqMFPz77knG9nT1R
+vsUKq7ywVXa+ulpWvrzpF74raDQ+wEsbPggiwTzvIoi8TErhPVQx570P++I6oeUJPCMooD1OMzM+
+FJaBv5kqvr3FpWk++Rkuutj5qD3dVUQ9v0VYPiv2xD2wWxPAaO4MvwKwRz0v4EY+ibcQPTm3Tz7M
+4pO9kGTBviX4McB69YW/F9WavBxTfT0QMU8+kdcsvXuCFb0O1LY9YBgvwJJ7hr/4M969Rzm8PqrX
+jb1WsRY90RrFPgwlXT9b9nW/7ngRv2kHRr0zZP8+EcHIPhSFMD4LsVs9q+8pP4oCHr+jTgi+8niL
+vSHmcz4Rhg4+uedqvje7Ej5Q9v48V2CXviLpYbyITq69k/hwvKcWmT0gvDs+2fmBPnYwrj2ua3g9
+JAKCPr9PZL62liG+5z8+vv1QIj6BDqs+J+MiPiJ4fz4W7Fs+uDAFPx0ZlT7iodY8U1tEPrt+Xj4r
+xnM+GhaMvm1Ver41BNw+eoNwvZObtL0Ub9s89x4GPbM5lD0/qfe+zF7FvTugjj0NofE9ZHm+Ow4b
+Dz2d/Yq76Uzzvc9bOb760uu9Wke/PRZ0hz4jq1++m/wKvqYFiT1EJss8y3WMPZDSFT6FBA8+caPx
+PT220r4Woq++He1Iu2DOJD7p2+g9drMGPke0/DwwRt69dmyhvoQbO78KJwK/zHCtvDsJRD6tvhQ+
+1LAXvQj6D7483+m8A2VrPSVmK78kooy/R0QyPjYzVz3a6jW92gXAve+N8z07xR492i8TPxkLJD7L
+KFQ/crYwP5dqBD+Hig0+lBVWPlo2jz2dVCW90m9xvqmkNb8sebi+jUJOvnRK971f84A9dj+CPjUZ
+Rz5j7pq6IhoFv4Lyrr74fGu+ox2lvtD6gjuLyHA8/IjgvdpYOL12AgC+UMNYvnurzL0+Eo2+evZb
+vXBmKr3eADq+nD2HvZrSGz74UzU+SoxbPuLffb07P7+9mfS5vA6kJr6RZgs9eBlMPg7JJT79kXc+
+8rhxPd2MuT2Xlh86OK5mvTsjUr6q2hQ+/wEyPkcChr03gdo9I6BlPgfnGLz2lWY9C5wbvhN2kz73
+sJg+mY3/PV6oID6OwlA+/eeNPkodjj5rh9A9m4uqvHE2Kr1kZyy+E6IevvG2Lj1/Bjy8BNdnPbVs
+UD1mmz6++kK3vL/0LL497pW9Dp8PPSudlD3EoJE+86pgPhWqI7tAll49VujCvAqJoL2d79s9T70E
+PkyNOj7w+dc+JPFbPtGEcD0Jl7K9kJKVvXGWMT1naEU++tqgPjWYID4JdqU9fTKTvr0K776phX++
+ZfgHPcN0ZD6QTPE9Y9k+PDYQc76PCDO+/YzzvYHhIb6ugrc9aYv4PaJ0n701JUW9GEZFPqozKT0w
+44Y9tj8nPfcXB77SVXM9YQPNvUyYlr1QjAE9z8SqPU/Y8D2HU2o9dtiDPmQltb2f6tq8VP/HvrSt
+kb/00Xi7ssNLPqxk2j2uNAE+M6sFPuT2/D3fHsa+wzkYwCh9Nr8Wmbg9aOzCuwTDLL2TdHW75ce5
+vcGwar7zjkjA2M+Zv6TY4r3xyek9y4xAvVKPRL3XIho+sxmGPmPeT8B7PqK/I2wrvtlyEb7FwU6+
+bl4ZPfiSOD+BIpc/HN6NvwOU8L67q4q9LnNavlAPIj22H3A9QBX8PgGWgz8lvP6+HHiSvdVIqTx4
+WBI98VKAPQqXPj2j+S07/aqSPvA6D75P/Rc+arKyPcihyj0Xaro69eVmvNP2DL7+0E6+5vYmvk23
+mD77TY09fbyYvHKVkT5m7+M995ltvc+GXb6/BTC+XaoYvjYgUbpoob49rBQxPrIyu70jJlQ+/NXd
+PRr2tb8ycC+/CV76veqFiD2YZK89H149Phb+1b07Kdy9+cOOv2MES79uy7O9O99Ivdrmpj0KsZu7
+DWtUvbVZ173Vqge+XEROPlgxYT3Gmyq+SPrivfxjq73glsi6rOUQvYVfMD7lIgM+6x2SPpRADj4Y
+i7q9nJEaPfDUCz5AJIw+U3F4PSxjBj7pUZY+UjKDPnxmZT7BS6A9T/w0Pnw6kD5JTw+9dskZvHP5
+vb2gyZw+oS0IPmV+9Ty19aw9IwzSvbFmxD3pT8w9NNt2PUOGaD3ZwaQ+s4lnPQHTSz4kXRU+LVIE
+P/VFlj5q8gs+kt5NPRb0Q76Y3Qu+HrYNvv/TcLx54es91t2KPnGShz2CDJG9d+KGviyDUL4A0Aw9
+3IV0PpBYDL7X1cy8yhDivdutsT3tpAW+HIm4vflAEL5ClLQ99I1tvv89tb6Js2y+FWYXvEOL8z0A
+UR4+4JzNPeJ3NL75DxW9B2S9vn3YYr4eiwI+o/QDPtcvjT7H7OY8STHQvYrlgT0nkH++0aaDvsAv
+ar34Uxc9GlY0Prt8FD5IITu9jlUmPs2YWLymBlE9d/z6PS8x87vf6CM9nhBLPpdBdj3G/SE+6bUT
+PqdtjDwmDuC9FWyePQE8kzu2aGA8cU2wPq0kL7wPLn89EuROPSpzKL4CfyG+jBNGvm3Ov75af3G+
+3NoKPcLUgbwWW6S9YDcNPQmsnb4xW1G+xfjevhsfQL4BDq08Ip8Svc28hL76sTG+OmszPYxP971Z
+SiS8Gd5fvr0Aub30ub6+2m8xvqVTkr2RTMO9lP7iu8NXAr2Of5i75XOHvAYlyr47XQa+7LG+vFc7
+H7tinUS9tGmOvAWboz1ZL0s9ocwJvhL90r3svjS9HM8PvSe2oj3RfD68J3h0PsyLf72KKH291pBW
+Pk6FDj0qXPU9g+tzPIMBOz5YvqY9AtZJvkBnw72g1R+9M6acPg+S7T1S1l8+FBkTPWwenT5XpPu9
+Ryz2vHxVWj34Bds9VtTQvYAcXj3yB6696VjYvUzCm77bHwe+D2jZvdQvULwpi2A+1ktQPmoOc7zg
++fG9ehYdv3thH74ulOc9Z1/FvKHxoT2kldE9jYgavu8DKb63tj2/VkGAvjq5hj5QLpY+OI7VPnKo
+ibw7hqG+rQ+IPp8Y7r5Q0d+9r9QDPltVST6jQJo+1bypPZGGj77s/k0+62UwvET0E74fanc9UFGv
+PC13oT0/kWU8t8sJvuSv8z0dhCW+pfcuPsOVDz7SpYc9/khvvTDSTTwc7yE+m5BFPufll71mdCM+
+Yn52Pl4ijj2hpl48J6rpPa25OT7MZNM+H4ZTPfkP27zi6Fy90s6NvgTde77WAQ29eTyOPBdCgT2g
+PoY+4XSpPSohIb7JfFy+2aTfveDTozuJF+c9buUZPus9fT4oIdM+XPBHPZZQiLzeO0O+Za9+PSJs
+G71CEuI9TsRGPlEcpD5d5Pw9CMdwPlurnrzw3wm9Jl9sPnIPET4ao5I+KmM4PiVmjr18n9E8Pcag
+vXxRY7109Bu+qMKiPVpQMz5k6jE+UNa7PKqkir6VBOe8aXplvtfFK702eF6+QJLWPouvpT4rjpc+
+4YjtvZCw/700vkU9Jm74ve85Cr5QlRY/52yPPrGFSz7xH229y5Q1vREB8r1/owc+rNsqPahfCz9L
+B4I+hPSrPYzYjj3ztzk+7oMxPjGEVj23m8a9XfMfvmmANT5iIwk+ue/WPRnaCD4/dzo+jBWlPRWQ
+rD6ewtG+BD+DvgWCKr28zFC8X50gPVPTsj3hSUa9e3llvWcBHL5nPY2+wk2Nu1Klo71UvEO9jL/Q
+PeWgPT0fLPM8i+c/PeqWoT1o1Lu8Uscqvr3PhjyCGps+ySUQPlX95b0hrqu6QwwLPvTshr3JEZu+
+H3aOPZB8sT6mD8U+UA2iPaYLrT2vOiq+mkknvjiyUT3K1yU+Ve+KPjIroj6XeBk+1fTdPlYLOL3h
+hh+95eLuvTTvfD7CgSM+qeKKPo4XuT5onqM+eRW6vb6yL76JbqK+lrKLvWgftr7fY6w9icJYvfBD
+GD2fzkq+ui6IvnyXGb7pbi++0O02vq9CDb5uIGM9OCyBPQGKfjwru4q+dPOIvf9NL7rk+p47AeQa
+vR9gAD8ty9Q9ikoXvtM4Gb6TPWU9MrR/PkHR+j3E1U4++HjMPvvSdrwJH0a+HCw8vgn01z2pCJU9
+LvTFPZIwDT5fco4+w/yZvsbIQL5XenS++7bKOzTzhT5VP8g9BIWGPTgmqj4ioji+CiQHvuaShb3y
+wW68iFA2PkJcxT2cfvQ9e1jcPX8RNb7vcrm91GkVvmdJLzzc1Jg9XLmpPlasKjy+nL29hDkWv62k
+A77D2iO+M2pOPMkCN73GVIC+4sbCPMVOnr3Q/K6/r+6vvnmmarzSWnw9D5Mevmdvh73bHyS+aSTO
+vrFE7r8gkye/ySbaPceAs7w5z5U99u8pvokhLb6d/8K9nG3ov5daLb8yhD8+uAvAPmsDqz6hjb29
+L0pfvibmNz7/kyC/HzYevzWiOj0N3so+ISO0PuW4GT7a4Vq8qgvePRI5w77svC6+SO/ZvTQJOT4x
+64M+8DRPvKCY37t7AY+9/e33vQ1H5LwaIWe7Y6cGPubQgD0Du0I+svqHvfO6Pj49lJg83131Pa+5
+Tj5Oq4w9cZHLu3IksjxDSbo88WY0PVAU8D0LUlw6ZcZhvo4agb1m1nE71NFFvl24Nr4gEz8+uo8I
+PtxLdr67g7i+FGZ3vnAX1r3OnBK9plZcvU/ZRr5u6sc9awe9vPzdt77wfI2+uTvKvQ2Nqr0GU5S9
+cJEUvhu0ZT52Ctw9oCYuPV5gqj3lPB+943jVvP/oQj0dQCG9dcqbPqPomD7mE+89uql8Pe1Tt73+
+pqI80RENPlWePD5xixw+mEXMPXg2xL1sTKw9EeXjvpHGpb4M8Ci+8dBsPb85ET6a7Ig9OEeOvbYF
+ZDzlBHe9E9YUv394Wb4Seiy920Y7PpOWDz1dohq++8rLPD8GSL6n7wW/Ks4FPqkMRz6FztM+tDYL
+PvoGQD6kgrA95+Tuvf1yOb4rhzy883CBPULqVL0Y4ES89JC3PGMlBL4Wpga9Ahg3vt+Gubvuyi69
+c3aWvoaMmLyQVoq9QsYBPi/JDDwsCqu99JGTPtmweL0OF+G+tNO1vSd5Bb4BqdA9fGMIvRelBD5i
+jmw9z/KSvI2wEr/OMQ2+PLXQPB/1hr0GAJI9Azw3vVbGlj1zIIG+EiNqvunthLp/bvw8/O+uPU0d
+FzyBAaM9pqC4PWG5C71M0Cu8AHZWPiiDF710B1++S7yVvqNoNz3tf6y9KXc1PaYT6T1M/pA+Xmnb
+PZ0Rnr07rIK+LGRZvhQQBL5aRNS8Zt26PDIbLb19EQG7MLxuPrpY1L26Uok9E8DqPVaqFD3U+bS+
+uAfdvo7Hh722Jgc+I7tbvO2Iob3w3US9+3cxPscmEL+XlK6+aunqvRLQjD3roHA+DdNrvUWGgz5d
+/Z0+jI8ev6k8zr467Qc8cFV/Pl0rxL1GH/u99/Jkvuiarj0oXBS9+qFSvaQ3ID1r+XS91vTovcw0
+vL3VtVa+kv2hvi4DVj4Fq7A8raHsvQ2jBL79YB0+n5FjPeqm2DxRB5q+Xmz6vWPXo74qYVy+6BhB
+PfOSdD3SZNq8OkrGvVvzj75vUC69aa0kvmmljL51sUO9XE01PpQ0Qr2SClY+uHArPbtro7vudqg9
+EGd1PQmU3DwLGUs9z0kfvXDWvz0CyOE9giEtPja4Oz5wHbK9t/ZsPMqKNj6RXtc9qgtXPXDNiT6R
+R5M+ScdlPruJ9r0iuGO8r4RLvQ00Gr4S4l6+OR+VvQ5Ufj5/vJY+0QrNvU4qzL3YDF8+1oqWvGJ3
+3b6plx+/jYyFPg66QD4uA6G9Z4PJu/qhMj4daJY+mjAQPsmYx76rBqc+OLYXO+MXjj23uxG+zh0Z
+vqdplL3Czh29GdczvkLTgD6kj4I9/xUKPmeZwD3vMYw9fzOEvpkmkb5qeo2+wRLXvYxrkD5oI1E+
+nHuGPRvH8TwHDkW9I13pvZkSBr9NoYg9kyZiPDhdET4+3C0+EPjePYOYGT2BZo+97ho1PmuiCD5u
+28I9eFCSvUMMxz09VlC8cR5/vv2tI77ubH0+YXHpPXlF3j2KoKG+T7MEO0xJQj6jCBs9VOOSvlQi
+272gbzk+/WqKvsfcxr5HYCk+ktCzPov6Yz6lx7o8CtYRvldQrL6uJBy+AtlIveoaW73zwk0+30i2
+PoZeZD5BdF09Nfd3vge3irw+Zwg+IMszvVY+MTyaaCa+u20mvgfoPb3c43e9/wK5PUgGKT7X8AM8
+rqLtvdnImb79xBS/QECsvmW8qj1KLLE+F0VRPsmnNz4sUL++Am+SvneZb76exfy+oDYLv7h8zb2V
+suk98nC6PIKGC76VjiQ+9519PuIEUT6HBCG/KZ9GvTBJBT7A4jE9dMpKvXcxmz6FWFc+kSOePd3+
+Eb9XwTm7yGfpPR2Spr0+UT882khUPnCuDz4Vbcy8RV3bvcz29j2yRN89n2xTPRiKFj6stB0+qAO1
+PTNXkD35TY2+qiEPvn2Wn73kbHy+CRj0PdVYp71M6JC9l7K+vOAzx75dW+q8VRjPOgqxBr4byBG+
+YBArvXBDAT1il0m92B5lvqipPb54+Pq99vSGvcqsrD0svmK+s7qMvk4t5L4YKGm+Q5kXvdFvzbvX
+pwy9LmhyPgLhPD1UGni+59vVvtiHHb1kxXK968sbPnavBz6u3iA9gQSCPRyXbz243wi8s817vY0I
+LL5ah8K+jY62vbOAwD0wCbU91A1TPb4vir76jBK+M6ffvryror4GpPi9qGUYPnFdsj5KpKi9iyow
+vsLTvr4v0uG+tm2HvgmKJjwWO0M+3EqHPuHLRT7Q4Yu+DcEpvn0Kbb3gu+a9glwnvpaEhz2i1io+
+aF+2Pd7Hx7yRTzy+75xOvjxrCb7V7cy98K0jvRLfjz29zSc+YEtmPXIBKz1/h/I9pFmGvikuN76x
+Wg89Q4UqPT3UDr6TGlu8fmQPPif0Wb5PVUe9asmlPAf5Er7m+9u9EdyIvkWTYL4A7vc8a7vSPSPm
+o729azE+xIeAPlJ+oj4+ZC89xsdBvh5t/z0mnTE+gbMtPda2Vz57IJk9UvUqPsSjd759yaW+E/8S
+PREQLT5l6w0+FGzkPbfEozzA7Ca+uxgJvn6QCr7ItkU+kd4cPlIEij1HFAw+mFdVPmwUsD7URNM9
+NIq+PXghYz5n9Yo+0sG0PTq0RT4RxRQ/SAvUPvocYD52Ze09w1c1Pljuzj1cEj0+g1RvPo4p9Lzr
+AnM9j0EcPpaUij1OL7Q+Tc+CvEuYQ77U5be9keMPvo0fJb7Jko69d+TYvRo73DxbYeC8fUBYvkO3
+o77xSM2+kMObvgts0L7LjaS+nbmxvlWQuz22z48+8FOiPTrtGj5G84k+aq6nPh2+dD7eHMu+m6ua
+ukI6kD6vqeo9zWZAPlZBiT56W1q9mmcTvbRkJL8UbWe+9kThPfuVh7wIAy499JqIPtoqzD007AE9
+Hb6zvoQteb4fzJ28ST1WPh5igr2pDkg9is4XvAxz+bt50Zu+WayDvhyegztkqxq8yWYLvWJHKb7d
+uPm9gPXYPU/hB78Irt6+Mg1kvjq0er0IUTA88f2HvcbFBL6PmBW+PjMAv4aLA7+sYMG+X+57vU3Q
+wz2o9+g9Wm2QvuAcVr4JvAe/lsjWvmdhxL63QKK7PiPYur2vGzpt51y+//Q0vs2ZPD+utZU+o/c/
+vk792b06k6q+uVlEPB4buz1L0YM+JyIcPz7oWz75VmM90VzsvWFqrr0Uagg+jjS5PZYYOz5bvU89
+0rMnPVjYjb3vATW+aywMvgfMWDxz4Xa9vBhAu8tUUb50MHS8hZZDvWvtcL50xxq+FNN9vSfXoTwb
+VNi9JSQKOyTHsD0zgDe+ljOfvvFHrb5hjr49S15HPtqL472MBrI9/shXPrA7NT6a/r29yGl5vpFo
+zD2oV+U8dzqLPpyfvz2bYho+fa1zPkGVpb61wDu+r3cbvhrZgj63gNg+eMmEvdyRHT4wudg8qULU
+vpABxb32JyC9TpNjO3iZYD/lF/8+rtjfPlzXgj7JAvq9peSQPSWO2z0nIdM7Ch4WPkJkiT5que4+
+RhGZPlB2ST0uflo+v22NPlqwgz7eKy0+TbeXPtZf5LyFOu69XPsCPKtMmj7IV10+rgyyPWHjRD0Z
+Z188Ri6svlyxUr6F9MW8N1rAPoAmlD7huW48j9g8vQznsT269NO95RofvpaTr72jKB4+1vTRu9qb
+0r2YHMa9e+aOvdNerjy0Qs+9W6kXvr1dO75jD7q8LMuHvKoxWr7TG2y9EKDUvT//OL63cmA8M0yJ
+vUCmqr2opSO9IswQvc4wm71koCe+n7cGvp9/uL1JUiG+LpQTvQ2fIj3joCk93QOUvQJTfb26t4E+
+X8SsvI1HAL1fli2+qz2fPebAhz6XL7u+eXljvSWZKz6/DcM9alfOvDThkD3FhE0+F5nLPN9Z7L54
+qim+IoU8PZv5JrvdAIG9Py3rvbXqKb4sPYE9d1MUv9HVwr7ynZO+1zMPPqVlkD4osp89pC2fvm48
+zTxO9Ta+fdCxvi0y3r3ldZQ9rNytPlVrrD1pyA2/4IkuvsW6M74CzWK+QDG7vS2ikb6CHWI+Hnqf
+vm7Etr6ZzJm+16ULvkPayr3iPu08jII0ven6y73kAji+qDo4vkSCxr5XvvQ9Fc+iPX46Iz4YRV+9
+385FvVsOWj0Y8bc9t5wJv4OFGb3D7J0+pBFUPjQGxTwKTkk9bEtRvYLCvzvSNsG8MpLEPeVRrD6z
+whI+0s8fPambPr1Rz4s7M0tfvrOYBrxQMD09eCxePqXuRD7iCuM8dlbNvaTzGL5mjFO+moKpvUC/
+gb6tKcI7aYgPPUHsfD6E41s+eN/fvaabsb0P/Ii7oCA8vlpZhb5jPla9SIeLPtKugT7EQWc+3ThC
+PkdCBz6dkAE+HcdFvgmHEz2LgxA9YblCPmgSGj0vTjY+nhCyvnhkbz4Gu0Q+AqAevbT4V735Kq+9
+cL+pvTvaNj40s9q+2XVlPtd0qD48TUY+FQW8vacZ0715oWw9EKlePazthL7Mo+W+H2ytvralar7K
+VwK///owvxkgFb+zjgi+I3bnvILc9z7A0pY+fdlbPWgiaj06lWG+WfoUvmXtRL0LAYm++OHZPgyr
+vj7kPgu9lHQpPnMCKzzFvC0+W2GGu1xnCj4Nr04+W16LPWvIg72TwPo9J7nMPivnQD7TO3M+z+2Y
+PraEPD3cqGa9A5NKvhqcmL6U1/y8gwMFPkOq6ztbxDE+YusVvlYPar7Bq6K+xhVCvmioO76efAu+
+ICyJvsWJCL1RNwY9iNQPvm6uy70W+Ce9OCKHvOIJVb707lG+wtvXPYuphr4ra5k+bYZqPvYlRj5I
+D7q9raQivjIzHT3KBRs9miHbPLtdrz78bZo+23N9PjvKYb4YJaa+DCe0vXfDAL3rLoI+WM+wPr8n
+1z5720k+JQmivpgX2L60UVa+ebOTvgwioT0gkWk+H3DHPtteN73JlcC+D4fdve/iAL7f5nu+1o6O
+PdymIj6svkQ9f6WGPWhKoz72Kzc+UXtyPvCaur1eNC292pByvrmn9D2TnCE+iJ3HPWwUTz6Al3c9
+OGK4PWnp1z7DsSA+s78FPhRu073sKvi91APWvWlY6r2lkBY+T4agPbsGAT65ASA+sMo6vvsBvr5o
+IoK+4+CVvjigHT1BCbs95JNkPuXokT2n7k6+a9GCvs4SiL59xXC+3j0tPWkp6z4+M4G88llzPanX
+oL0bOtk98cPAvbMU0b23BLY6EQK3P+n0nT5paP89ZTA7Pvqfkj2rLFy9NeNGvOqeET4ER9o/bnMr
+P3Z/JT7E4+Q9xbM7Pl1jsT2epfe8oBGrPAl+/T9P6U8/eHHMPAWveb6bBxK+eMc8PtuTrL0Se5u+
+u6V/P+xp0D7wYOC9Tz3LvuBCUr5qOLW92YGKvZHjqL4/VaQ+92m6Pv5aRD1KW+a90lpRvrHxib2+
+Z4E+jtaZPk81mj3wusy8+UO1vbqdI72VM2e+uAjnvetHHT6Vc0Q+ldjsPQicgT4Me4E9Mh7pvVP+
+kL2Sw+Q8rE4UvZjxR7wSToy+EoQ/vGcZu7w5lPI9K6uSPnx6Ez2aE2W9SNrIvbnGDD3jqT274Qhd
+PriGbT7e7is+Cz3OPYlrwT2zWkQ+l1quPnzZDT7mxgM+LXX7PTHztD2GBK28i+vgPXidXD5bFuQ9
+YMChvobjzr0oqRs5XaoKvlwddDzAnNQ7mm5JPqyHgr7COkW+sXaNvmUBcj01hgi+BGGBvhg+JL5N
+4Hm9bO/XPAEu4jvwaau9w5mgvfo82b2Y7wS+Gu5Uvp41D70AbOk89rhGPSuGQz3HbiS+mXJ5vZwp
+pzymRxs+0UWTPu56Pz0L7de9FiZHvq4ZAb71qgy+Ld7yPBoOrD6aZPM+VcPgvHzbpbzUX7w7xdtJ
+viAlWj2k3DO9+4XkPK4wPL2TxUm9AH8JPhxH1L3cdGy93a5ePd8tjjxzStC980PIPbgTYTqaWM28
+QiqPPSKc4T2qeMy94LCxPIDeMjxy4io9ax6nvemoCL4BQ3+9QpOcvfFdwD2Buey8edyhvCz4wr12
+MCG8kiB5vVx2or3MLCW+A+alPQy8Cj5v+zm9W8ViuygBOL3nnhq9Vwb7vZavLL6RBbG9zs6YPZ+M
++r1dhG2+WCl/O+Vgsz0nAEC9uXpVvDlF9L05WQO9DQ5wvR1RpD30e4i87gLKu+TB8DtnYsI9oujP
+PWgFFb2wNT+9uMOcPeZ96z3aMFU74pievOBWyjxGls+9IbQzvuDv/jy638I91X38vXwB1jpdyxC+
+g7UEPj1OPL0P+6S9iU50u29C671+9iS8lWnUPFJkwT3X44s9PeQhPtudmj4A1ua8zNZUvYQgDz6V
+k8y9tHXWvUOxfb32WSs9BzLoPKK/nr2E2iG+YOQFvvxtV7zXoi+8S04MvTfrmL1hzck7DWrNvUas
+tr2LNsI9kb4MvRfxuL3gSpk8pVBXvhS39Lw/SJ29bDoRvuJwzT3hrDK9ywWIvWoY4LxOOcK8fzsD
+vhmU9L1E2kS9D0h/vfu0uru3a0o+v+ESvj9lB70u/1O9S3KSPBbigb3NbPE92Wa4PFHFUL7joa69
+EBE7PZILar7IuyW+taTIPGTrRr3XPFq7JHQ2vkfeAT0yoAs9rDyBPeCeIz7oA2U87fNwveIRtz2I
+K8i90aaEPBNyoL1ZRpw98EHSu+d2BbplbAy+0xSwPI3jBL44io+9/IkoO8IGFr6gfMU93oUIvXV7
+lL3PpaC8nlbsPci9Mz3+qMg9Ddh0PWFXFT1t+5u8aIELPvt1Lr3HszO9jvHRvQXCBL65Q7S8X6jz
+vaGxxz2D6f69wna6O4flzb1TW1I9PMyFvbjTIL72Xqq9jrCpPZmRIL7LKlE9R72yPc+Jdb4ZvJo9
+ilAePR7eP73nGzs9GU8HPgBbTj3MxrG90+LLPXQqKD1TEhI+XFDqvNYP0LzAl708CyEOve+uOr6E
+rPq9O2wXPQjubb5MEwQ+ZVFVPctwFj7BxXw9wEUUvcACsz0u/QM+onAivs+XBD1MQBi9s8DVPUKe
+3r2pH1w9L6yTvZARjrx3so+8Slq/PdujJj3spdm9oBWNvdhU6bs+nxG9nxmPPRoUgj2pYTk99Vwq
+u9k7Wr5A7T++WcQqvq38lTwSIbw9VIsAvua1Dr32Iwm+qIyBPOpPRb2/Vm460p/lPLXmCD1QQy2+
+dCuCPbWWI7wuQB481VJuPU/tJL4mnMK9bNr1vTR8Kr0q3Ro9YymUvUZ5tr1uRZm+UV+QvoUcob3v
+f/k8LQ2pvsd4YD1nic89WlkJPtjNRb4bUQ+++HRyvpLrW77hRZC9npEtPDt/+z0KLlQ9eWxaPGBq
+vr0cghK9NqFGvsjhhLyAsV4+bD02PkccP73SuV0+lGAhPqDEPL0cYPU9r32hPvFMjz6qxao7VUSe
+PHPxQD7aSqs+mW6tvWQlZb4VnMQ95O9GPYEBCj5mm6e9mYJAPuL9BT785ei88WmOvKghIz2TZ/Q9
+qLfQvKzM2r0S6ZQ9XYxsPuwD4T2p5k4+TY0kPYxNUz6OUXq+/aOLPhuzsz2LObg+lEcfPqt5Vz41
++eU8EhVoPvrBVT5ESFa++JW3vk3kA7/AQmy+GnNCvQzVfj0h67m9Uz5xPrvFqr6EAAK/8QGnvsmP
+J77G/IW9iM7BvU+TRr3qXF0+brSSvr6qzr4kRJ69mUK8PfAlwDx9swS9CvxFPfte6z3V6i++afgh
+voLDA70UyiM9WZ3dPW6umT6HqVU+fJJaPFVrer6yaoO9p3OpvgjVOL7DXmM+Ep6LPiD0Fr0b0cY9
+j0WUvhiF3b2E8JK+CWaovr8FFD5+YAw+d/eUvaN2dL4lME09loRHvWEbyL0rw8s6KvBJPX2oQj2h
+whq+1vsyvo0xUD4Qtpi9/LpLPgI5x7wh5Wo9mtsevZvKrr6dXIK+8WSSPrg6rTySU7i88bCMvoED
+gb7So7W9bKd+vre2aL4kdAw/BSmlPfWIqTyFMI49NE/lvV7gAr13fg6+yfzlvZcLTT+k3Zo+X5Ez
+Pmtl4z2TEAi9mHC1veXaYzuxu3a+FVczPxVe2j5k9Eo+SXB5PttoVj6cDoc+/xzQvbpGa79JfcY8
+sBfHPECuNT4P8Iw9Bx4BPfih1D3oPEm8+3A3v6sa0j0tUQY+HeKbPS+aoLtaptm9KkNEvoH9ULyD
+Cqw9pZk/Pr89rLy3hs+8NyoFPfIjmz3hwoy+gTqIvQ8fjr7mdSU9uSwAvn3zCD39Qme+aaLkvNp5
+Yr7+S8O9elO3vsGRzT49Sj8+q6hmvQY46L1kmNi+dZhFvofViD3iSHa+xaqlPgCtdz0WCmI9AfqF
+vDWhNTyj5F++hOq0vdIbND7Fny4+EmK+Pql2oj7Jfew+fpG2PpeqCj2SJ769PjrJvH39Pz40WyM+
+lRpCPo814D5GQ6I+QltWPnPnzzxhpAe9iIm2vss8Jr1ODSy9gjQ/Ps/DLD3GijO+LXKWvqjYur6t
+YI++94NqvnDVZLwivRm+clzrvrdmLb8IuyC/kRI9v1vWUr4bSMq+HjArvvhZTr6s/r6+S8o/v7oj
+Jb8of7W+KURSvnWNnL4sCje+RIX8vZSc4r0bdcm+3mYKvz0awr5nltc9k8xpPu6Brj6dlQo+Va8k
+PsRnpj4Dmq8+zF1yPmLA2jyDppQ+Aw5yPdt4eb7Hm/w95pfQPvZbeT4mxpQ96Wz8vTwRb70fu8q9
+PyMIvhQRGD4zaqI9OPHNPcYVU77NFfe90R0LvoTJib5ffrm9SEqWPUw0Wz4tXmy98gnUvXmNzL0f
+0Ua9X61Rveg1B74P3oy93c5yPfUe9D0pu/O95Fntvc0l5bwiyz69sTUuvs3TE74Osqa9kP80PtW8
+/j1NJ0O9/M4FPNJyKT27uwS9cG4CPg284z1Vvrw+21qxPiT6972o71M+yGVaPmdtQD4LFV4+VCYE
+P2+2/j6a/8w+QUnPPn+SxT51QN89QS9NvnXfgb0KsOy8YReYvXDcrr7HosU9bmwoPmH+rz2WExG9
+d1QLPm86JD7sIKc9F4uqvBX1Dr6J32o9kIogPeLNb76SsSg+FgmEPlf0WT4JRdo9iAUIvtKnGj0y
+lS8+pNG+PWCwGD5a92E+qj0gvu5Mir4EoQM+zOFQPrutxj2PUrw97XihPUcBDb6DGNa+5SmIvtuw
+U7x7moU9Db+Yu3Sjzb0EJle+9XCdvmXIxL5OsSa+cQpIvttCir0vsEU+b5SLvUVmmL7lTZu+Ng9e
+vVNmDj4zgam+23XZvSsXor1NNiG+vfPLvq27iL7xQJu9J/TEPovrgD6JpMY+Rkw4PptEmDsacJM9
+RSCEvcVFAT47MG8+0OMzOwOZlj4T2rq8Ys/lPVY5/zvBGdu8hFoQvTCe0D6nIKO+ysz+vUqo+T0d
+7T89Nnt8vpjrTL1r+V8+rAVzPsD1Gz6iYRY+tfgbPcGmmjyyVIG8p9ZNPnppwz7SgKo+Tob2PfEu
+mj53NFM9JwsXPLbk4r0QSsM9so2APsuIQD5oBsy8/pYUPindkD4FYHg7QWuLvZxokbw7IZs9WWAF
+voQ8VzzgbD4+HtREPQ+eHbxkLjm+m6WIPlZQ1z6bsOo9lQBvPaMpdj4AMcc9ezm5vioow71TE5w+
+6K8EP90pNz7M/v6+qMRZvvzDyD6Sa1E+6zvSPYRxGL7tjpu+PEpRviEZNb4EfeE8lnGIPiofiz4r
+YBY+U8mTveMEdL5mOC2+OjxGPEhWpDz3aTw+8clGPsi6RT3zUQO8yzkDvjb4sb4HgvE9xhZ4PhL5
+Sj5m0hs7XS2RPboOBzyTPIE+kkoLvZXANjzwvIU9TQ6vPWnE4D07MXS+8R15Po4Qpj5kSXw+UMMr
+vnP5tL1/hpS92EHqPVCfjTzYtWo9CqOPPnOEeT5DZJC+SuOpvcKzmL3ZR9U9XC3CPuEbYz58HKK+
+zslSvpItu72sOZO+Hn4zvjywY73wEgA+b5e2Pmb02b764xG/LP7LPjZFkj7waFk+cyMIvV7YUr3M
+4ZA+OKzXvTo8NL4vJiQ/WfcCPzcJOD4APT6+8na1PeS1Q715BiC+Mjx7vdGJgD5M+dc+a2fvPNh6
+T75Mm+q9N+IlvoghU77/2zS+xn+qvjD6dr4oPze+zoaevoXdeL2zzgG9blvSOhuxTL035we/3n0O
+v0yHOL73eOu8ADowPlTsmz0JUIa96mccvg7D3r6yK6C+MsXcvV+t972mUGo+MNq1PGVBXL1graC9
+4fNgvViziD3eS5Y96r8avkUgMz1SkS49GdtcvrFuGr4AaZY9FSCgPTf7cz1A4Jo973SkvLMGeT1y
+QfY9NiNWvity77xY9q4+RwYkP3GQ5D4s2Kw9Lx3XPXYBcT5VK3K+C/4SPl/d7T7r7Ak/YNPyPgA0
+iD4ZOS474h3KPbabqj208Ye95m5ZPnzm2D7PJwo++o6EPVPW1r0N2Qo+e2mFPb+bQ76TwGS9px4S
+vkr9wr175FS+kVY/vp7oHL6bSDG9tGRqvj04rT2tgLo9XrTdvGyQxj1ql8m+79N0vtUMHb5NWbA+
+1uoIPRzilj4iJig9Bc8Xvk+7Gr6S4Tq+2eBuvhl0wryu8T89hno2PdGweD4/CSe9uAssvinw170X
+LoW+RT12vumF87xuK8A84XDavOs3cjviAkW9Xv8hvj4jp70NmtA7SwnXvXyIFD5F/l8+QDDUPZoi
+CzydHb89oCvQPkVDgj7C2Lg7CKgtvfqyTj43VaM+n8RwPkolcD61nd8+3mgRPlhldD267Zo9EFTI
+Pa1DmD49ZNs9FUm8PgwW0j6recc+7OA6PhhAKj0dyRc97E4iPpbBvj5+mck+RGxMvvvGzD6UY4Y+
+AtbcPUzDZDz8zL29RZutPi7DaD7OGZy+LZ8FvonEvL3JGw29JwipvY2l4L1JHfY94KEpvW6oML4N
+9rm+Kl0CPP/4fD46ERE+cv2TvTLWlT5n5B68i35RvW7NIr4FR5q8uCSWPm+foj4kkSS9qdYyPWGe
+zbvOYxW+6jdnPpij9L01fki+HRO3vIpVHj6hARK+V2kyvvvT8L5dvIY+8zxSPnD2RbzuSgc+S0dx
+viKslb5Gohq++RuiviMJ4D58bOY+uWGlPVh4dz5ppuO9mEEbvmZ4RL6xIV29WlhVPuPzPj4ejFI+
+JdpqPT+5mz1PbsO9A9jdvXvnQrwNhp++zB8+vjfIlr5sicG9XFX8vWGwDb4HGSa9E8W7vDx4CL51
+64m8jXhaPLTzYr0LwEK9s9PoPPP0gL0rYZ++gBofPhmeLj43HUU+O14YPon8rr0YJSy8hpPdu6u6
+2L6aexY+GRf9Pq7mDD8oM58+s2O8PKoGor6yWKm+RUP9vldmpjwBXVQ9YvdAvZyVMb1UDdW9EMa3
+Ppkwvz70aRe+Dxx7vdaOdD5bYkk+X6UGvgLJeT2aIpI+XKtZPhuteb1J2qm9tBWxPtjArz5S64y8
+NbnCPertmD4Z7II+i9RGvUkTw70xiwk+P35YPts9Ir7uSU69sEllPmu3XLyM8qi+4Qdcvq+nf72n
+WrO9N99BvTv52Lsk/9A+yVZBPZCck77NUMu9YyqlvbgrR779+FW+e8dYPXub2j1cAt09+s+Wvnoc
+mb4kh6S+ua+NvvkQZb11foU9OHChPsjz+D2IGgA+ifFsvqtIKr7x/Ru+CR6XPeuw3j3YCb89T5EL
+vOpf3z6F9Tm+E1ZsPbRGmb5uEO2+mxuyvjt8Wz2rMCq+cEVpvhMRIb0Tjoe9ro3GvjfOUb53S5u+
+sk+hvQl7K764YEW+W4Z1vjHMwDyivyy+xSeRvh8V4b1biCU+tghavXTm6r6UBYC+dCyYvazoyr1K
+RZa9V5uPPp56XT0OqDC+t/2Ivin4pb7fAWy+mZaEPUXLrz3mJgU+M1RgPZWqBT35opQ8LWbOvukn
+or65TMA8MTGtO8vmoT3FAgG+Y28RvrkTBb470Aq/88DDvoZbSz1M3o4+gOIyPrnERz3raiq+JRFe
+vLeS8b6I9em+Z3invQlYvz0aBO48nuUkPoc1071fLKQ8Chk4voNMDL5NZPE9yZWkvYomWL2pay0+
+sFiLvEXLPb6NEbO+FZTPveQFdrwkqhi+gqBqvN0dTjyudzO+A5Fwvj3Pjr4THYW+cqA+PS5ak720
+7CQ+25BBvgFJTb7A+4O+itQZvo4aUj5XkxM/trTLPv16hj5G59A9KrUlvUaZmD5IZjs+Y3c3P2G+
+Hj/aICw/BMviPieKg7wpuTO+FhCwPu+M9jtsgAY+Ik85PayMAj7B8F29q9VevhsiDr/Gyua93IO8
+vtHz5b2/Jlm+cRmvvHItCb7oaiO9o3GEvtnDfr4LQ6++3w6PvksZR73We/s8lGgQPhQ6Eb2hSmq8
+iQygvvf1lb7hg4G8J3I3Pjif571H5rm+nbdjvudIXb5q5VI8M8RgvoUW8rvip1i+WeSKvvy70r4j
+GTi+8Y5UvvrZCL6jPoq+jyyPvYzxqzw+7+y9GYF5vqbHlL5x1Ke+lCUBv9A4FLzVIFk+0Mn+PqiB
+TT4Wu+08mTm2PD6Web653Hq+fgzLPdtleT75h7o+3ysSPiL98D0hZaw9ZKAgvSSAqb77n2a9+Dhe
+vbV+DT44FS6+Kjafvf7/y738XVW+tCQaPnYQDb4W0Hi++BYrvhw9N70dZbK+YYHBvr6xab5N8p49
+v0ZhvpG1Br0/cAi+8dhVvRhYSL61OLu+BPaIvijWhb2YM5S+CtwFv+i/4r50fi++QobVvbO6672l
+6zy9TNCVPYvhTD7PuYS+Ab3EvhQnSr45Vrw95BZMPq6xyr2pGOY748qVPjdTCLy8Fvq9qc8pO0WD
+rj2Exg0+KKZePZT/+73DYJg+TMp+PqxOx7046QC+Pj6rvT4I2b3NujU+02DNvaKAzTyK/0M+Jppe
+PuA7trwRAEc9+i3oPRXN7L39whY9x53rPdb/Yj4n/jw+98+0OGQj1D21lFg9sWQtvr0fs779Z4s+
+cTqVPphmXD77LIg+SFAmvRc9+D2Rra09jLGPvR1Ygz5++gk+GQoVPi59VjxMwQc+HiCEPli5hT7o
+CgI7pvqkvskaqb2zylq9KdKivc4ADL2uXcq76woSvCBDtT0AgaG+12w9vn+fpjx27OI9HB4ZPcy8
+jr0oNUg9EZnYPfV84b2cubO8TYOyPB8SEz4f1Dm+4RelvaOgCT14o1Q+/1zivT1ujD2JJ6o+HyH5
+vY4kmr16EPA92lGaPrtbCz7rNqI90WzgPp1o1Tz11uW9gPesvbpeQztmj1W9//0yvtqj072jYgk+
+VvfRu9/JML6l2Y++e42HvoBeRb7ub3e+DrETvur3uj1Ysig+xEjkvVUGzzzpTGa+TjaIvhK6fL6W
+DAi+XMjsPNX7PD0NS3Y9OYUku8rZ9b3BHcW9WNkMvgpXJzt2Fba9n9dCPMbRy76/2l++g2EZvqUn
+6jyC+0u+ZMkMPsSibbwEu7Q8b2yWvWygjr2XnUi8mjEVPssmLT0rSUw/mbfMPv0jWz5mT2k+UGUM
+PXGOBj4h17o+HJ4xPqi4aj/pQxo/ZxLYPlT6xD60ZcM+8PnOPv8lwD6fnWk+zSDtPt6zlD65wgg+
+GLwSvCB9jj6oz5g+nXmpPmGcNz4xxXs9pMXXPQ3Uxb36NwS+xl3vOwq1pz0zbQU93k4xPngXlr67
+n4g9XdeNPouLX75/jTK9P+yTvb2h+j2sC6s9xr4VvsY9Oj77R0Q+I0frvU7hf72uN0g+keMNPhjP
+Ir58fzE+cfM2vicDKr7XRlC+y2WqvAiOKr0V0z6+B6R4vgLdCL4cBGO+uimZvlHoOL5LYBc8Cmp9
+vU7cr77kO4m+7CTRPe+smb2/Kdy9y7rhPW3CFj4x/r68RVpuvrbmrb0y5PU9x1z/PblICz5XI4+9
+Lva4vdMmTr6bBMw9uk5PvgBNpLxbir09MzNbPXE6/L0PWVG+vDL3vR+qDb6mnX++KW30ve5Q+7si
+djE+xPzKPXG6Qz6RW/g9tRNhPkyuWz2OXe69KnsBPnUxGD2rmk0+pPyPPriqRT0th6k+4yxyPr8c
+JL0p7zK9ENvDPW/JsT7IGzo+jeE8vWSSjj7Ga/g9Av8iPk8Fdj73lh4+vDMdPgJcmT5vAAM/nEz9
+PiWPCj598tG6NiwiPrYWUT6k3Hw9GnMbPgOb9D4wHIg+7Z4Cvikdc70UywA+towJPQ1vr704xIo9
+5cWaPrxPgz32moC+XKKDvJ6wZj7Yezo+irAnvRnb471JBoU+SOULPlAko73xTGa9OoFAPuCSJT21
+TEk9QBMLPmYHRD7ZCkA+Q3fNvQh7f754kQ4+5FYmvrDwLb1y/6e+d3pfPk7cTT403Eo+kKYJvr51
+tbx654a9rH2ovj7kUr43twm+33MsPk7zij6QDZA91MVkPtl99T2Vsne+QEGPvl6wHb6bdc88OHzM
+PVhKUD6rrKU90xfdPbl1gL1biE49s+fmPibHAz6qlmy+B90pvH8PYb4HQLa9knnjvX7zCT0pxcM+
+XFe1Pi7r8rzcLOW9R8MgvFcmTb3Erca9v0MDPnjXfT2Z3Do+Hb5FvSRnAj5oOG48uG0rPlJrbD5h
+Go895JfhvQ7xRb7Gtoe+7QoXPvGAcj6RAMw+9CKDPpP1WLwsBJq+ZDC8viPhP769JQA9Ca62vMHb
+mjqTHcO+Bxm4vgomwr0FjbS9kfstvgleMT4YhX++S9h7vuTBEL+LU62+rLMbvkGwzT2+0PI9XWi1
+PUYpYb4U7Di+iTkrv4Pkfb5o1EC+FZMJPdP3aT7bTQ++ig2JvV0Xqj2a9h6+oO0qvmwrDL41Bg+9
+ZJdOPnMe074v6n+8jfG6vM+akT1shqG9cUxfvrL54jwNWKw8bDIsvwtqtr6dnXe+wS1QvgAttb4d
+X/C+JVTfvhgE571FH3e/hgiGv57zHL/i0tu+LHb3vuDNP7+Bfja/tHAzv9qnT79a1Zi+zsqrvhMN
+D792va6+yfDGvl0PFr7gOh6/UZ8Kvw02676e+BC/lqcQv3s/ob5Ny3S9OEpXPuHvS767I9i9Sxun
+vkbPCb86Fn2+nQdtvVQmYT0AKaS9t4DfPYFmCL2A4BO/BFubvkftVr4PVe29kipyPaq47b2jWo6+
+KYNAvSWMvb3AMxQ+X2rVvcyphD5rMpA99DZbvZu74D66dGO+zJTJPcze3b1oTFC8L1I7Phohlb3u
+NUs94/oEvRSjJb5Ymvy8l5r2vWsF0TwLi3E9ZC52PFD2nz0kntq98Y20PihYJz4y3Ts+ztqmPNzc
+gD11gnM+3kwWPujcij6r04s+EKK4Pm/9kD7/v+e8+YmzPWM4gT0wwcA9S7BBPqp+lr1vgfK7KOcE
+Pu+HhT4Uvb89/NAsvrcPqb4CIqG9TWqkvjrrLb4JBGo9yNOxvVwgJz4K9J6+wvWHvkdEsL36v7K+
+XZuovbVQ170RGZu940n/vfsKgL5krH2+UucRvduHXz4bIzA+31KwvQqd/70PiDu9hcr/vD3zL75m
+TlO+ryXXPaGzfD6Trb09CASsPeGkLL7I3/67Rt8ivHaAjD0qvxC9AI3zPISr5T2jiDE9CuU3u1rK
+ib0ttDA+LKYcvj/vD7+P0Ge+AsGEPL4MP76AVE29onwPPRK0ST7gGr29E7TUvlUbSL0YtV09vnKf
+vWeyNr58fMM9oVkhPWA1KL5eX1m+G4zuPZzr4z2dO9E8vG18vUA4AT4KnuS86DJivvtVRL3nBCY+
+Wjf0PQMToz18TnY8+7Q1PkJITD7uIIc9VoM9vjURNL4FsaG8Wdl+PfGFtzywxjE+vgD9vTdhO757
+lXk9fJYJPkZTEb0jtq08QRuDvUWm4L7jvsy+tWogvWx+Bz4HC8C9wPwcPgCMJz4oUOS90+D+vnyl
+2r51KzM7vOavvW36hr0IBgu+t0wRvnUm7L3OH2G+9cgSvlnuAD6BlIg+BvQGPt3ERr2Bbg6+zqGh
+PfKU7T1eUpY7LAU9Puxfzj56fes+FenqPlEtaT4rTuA9txlovYiKvDy6DZq8ArPbPskZKj7Zf7k9
+BPFuPpQUnz23tY29bOgyvgeoE74UPSk+5jbdPfHtTL05D5s9mL7dPSg8GL5Rqpy+jDGrvZwbAT5P
+5Ai+AA6SvvsW373uZps+OarhPbu4hrxhlrS8cSK6vdtpMj6BpZW9Abc+vcEpPb42qzC+28EOvnfn
+77zkdF++QnEDvoLc0rwsRKe9c+G3vVksFj1c6AM+e2MaPudgOL7p/ZK+nvWiva0tUz76cJ09w3ug
+PiS60j52rn0+EsimvfU0SL6D4TA+me7xPs8k4z4wlqI+KK9KPteHEb5YdCk73qIxvisuVj5IsYM+
+niqkPnNnGD7Wgjs9mvNbvcLPd70Ho7y+n5WEvvOPqjxh0ue9D33wvS6/wT5XveQ+zmdiPIYOA74+
+aGi+B5y+vTfAAz6UWE29NbEePg65pT7XWuU9YMgcvsL7Hz0x4LI8gLYivZgjBj1uqRY9Y3ftPjUr
+BT6yQm++3kgLvwGUfbwx1RY+lIp7PF2Thr7VNmm+poaePtrw+72M8ra+4RSDvTQliD62VgI+0S5M
+vev7vzvUZKc+xd1CPpkZmj0RnJ89X3VIPnoKHz23vUw+7JjmPGIUrz7XOwk/ZAPBPvGWID7KY2W9
+jcMAPvkn7ry2DAS+PVO+vfk8vz39s8i993bqPCuasb2b6H2+xnP4Pcy4BT5P15a+Q+s/viPeErxy
+M8k93zhbvRbwSb6iKiG+ZmsmPpGX9zzQ1O29BVqZvFxcQz4+KsW9R54YvkATBr5+DQm9Z3iDPPIA
+BDy3o28+QjVTPmoD4buL5p++q40GvnAJKL2ld+W+6n93PQ7qmj49u4+95dVUPrPy8z5xfwE/ANmL
+PY9Job59K2M+pJALPiNwhT3za14+6qOYPgeUdz51YWQ+Kfg3vWKV1T31UWs+fXpQPZDqQj3ysjQ+
+x+g9Pn4oGT4FeB++5eKYvWtQwD3d73w9E05jvO0LNL0DXv88nKRvPtIlFr64dVE+3biAPvLT7zxj
+Iti94z2hvMlkXb2X2WA7knDhudgp1z0C1pM+hfWQPRrEmr6dxUS+Rt8uPGrqH75rQNM97FIOPsdh
+Ej6fj9y9hJ6XvusFKb5rt4E9rIAivqMdhT5M0yU+beDhPWrQd77KtMK+fkiNvpaZkL4y3HS+kUnu
+PXjGXb6dXya+9XCCva+btT3T3rk90+rIvZm63b7fRSq7hy2YvuQd5r4jVJ++OZCjPWGTeb0wMqi8
+LfvQvjWFpL5DWhS/lC3wvmu2Ar87ETq+ls+VPrEO3zyAIIc9ZQufvklvrr6i2V6+YQKgvh6TRL4e
+V9Q9MjTZPdhgQj0RcU49E7jtuyuGgL6FJ4e+BHBpvZxYEb7gNN88JuezPLtFqT51kf89WafePWF8
+qD0/Sxw+Xu0/PgS9WL04o7g9FIjwPt/bOjxpMk4+tCsCvWfvsLkP5Vk+nOY9PuwQJ70FjZY+hBNm
+PUgEoT02qRy9kLpXPX2QgD5A9ac9tS9fPLoYdz6vvpI+M4k7uyBRxzgY2mI+1B3XPXBWH75FqlO+
+yvi7Pi7QTT4/bSc+23TPvccj6DxJTJQ9/HNRvFWJJL6IcQg/HLm4Pmbz3z3xGT69DN1EvjQC3b02
+Ihe84j1tvpwk7z5wMuA+WbWVPkaoDD4bwCm8LsVYva0U9D3Wmfe8DAB4Pk/82j6UfJc+4NVmPnRq
+eD7YlR4+t3eYPv7HMD1VefA+KHCBPuLdKj4S6j4+agi2Pb/ZAj4TNJY+fy56PtgFqD6py6Q+/e/f
+PTchrj2iADI+LYruPS82MD7HJ9y9sKGFPq3oeT71dLo93567PU0tFb2VPH0+LkNVvVBsjL2uet6+
+IHadPrQ9Fj/Ri4w+vCFKO3KZWj5/Vbc+nvLcPbeCKL5RctA9C2+ePiRJgz0NWqq7NX8hPgSXKj7P
+btA9M4Y6vsasiD3Vj+Q+eclevPCzED63Nii8pzmsvcwhoTleQmW+E3QBvv6eqz1Wueu7evX/vVoC
+F77iMr09/Hz7PC8+cr6eJ6++Ma1ZvnoiA75y3wI9EdaJPDlKUjyKb+68dkfQvmNdwL72hh2+/xvK
+vcYcir1/OEG+NS3cuujHwr0n3+6+qm9mvlMycb7r3Yu+CziGviVMRb5ONn++hvuCPUgZCr47YVC+
+aMZLvkujaL5ejfO9cypXvqr+x74ZkpK+yYoiPsomKT78nic9dzY0Pq4reT6ITke+WySLOzXmKj5F
+8p2+/9vLvS6Xmr0NsbA95sivPQVnkT30KAe+bqAOvbIwwL5dGH++IvSQvhNPar663B09Q+C2vqTW
+ar12Cwq+VfYDvkz9lz3G+FU9qgixvUPSnr1Qf6u9/SmRPfxpP74aglo+2XedPky8UT68GSo+NP4m
+u3GrJj1zEUk9SnKOvfFqoT65C2U+KQmQPtrh4L3z4xI9KpYfPaeZNz41RDI9ET+zPmE/nj41OrW9
+d9p+u010EL4gPh8+gHZEPUTlhDuJPN490KWkPqUfyzsYVrO93LoZvsHyfT149gw9HVaBvWWSoz16
+aN+9yQWivvWYkL6X2US+/79VvSqBGT4NB0s+bZs6vknKn75ZOcC+VT1cvm6nDT5AQru931EdPjiB
+hj6LIvU8ZNwSvqKKo73cPgw9Jln5vGl5rj5BLYE+5rVmPlnqCT6Kgf07XxFMviUWhz4aSxw+BSkC
+PonSYT0x01E92RdOPv4nLL4YZMe+/PV/vgs6ML4UUW07+cstPkF2Wz5VlUC+Lxh7vtZKrL3g7Ua+
+HWWwvJ+22T2nNUE9kt+EvM3TBr620JO9c142u6YrIb2azyc+R52GPms4zjwcIGu+ACcGvepkuj3D
+SAm8eIzHvctsB76lWho9wssKPQR02b7kviq+ZHPDPdRAKD404KI+NHT0vcUkNj4/ITw+vBzxvUMZ
+c7+hzV2+NwFZPbsfizxFO8m9gOLbvZhZrT3FkFe+3ym5v/0K/r5P5ri60uKRvcmCMr7aHRa+3rS2
+PE2PRb0IC8y/uxEwvxL2LL0urh+9AkOtPZQOSj0h2o4+4+UcP9eWzb7y24u+kllOvqtaHb0LpiQ+
+gmsUPi70mD6tggk/o8gEvrOg972ZNi49fRWQPYkftz2CdbE+Le/lPUdTgL3IoEo+luo7PpdJOj3V
+CFS9jDI+PpdrIz7sgpy8scj2PMLbPz4k2io+PskyPdjUBT7RB8Y9gWIAPh9FGb6ra2i+rDMDPsbX
+WTxnmdM+8J8TPiBnqbuRqfc9sfFjvoEAhD5iUIC+y4syvW6GPz7lB+c9LM63PaY4Bz2uiiS9xhfu
+PBS9CL9cBcy+qxe8vhBdTj7rTAQ+5MohPln51735FVO+EzpCvqh2Nr5FqrG9JA7CPRSQMz6X/t49
+R5dhPTYMojz2T0E+XgaRPpHrUT1kIAo+146yPcT5Hj7Ae/I9QYXDPS5DJb3N0To+t68KPmQo6zwp
+OTu+YsB1PSTjSb1utoA+0KcSvcYhCD2lInq+kdhDvtr3g74pj62+434Gv/t/or5eo4U9XKOrvUrV
+cL5YLp49zcU/vu33q76Tde6+TE/QvhUfTz69NDY+b5MdPvHEjrxcpTE8n8KaOmu/XbjG6G8+lRpW
+v2FN2L7Keeg91oITPMK8Qz75dKc8wKhOPgwmVz7wWDa/TGXSvjNXTj00WC6+lmc6PpaH5r2X/ww9
+M/1NPSk9gr57vyy+vF0bvuxAujxc/je+ZnMHvdvloj6z2Kg9WPUUPoQEhj4iFnE+qq/ZvdDt3r1c
+fcE7MygoPvk4ID6bJYM+6mxYPr2xNj7wQ5O9AdGbvf75S7x21Fw+sUgyPRRTaD13KJs97J2cPdjj
+vj1UVjW9Hd6QPe3mCj1X9I0+T/+YPZoCzbwyESk9xuXLuhJ9vz1dGSY+rfqlPjyjdb1Y4s2+kP3x
+vZ1KAj5JODU81VWyPZxjmL4fECC/2AWmvnTzxb6lyLC90PmhvR1nDj5Utrm9QU+avgA8tb4yzHg9
+v4MVvvI45b0TpFI88A+HPZqgVjxyZce9Buvhvexf/D677yI+ilEtPf9ymz0MP7e9baa6PZ3Icj15
+j0M+nn6LPjo3iT5z5my+Qg/evZpeEbxAXkq7K7OUPUuZUz5F6R0+MN8vPpq5ZL5pCVm9pNTkPAew
+Pj3D3T0+ueskPrBvjL1ktfy7bzLSvFab4D03vwY9ytiPvbhifz2vksk9netYPhWaDz6hSou9sTV9
+PuQnxr3pVRS+B7+yPbM2Gj5Yves8GgCUv3i3A79fpQ29d2xwPDtTWD14pQC+ctd8vn1O3T3VvOa/
+7Blvv8cBAb41Kck8kCw0vscRur0YvFm+wzbFPR5A97/hX46/stGvvq/TJTzpLF+9zWsBvlYhgz1r
+1Yu9DxIOwNyHqL+gYaq+xQ8tPXVYxzykkYk+004PPs3I9z5HtU6/JmwIv79H3z3hGlg+KSkRP/dr
+qT4v1WM9/WvIPtJxjz2G+gS+YIpRPj3CGT7zLp8+rKFsPjEqJj2UnbM+5+/APruLMz4VmQS9Wqru
+PX/s2rzFDXM9iWZhPoNCSj7V7A8/v5R8Pj4/Oj57p1u9tJGfPV7Ghj20cio+8gizPeObej3Awhe+
+EmKOPtnVwz3WmU+9EhHmvJXeaD1Q7tg+1Xk3vyI/OL9xvYi9GhuAPY1YOT78oFw+9cs9PTNdCT6i
+FZe/rU6lvwsMP7/JTfo8A/70PaMpAT36bGI+0oIUPZkVY7926T+/ix43vyCFNr5UHn89X2z5PX1R
+nr1OMDu9ATenvtN5ib6wRU++FV2yvIF53T3f7cu8bBeUvVTPQD6iQ3M9G3uBvpHuNL5uH5K9TZEo
+Pos95b1KnCU992hcPpYDVbzCwqi9gmoevjGQvT0oWM69PrgevYQh7b35WwW/CaXZPDwOWr2Jea+9
+/XMavMNm4z10y2Q8Cq8KvfwtmL4AwEC9HysHPV1gFz43cAC+gcHkvR1uP73gYgk+F5FWPb3jiL7D
+sdC97hZBPl2ndb5i4Ai+ZOt6PDEOUD74Hsk9NhujvsuRmT0lT1M+v/d9vEez57sevDQ8gOSnPnWk
+GD0EIz++pfx7PpfcZz7Xgx8+PVIXPgJ5jD6gG04+Zuioveh+P74VlHw9DfYsPnhqCb09AuA9wJVI
+PdvYET7leyO+wceJPedXKD5/x1i90YgLvaZ6Hzxyiwg9CDbhPTAambtc+cc+GO6aPSzXij3dbeu9
+BnI4vsNFzr1j7rU9gaI9Pqmpsz6i8iw+pQYlvoRGfL6Eg+S9wFCwPYCEOz5biuE90+KmvpFgnr5o
+08O+rIKkvhi7Qb47iAm+OXUUvoenWb7GTMS+7ZO6vtyozr4aFeW+Kwu0vUwL/zsbHOi8jW4evrDJ
+4b6w0WW+e16dvneva74Yche9DZKCPYPbWb4nxVO9SKA4vkZlC77NQQc9qAT0vdsrvzyHLkC9N+MH
+vQ6hsr3S7AW9c1z3PbqPpryF6Fe+p7zUvQshor1CVuq8rhnIvap+Gz8TAb0+RrZ7PeDuqb3/9L29
+Sgf6vNhzhT0mtVa9BjIBP1mSaD77HdE9RXztuoKIcj5zdE4+wNyfPDFMeruSUu4+OZaLPg0P1j1b
+ji8+zvVfPgQlBT6aCRY+4nkAPpnXBb0RXaM+kSJ7vQNYFb1zhTI9V0HNPMIewTyIHkm+ZgDVvtrS
+H75PcD2+w8oRvbfUJ73MGp09mfTdPcpjqb28RUC/es4UvjbZ/z2Lxq899KNGPuQWuz6aC+A+lMjx
+PaZ1Pb+ARBa+RzwxvSpZVz1znkg9RtFvPqXukD7iOcw+mYRxvr4mgj5z6+Y9O8CNPX5Y4b5LD26+
+RACtPXz3mT6n/KI++JFXPhjMxT1kc5Q9E6tovpbJYb62rHg9Qv8ZPiz7Fj9/kaI+ib2KPlhtmj2Z
+hE+7mwy8Oxc7Sj5hssg8yFLePt7VKT0Z/EY8vpAZvae/ETxNLZW9OUlIPkKnGLo5FfW9m7dJvW9y
+yDtptAS+3YHRvfIcnL3hZzC86qNRvh88I733YBS+D++QPdQLZb7Y9CS9xZbVPP946b3jTRG+fGtl
+vq5pab0YDE69mxEBPakuvrzz0OK9fAwkviCngj1fttK9nhJIvYLYij56qmI+9LFPvaAUPr4C34W+
+U+pmvtbMt716bIW+lgjavPNSX74GSIk9TVNNvng9Hb3ytyy82zqIvqRrV76TMxK+BmEwvKrhST7/
++S09E2AtPg6sBD7BIqE9Mmu3PS3kOb09XKW9l3IUvWIlpj1Q2469cKZLPiTujT3hLea81fYQvqce
+hj0CW5s9YpmKvaW8gz1Ddc88MYsAvohEEr+mFYK+ejQiPqmYUD5R/ai9nikEvX+EBj+DbJ++vNcT
+vxK0WL5IaJ49dUTLPMhO/L0Izb49eua1PtecKr4NOH++dvrQvW9zFbxzs6e93gy1PI16R73b26s+
+jku1viXMqr6bTg08cxotvf6FXb3/YAE9jMxLPUejNzx9ITi+TTWhvUUW8T22RNU9xlqkPMiyPT7n
+lu+91NmsPPx6Eb3WD/w9tMS1PXSytj4jWS4+AuyUPvMY0T2yrgS+anF/PtaLIz51h2g+f27mPURC
+cD3TuQI81GczvU+hdD6TD8s+pdm1vNpi4j1XVCm9jMHbvdNeX77N+0y+nmuzPqNqMD6jK0W81AO1
+vAPVOD6W7gS9Il6OvmTaKL3ICm2+ENBzPYhrqL4CwDw96chSveCner3vGps9RNHkPRIIkz3Qf5a+
+d2IBvtPktr1R19s8QnWtPQEScL1HgOg+WoEKPjRH1b64jt6+8nyevm3Vib5E7ge9x4nMPYKCUD7s
+3IU++VYXvulW577SIwW/AYOCvmKWeb49wzm+OfX3vQgxBb6T0B++WWSKvsViHb7QoWO+qjQuvA1u
++b2yF26+PbsrvhdcYD6a31E9CK4pvmgUgDz5ZvQ9oXjyO34k5r0MB4k9u2JKPd5jDTy6IAC+kjoh
+vfLZdD070Lg+hgMSPsmqPD6QSAA+EewqPqkERT4x9RU+jYHxPBgenj6k/bw+DMOcvWcq7z7H+Ys+
+0/O0PjOm6T2nQ+o95HwLPhqBjT7iMh8+vBwhPjbbtj6TzOQ+PSnFPkH/JD4eQZg6XkFXPiyRSj7y
+0Sm+wkzTPuaMVz5Zsrw+jp6DPsTRNT7ZNaw+jmlxPnFX9D11+1I+LEiFPtJA0z3ZJEQ+sA+oPlPr
+rD4iHhk+96gPPsZaGD4Zk0g9AVHiPXeJFr1ady0+0I7DPnU1sr1kf3w+YY2cPr06FT6We28+ZPvm
+PcaKaT4898A+unVrvSTtGT4uR6E+p4OVPvB4ED64IBA+S1FiPmT+tj7IkoM9GKcRvok3gL4Jmhe+
+ScYqvjBSBj7O4IW9ZTo2PvN6uz5+R4g8tOowvs2FNr4U28099OzOvExIVj6v3589oWCHPvTOHb3d
+lsm8hM9vvD2eT726R2o9a/LXvCwhmb6doPw8kz1KvWeO7T5lO7U+/FkHvSi6pb3rjs+9rn08vnLb
+Or4XfdO9rGFSvd+b0rrx0FS+ZYt+vkTktL3yuQE+ADqRvbjMi746zim+buMQvhOHKr6D1am9D4s7
+vhILfbzW18w7Sn8OvvExZb5hx+e8HoQ0vVwP873ABXa97bxDPaDanT6rdx+9w1/Au4NnCb4CU5U9
+0McEPTHPhr6M5Lm+oUWpPcny8TuejXg954MPPhZKBz0xp0Y9oQX9PSOYZj7cooC6wTQavjzEbD1o
+HUQ+z/ygvXgiw7yZm7m8BbeLPiJ/7j3tBji+PogzPURHOD1eX8U7WFq4PGg6dj29yNc9drdFvE4f
+r70d1N09EDciPq6tT7w+ahS+b6pUvSo6nj1TfEa+vsxXve09jT5Bu5E+qxcHvZHKjTxHZtE91ucJ
+PaqM0b6Vmfo9M1s+Pt76xT2c8Ws9XqANPVquFD5QFlI8RW/cvhHEcT4AIW08qquJPIxlP7zYkJg8
+VMj5Pbd3nz3zlYu9FrzGPfhtlL23uBW9VxClvYvlor22VJk9U+bhvNFRRr5Astc962XvvsZKnb7E
+Pri+GLd5vXSX9T3PUNO94CD+vi++QbzSH1O+NwGLvmJxmL6hdNC97IxMPEUrXL6tao6+Ep2wvGNQ
+XL0eTIa+SbiLvpvVuL2GIZW90v21vQz5bD15kHe9q/J9PvFqBD5TMru95pVaPWmhFr4Dkb098D8I
+PsAvXz06pCc+2Fa3PtZobT7hfOo9DKZBPpNvFr5ycnK+/ELFPedKyTyhuIu9BuNhPRMHNj5B7h29
+5QG8vWHVYr52XVU97WspvtI0Ur5UbFY95Y/SPMfXjj1GyOi91sDQvdyhzL0qfta+Xbl0vm2hRz06
+mCk+2gxLPtCfrj3fjIO9xr4yPl3FprpQ7ni+84povthRTr5Rm3m8XcqwPWJ32r3HzCu9hCsVvuky
+j75RWUy+aShAvlz47b0sma29JM2xPZPL3j28pLy8phKNPQM8D7zq6EQ94FBDPT97JryEDti9pfm1
+vUHt2714ZgQ8TMnfPmi9jz6cTCQ9IE1Evt+FvL75+cW+LNmSvji2kj6ym/I+chpxPryEjj1w3KI8
+nT9qvt+Ghb4PE5a+3GnMPXPimz4Tm08+BVXBPSBUobwGi5q+dygbvTUzXr1VUf09TXAJPGvGLD4X
+2Fw+OsAlPrPA7L33/YI9ipwUvNPMKz2rjDg9R/hnPqFRtD1F0dM9wz7ZPIFAir4yYXq+O5lAvikQ
+jr7qtAu/96FnvunhNjsFkyG9HQBBPW2rmr2zbcg9gguJvmtYo75amIK+ruO1vTlhOL5Ugys+4Ryt
+PcMRRD6+A8o8BGdLvmp0bb5X912+1jqEvoWjLD5gsaM+dc9qPgDjQj5Zisc9zn4TvPlB2r3k3Vq+
+ZvnxvRNhp72goRO+J4nLuxHYrj2tBl8+1leWPR95VDy0b/C9Jm5LvsKjz7xJeBG+iaKHPQQWkL0i
+zD6+1ysdvh1OFr10pU++hmV7vGO1Zj0WPQO+RhUAvzTq7r7HGOG9+NooPXLC+L0SGx++tgNYPmIg
+ED4okr2+ADUKv+kCyb4rbV08QxsePW5ygz15FJe+FXgYvpbDMD4DFpM9nW5ivRxDUL6rgdI9k1nZ
+PQ1b1DxXyTw9DK8CPkbXdD6L0N47LJNcvoeOajtY07I9sJoXvTkQFjzCQp09rLybPSqzrb3weM++
+wZWZvZWBRD28fM68PVx+PBh+6bwl9i++wGtEvjzEqL41YwC+T2FYvVIyhb0pS588yCZDvotQDrz6
+QJ2+PqiFvk2DNb4olZc9jjgIvoGGz700NbG9nqQ9vtORJ74tcy6+KiNuvO2jNb0XPQe+ZS/8vZJd
+ED7YBoU9KgI3vdfVaDt1TYG8/Z4yvucNAb80Pi++zou6vVpVxb2iAJK79B4hvsU7Bj4YTRs+YK+s
+PcvleT2cjxa++osMPt9YRb6K/5q+HyfjO4/yXD6LXpg+bBzQPPzNuryaN1s8jI4dvnuymr391ZW9
+KaFbvbK26z3wSQq9iF2aO6J8Fr4bQ9G9iJC1PXTGW72rpxm9ZdTTvcm3IzwnSYe9TU8pvpRFkb6o
+3f49BxQrvXqrHr23e1A+XglnPWoIHT1rA7e+m2iavgNWaz1ulpM9sDECPornTj76Fzk+HKMsOyIB
+/738rua+c2FjPv8Wwz23hXu9qVfqPNIRez3bOZe9FSn0vdLSdb4Dz5g9K5yDvlwBCL591i6+nfKX
+vZHrHj4tnuy9CNGAu3Rf8L0YMqa+t5gGPMNKgz21LFo94vhwvVdbib7pMKg9vIZ6vQ1cbb6I3iW7
+0m/RO6kltjx7Z3G+sV7Ovtv6XzxK6l++agp1vrRo27yL1868HPIvvsBpVr7qWCG+M0UIvoUPvL6b
+Cuu+X5dpPbW7hD40k5c8oHE7vmSU0L6zdwC++fuIvhLApr5f48q9OqecPGP7Ij4c/SQ+12oNvXJ6
+oT2TkgO+lqKmvsFn+7w6xIa9HaRxO/bBkj3SUHY+BqDwPh5qBD4rC6S+JM5PvvoKhj0NwxE+IyjK
+O+l4Ez2uwpA+cYSEPgiIeL5KhNy8BSrCPUn2cT5jL3U9IAv/vX05cD7z9Ke8irf8vIXONL7TIQM+
+ySfkPXZ3fjvFwWK9QXh0vqij7r3IEYY90J7+vIhntzxMRMk9uywuPW3Eg7zpKoW+T2hLvgpL8T0T
+wr09PCRXPUOOQz4PsIw9Kg2wvd0ZP77z2/m9FQHhPZEHhD17YHk98CwNPtAoNr27O+i95dnkvRmT
+cb5qsPe9f2rXPL4a2L3LjTG9qNTDvXtohT1pdG+9sjKWvpHmJr4NNOy8eN95PuUgCL7m77y8BG/V
+PBFcEr74QZG+ETJEvmz7PD7eafo9n9G+PV1kBr52cHA98/SOPFMPQr4LMq+9GJh6u9Po3bwZWYm9
+yDdvvi9IyTzqD+I8/uc9vwT0Qb9n78y+JL4CvnmGxL1mdoM8FwREvlxHsL68jQg9E9oJvdAMP77a
+oOG9tNUrPcy7Ar42tQS+qIGDvqKWyT5PIWc9h14tva5brr3Q/qO9skwKvfBkDz2sPa69C5m5PjBB
+Zj7cz2W8/RIGvpvoDL7z8BK+FAOMPflFSD61zCU+aL8FPlvPvL3Rs+O97FXPvSAbID3H+Tw9pnNo
+vdiJq70aWpq9qGcGvtubCL6S7m89eobevUYV+r0817C9+RYWvtG0Qr5bKhi+w4WDvsxWCD7Z+vm9
+4j1LvtofM77p75G+KR6evoweRL5tWe09HeKBPgpreTxKID++Ja9HvvKvjL4Yvlu+xlqtvbOXhrwF
+L609zTSBPeeRbb58zaC+dDy1vCpNBr5hFFg9+usTvmgvq74Z5e89wlR7vodylb07/b69uCY8Pm9a
+Aj2JDuK9WkLlvUYhBL5+YVa+zkRWvsmFHT5B+7M9r2PFPe3WFr1ypOO8poXrPSuTGr3X9uI9vVsK
+PlmsAz9Ch7M+GQeIPPnIAD5FMaQ98AWHvOiTG7ypat4+KtVLPjJZvj2yAt69nwvzvaO+ir3xRJY9
+EVAJvNp91j3mXuk8yYLWPRhZ9L2HQaO9LmGsvAyRKT4gOrK9GDmpPhTi2DxLiWA9XeAdPfzZqr3t
+Kau9Y+NKvv1dib1ksFY+SLcUuwSfSr3eMTY9fQCHvangSr5tx4e+lZ7QPZH8HT+2gpY+WodRPqi+
+77xd9pO9r8EhvQTbrzxar0E+ygmTPxWpGD9U1sc91l4RPYThuz2MFHU+7rOfPglmzT6KvMg/iZNb
+P42ZrT5yG1M+OQTlOz7Iyz23/oY+LSEfPqyHNT++1ik/7uzCPg0odT5nuUS9d4YEvuY7PD4yhDu9
+r2J7PtjZOD4yA+U9FyeSPI/0Xr2QOg2+CjN3vYVZmL1IT4W9I600vNoYVb12w7i+XfkBvvBFeb0m
+iEA+ieOAPU0MsD3Fn4a+gLmNvvzeDr6akC2+FUOuvdZblj3lzs09AYujPXXlF74RlrM9AElqPRw4
+WL5LQtI7UUMjvmtKsr6NUI++l5ugvY00qj3zPDg+4R6zvZLWyTwYn5q6scvMvXuxkL1836k9+QcA
+vQXdjT2EMw893dAzvEAiKT6ccfE9BONDPUz/hTxRAYU9Y9UwPbayLrxhZ4+9r+uxPQZcYD3sqGC9
+of/tvamAlr2Ia0I9tvJYvfE8g7zuaku+2oCePXHIzr289+a9glNFvj/3z76h4Ie9j7NDPZwM1D3Q
+0zo+BZZovkWVOb6jvpq+hbEAvr2ErD0wzOM9/NDkPMVmez7Rx82+BxgHv55Thb7V936+fGzHPTGP
+DT609KW9HQQDPiA3iDz1M+c95tc8PcO2W753T0C+m8/MvqCM0bxwR4Q98zvgPp7bFz6DF0E+4FBq
+vk+qrL6a6iq+78iKPYovzDvo0Bw/XKr6PuN0jz5nsc69MK3gvf6Qkj2mvU09KceaPjAhED5Ii88+
+qlrrPsuS9j05AgO+0IW+PcqbPz4MTgY9sVcxPcolL7zMlz0++YOYPdDXGD2B7sA9U+5qvXGcAD5V
+DS++Lct4ux+4fr0CQIw9K65FvX9Hkz1YkoS9nl4vPbaNmj1Y6FE+qXNrPvac6D0xQFS9XWk7PdMQ
+Jjzo2wk+DpVfPth+Yj4O8nQ+cwz4PAuJHj6DuOG845h4PouIWD0bWPm+R9p/vomvIr5cX56+UDct
+vuD2gL16zEG8pN9RvaK0zb3ZX1+9n6CZvXfaJb4/VFe+5cGWviUJbb4EQoW+bHjVvR+DBD4Gupg+
+UJk4Pszg+ztKAxK+IYZbvsJw4r6odak92XlSPuu7UT77l3A+JagVPr8FjT1xuE++nLGNvks7ib6L
+KBC+niwJvg6/3r28wyC+x+QAvotMFT1GgCi94eVDPssPUz4eYQm9g/n1vQOFA76Gog862MeQvQSB
+pbtAKLQ++PbNPt8iez6+uDM+LxDGvA/sQr0LT8O9H6OdPdxu3D7pJ8w+7L2HPnU6try+Gbm8396l
+PZDc2T1vokI+r7f9PitjOb7l2S2+U0kWPRfpZj0D/wE+wFiiPVKoSbtC8MI/BxSlPpG+lr37TiA+
+PkVyvVDJZz15lcK9QEaSvfhOCUCCRk0/XRNKPvOrIz7eKrs+K/SGPf7YHb6mT5u+e7cRQPuFPz9e
+Xs49o3uvvDH2lD428dG9gJhZvpcbJr9ffWo/G/SxPrUmAz1/u669GHsVvWftYr7cV5e+7BsAv+Z0
+oD6ysmo+yC/LPECM2bxJu02+IH+su3u8iL4pJIW+DHOzPZJdxTySCnO8dHM8PWEUyr0X90m8zxsm
+vm2gZb4eggE9UJlhvg8XJL57Qly9sjwvvl3q5TwfVQa+Ku6Vvjd8nz3APwS+zKDCvjGhyL4nEq2+
+hTUOPU1TI71qCwe+daZMvZmRjj10jqC+IlvCvm9njL3vLI09UAhgvRpBzb0KpOY9sKDlvWkLIb4K
+Coq+fPh6vU/EcbxoUrq92kGYPcDINL4LYr29oy6hvp0HoL5vPI+8nk4ovjLJtz0DZvw8lm2Evgdx
+Tr42VQk8GE5RPXPoHz5W44U9+LesPVowoD0yOYW+XEeSvmwXC77Ih0E73O2UPkOFgD7Acc8+NnsB
+viH6JbwDCB++Gh3lvfaBar7xh4o9eC93PVes9j7rQqI+ucWBvvINmb7zmp++i91bvbgGkj0g6b0+
+NREsP+371T7wI+Q9h1gdvV4OlbvSytS9ChcKPpNoJT4Hqje+3awZPnzxHb1ABVy+a7CAvXqoCr0n
+MQa+RFY7vqYOkL0WeLQ9OuU7Pma+9TxZY7W8iRODPS0qIL2vWfs7UyCHvlVsbLvgj4e9xWRtvU6a
+Db6A5IW9yKsjvqL38j2nKDi8hM8DPXC+l7wmnVa8TtGbvT8K3722Mtu8K/0uvBsnTjwL3AY+UIOo
+PVPxd720Cba8Gz5kPEEXsT10EFC982IlPcx+hr3JTXs++SiWvdwgKr65/xU8401NPAi1Fr7B6hM+
+KWcHvs+x/T0G8DE+SqJ2PdEeFT1B9PI8dVkmvFnBJL49XYS9HAPpvdtZi7xy/Bi94QuCPlA7/D2C
+SAg+hYxnvSk+vTt/f6W9uVqhPUwpED43P289YsjovVX8uj0RLp69GuZJPf0eij7hnGS+V5TVvUou
+A77V6q49WZZePQqQ+DtQyES+p/m4PZrDqb1HYeG9T9lzvdS7DT3eZSy+/EGXvSkckz2O15I9lcen
+vQK34L3Nqxs85V5mvAzN3z1ljrO9NEDNvR6lMr3W0Iq9t7OJu/h74ryasGw9NG6Pvjy9N70W8CO9
+W+5avTkW/zxaQq29xpNJvaCvdz3X7/68pKxxvrgQv7yyIZS87NHIOuR1iD17+QW99saGvY2rDz7o
+QQQ+fDvuvL6Dar2th5m9N5RfPdF1Cr7PvhA+TQ8YO8nfBzzaBg89Pxc/Pqd+wr2aD5e9XCQavv76
+GL1G1qA9N6ElvnKBw7wTWLW8yiC5vWy5u711C7a9hJgyvtixDr7vyvo8DmHmPCTWvT3GBx++NO/t
+uyapz7wqnDu+2LcMPnZ1X72Yi5Q9ZtVIPbE2L70XGgu+MOiTvAnrCT7Dmlu9z3fHPWxkhT0lP8q9
+yFQUPimTDr2MdcC8teodPiz/vD2L3CW9sGcMva0nVzx4BAy+YzIkPJ8ERL3QTdY8G0xAvcQI+ryl
+Mrs8f5MVPUGMx72VGhm820XkvH2zlbt9n4U8wBUJPhBVdr1Vfai9nICwPa8Yzjzsvb88nUewPaOS
++7pJROw9lglQviV+BT4N/aO966MLvSYMob2fXYm9/j6CvYgdUDs6VC2+bvmhPYzFfL2BwMk9Cdhc
+PlTJOzyRegU+VeDzvex+hTvmyJQ8ugwrvqUFx73cgYe7urVaPXwQKjxkiLo8FxB3PsLyk7sxeJ68
+mYGJvFdC2b3Gni89iAaXvSlhkTy7p8w9llpaPQVPKb5Lj7q9EHZrPIWONT1JZq282Nczvfp0wj03
+q1U9Vsf+PTVhDr6L0Xu9KPvUPQxPqrwMYIG9MU6bvWWlrTsYPIA8besmPCrxnr11rqW9lpgjPhBn
+JzxfqOa9RDUkvNYLVz6iOK0+Oki3PsH8g70L/J8+RhjSPnuRBL66xYq+b8qvPARoTz6PDgU+0YcC
+PpUgiT7PSa09uWlMvkAMS74qqZ49r4SLPhI43T1SlEw+PrG3PlkV0j2zsRa9Q7whvtyfwD1iM9A9
+X9JGPWDVXT6uXis+Z6cdPQXiPL6l95M8vg1kvHuBvD181wE+R/THPWMMBztNoEu+gxSzvJImjT3b
+uuA8xWtqPgN9VT4qo7G8wYKVvVkvH74RAIO9IwXxvd2svTv2eI4+Ucb3vOiL8b1LnxS9OQZrPaOl
+YL6fQQG+6GgkvZoh9T1PaCO+6sRxPfRLKr7lCaW8zGPhvqdcCb7UxLY8MM9rPDrdBT4nHlA+GWxc
+PqrJIb34AXO+2PwuvgwyJj6bbDI9S4Bkvfg/7D3V2QU+1dURvZeoyL1baAC9u+POPNBnSz3mfb+9
+NocMvlC/Fr23UjO+S6GavvvnHD4Zi1A9qxZjviwKhb792oO+Hsoavibivr3VXv29mUoJPkVQ7rzM
+BZ6+e5SqvNoroL1q81E9uAVHvnlJfL0rSmq+zboWvm1+5b1+FcI8Oh5YPpPClz3quM69+548vpSL
+9L08OqC+f+I4PSJ4Ej687pE+gPDDPXxM2bwsxp++pTxFvl2pF74AXJk8u+IHPVoW7j3qSRs+0H5+
+Pc+nT75Lwyy/5dK5vkOooT0PXQW+l0xfvQ+uH76xPZa+ZHwbvipdx7/D7i+/VrIivmDLZb1/m769
+/pHNvKEher7hGdy9JxgRwKL9rL+ZotO+ndaQvuYvhr4Qzb2+vW/Vvgiq3L5aPhvALpMYwD089L/2
+46i/yDSav+9bQb+jZ+e+PmkdPUvKwb82Xqq/iWPFv/535b+g1au/E/4/vjvl9D6Rogc9ytr3vodx
+r74JVWa+LsuhvWGEBb6EzGU9pvK1Pm+3VD83NbI9Q7wSvfCG4DxhVog+r3u0PkQf4Tyf5gW+qg/N
+vYjA4T1aI3S+q2SNvYIcNj5PQaU+GpSRPYa6cr6++sG+Zvvfvl1EYz0GMh0+aHSevb1igj37Fg89
+5ljmvSHUA75/ggq/u/FivmTlET506mC9+j/hPOgr0ru6NKU9wRECvGOUIr/nLhq+TXPQO2o+Kb3n
+oTy+1boCvfazDz3OVsm97JPZvPv7yL3EKa48er4IvjDAO76NICa+j3q/PYBGFz4gJ8I9T8wDPhlJ
+SD4sIlY9OZtrva9IkL7MRga8JulvuzaTQT7F/IA9xw0WPhf5Wj44ZOG90xSIvRTgE76R40M9ICzi
+vZRZcz0XHTY+wdMHPiAoDD7E7QG+X7cdvlWASj5eFi6+aMUBPqZerz01Fnw+brAPvodj070uGx69
+Zzo3vhHEND8m9hs/upXvPCyUyr6h6E+/IB5uvgWs6r1PfYa9IpICvraEWL1oiO49uSnUvcJOrr6H
+ApO8pG0GvOQ3Ib7tZyO+iXZlPBXZIb6z9y++2XPOvTcnOL6XXi2+wjj+vbktjj0jZgQ+afp/vMdu
+jzwj6RK+tQx2vQcOaj1eobG90UCUPvcvCDzFsJe9EF1lvieo8bz2ga+9Lc5fPuf0pj1Diqo+V/0/
+vpsRvL4Fg+y9rdlOvmx5hbuHbvE9ULI3PsV7Uz7avqi+VRWKvvvtHL6/MEq8ByexvWndmL2CVW09
+LxTkPQ2ahL7VB5W+ZfRgvoeJMj2G1Je8aeNyPZ2vXj3wfxI+4tdyPViX3T3qGSw81BJ8PvwBAD6+
+Lv89mk0gPdIecD7mGCw+qSIfPQz8Ij0xhc09XVGbPVBXZj7PeyI+8NQ8PTvYsj0L7g4+qvStPVek
+TTyH2mY9UMLWPci/jD7Gd0K+gtuNO338oD2F7Vu9+3aGvVd2070/yxA+shxoPR9qOb6kt/u+BUy3
+vjLOvr2IsJ6+HYMTPJbitT5zcio+chj9vrpzrb6CgX69rNMnvpuo5r08CfU9SFhlPnE/Ij5mR2c9
+McgkvHz1tz6Nb1A+aVJKvOwXyDwBajg+WCtdPj5tPj0wchE++ydRP5enrz564uY9hId7vRMGB74q
+Mn+907h8vhDF2T3MYQE+FD5wPp5cRz6Y1tI9uJlZPh4/2L3S7A2/aQCsvllNFTzWTBg9nNhYPpOi
+YD57Ke89EWwtvh+ag7+4c/2+sGwIvmOYPj7ZFB0+fP1HPgS9eT5MTsw+AjGqv7oHB7/vszE+zUYt
+PBQRXT2iGZ29R5e4PqVZIj+jUQa/wBO5vfQJlj4ghUk+J+JrPFqzKb4Cp1s+LGUpP5girr6olGk9
+MfiQPhqEIT43SoM+fVxoPpoc+T2iJXe+yDJIvjxl0z0RJKw+beGVPRD7Qz6IBe09DCJ7PVt3vr0T
+QbA9zYOVPqMQFj4xxke8MgLEPVSO9Ls6mcs+IuXTPgGfmz7xpLg+8fypPktKgb591JM8MTjtPRrE
+57zlO/O9lZfcPgR+xj7vIhE+wNXivYI+Rb2U/wq9iC+pPSgDKr5Zb48+uy2yPnqXhj6Mg7e9jnfU
+vTcCJTxoKk69dDgIvo8rp75dREk9YMA/vaXmZb62D1q+Arh/vgKavL0re7c9lv3rvbIY0j1DuxW+
+xe/WvYECxr3EcgW+XrESvp3jJz2S9to73VuiPaX38z1WApC+oVBGvv7c+DzyAFy+pFm5vNsbYj0c
+6so9DADiPeehgL50aQK9eK7qPU94UbxeHyG9zBzBPp1WBL3DICe+jvAiPS+whz3izZg+N25YPmCF
+yD7C4oE9j6mRvZbGTD119CY+zEUOPdOERb0JS5s8duodvYxjyj0rTbK8m6hPPhV+v73bEMe9W9oo
+Ps+xw7wW4Q2+xDe8vYhKK766NA29HhEbvgpGyL2vKlu9eVkSPkwpuTxdTSM9FZWrPF4P+b0HDwC9
+boHLvaLotb2iHVI8O/ORPEwVOD3BYZW9MCxyvSb9nDyFSIg7ZszCvRTO1Twb0v28tM3NvRe2DL3q
+Wqy89l+qvAKTgDyhjNS9QKguPhLlGL1DHZu93ZFOPdfwEb3+0029dAyovMZ7rz24k4y9UHr9POA3
+Lz2q0fk8trnHPVcG6r0YSN+9pMBmvXSNSj5s6ua9cIKYPDnB873tDja9H15PvBgIyTyEFsK9HPHK
+PZx8Db6F9QQ9fB9Qvf3PXD23S+I9ZAbGu/cWUrsU/mO9v30hvj0xG76FUw28QSynvfo9Mr0iOb+9
+15RgPo4Q173mtQe9b27DvVmMnbw3Siq9/hcMPuvs/r3dAWO9W/xWvWTCyrzqwnk8BWzgvQBuzbx2
+ucc9xlcLPbtoYz3UUvc86vfKvShqDr1A5yK+VKLyPfIHHD1+OIC8DV5QvXailr11QDo7/oFVvSt3
+Gj5qZSO+EfZivbK9Ar6MCrm9lWPNvUtbgT4bNla8yARoPTS4gL2HN4e9er1SO38F/L3Wsf69i3kL
+PSqXfD0wsW+9vErJvVIWZ71ILR++kzuBPAKtj71/FB++egyhPWemzr3WT2U9Wfk3viOwh72+GOE9
+Vs99vU0gPb3MWhq9fnYhvvtvJj7mtqA9dyzKvFTTY72JveO9/DB3PGdKaj2YrN49fRWTPW0w2j2X
+dbK9Hm44vdgWiT2LQJG8jZMHPeFSrb0FwQO+548JPROPCL4x74C9x6+EvAYgkr3RWay9eIjqvYqj
+3T3fIK29jwYFvgaFgb0IK4I9fxRZPZcjoDwFnii+M02PvRgOfj30GTq9fHMfvBgLBb790l+9OfXl
+vAoZz7xHXS4+w2J8vfRaWz2uOoq96fSJPKXL+zxucKK9G02jPIW/ur3NhgU9KSCcvTmJfr3rXaE8
+eYmMvfxLOr1E7dU6WFI0PaUFXT1Wspm8Ew0IPqAZJT7naeG8tw6yvebuAL7ilEm+uZIyPq4wYbue
+Adq9LF55PdT/A77zwiY9Y6jtPZdWZLwiZay9rAFjvVor17xWJiI9jT6ivYeS9b1P4uA8H3DpvQI2
+bT0lFm097ixCPHkttz1uBl29bQUuvCQvADyCZl09acnUPVpOHL1YLYM9N6kbOyqK6L2t4CU90cSs
+vAGkm71+9Bg9MZU4Pf9Mbj1Fdnc907pjPdNcfDuv56q8CvktvKpGh7wZY8C9qEvQPbsD87x4hsc6
+ZJ/MPS4vjD3BsR4+suzfPZha1z3fewE+IgRhPoe1QL5kkyW73TjYva52Ib6r90c9AqToPZoBYT4L
+0pE+Gjo/va530D1avO08j27tOwV1kjxTMnQ9GQbIPZZeFD5pugS+FWTtPSlkhr5SZse9JYfsPcoB
+7DyiHwC7L/8cvHHVOb2nOgE+Q8GIvrSWjr55RAi8TSXAvCJmZTyJH168bHW+vVa6d76+TB++F7N5
+vnenGjwuk4u94VsOvpeHK76iDb86AwyEvjrfBL5Y2oe9zIGtvTYsob2/CLA8Jro9PGo1n73bORq+
+x0EHPArEBr0YQyg+lmbGPbmeoz24J+68w4+4PamrDT6+QfS9J+qFvtIMBj0Eo8w9iWqCPR6qtj2c
+mni+SoKNvZJsC757OH257BDYvY/daTw/5aY8u8EDvWJEfL3dOHQ9PcZmvf5Zsr0DmAu9leohPlou
+n7vJuoU9pMUBPvsrjzsBL0m+1wFTvmdxzT0guFE+hmXxPer97DylbtI9ZYN/vs/tub4+vOu9iWnH
+vQMyXz50Soq75DR+vdS/vD0p9I6+pM6cvsjm9jwMNB499PcaPHsosL0IPxI+46G5Pe2pKD2xizm+
++H3gPB0+X7wnFe68ZJyCvYHSCL6fjCi+c19ZPSL3Az4M/YU9u6tTvp/hmb3jYla+dBbGvfat2LwO
+F0S9+T02POYG2bwlB3w8bwvYPWvCH75W1wu+2X4aPuoLyL2oPeS9nvl/vfPFlD3BZDy+w3BBvhF8
+rr4WkcW9efFQPT6ytz3bPrS8VR5rvXCrE77fo36+/m4Kvh8hrz15sqc+VKm3PqE65D7enrM+XhP6
+PT7nwjzy8Da9luepPvqeAj5ip24+Qq/fPq+swz6d464+xweAPsaSaz5IN6Q+2zQUvrxK7Lxc89o+
+lRisPgCpcT7jy4g+qGGBPZGyID7XV52+v7KIvipxHTwSPrU9iBgJPhDLGT7ZbaU9phOMvXNf572k
+XqO9XIAgvmTHRL495U0+v0YZvrouur3gv1E9CLs7Pu92Mj4diMG81sRAvteZdL71j0C9CwlSOzsh
+pDwpeW899bgTPpv9Er2S4m2+er9aPYHoNj2gVog8FKpRvraqAT7gbjE9OueJvpPImr4eU42+f2o0
+vl3AYr5t5rS90wFkPj0yND7n/T895W+DvcLWdL6a0Zy9HHaevfGsN76C+VQ+8MBWPp5zHz7QoIi9
+YCwjPuL0jT6gB8k9iM33vNboJz4Qe1E6UG4iPYquFr6HI9Y9Ed8APiOsiD5zsMQ+4Ob3vl/mur39
+u5e9jWwbvuyqI775k5c9+0kePnUhiT5eE2e+FXWrvu5xob7sJg+/wEBJvnjwFDxqkjQ+kSCLPs6S
+ITqpNw8+EC+zPXxIVr6lpes9lAVMPm8m2z5BMyy9IhGnPi2duD4r9p49hRiQPAIyAT5eZ6Y+FBsZ
+PXgYHr1pRwQ/oofmPsadgb1hmQ6++Axsvi8HPT2msFq9QjCGvRbysj48+i4+sGavvUl6C76reau9
+FspbvoXvKD0VH6i9IIT3PAcon72gZ4W8uZxivekaIb7erNW8muuCPUODib7ocAC+nbb1PBSkujs/
+7Ta+sQCavRd3KL5B7ey9oIHLvZ2h1L3unY49b6CUvGyMKL2jXYC+MhbKvc64Br4sxBa++rawvjg/
+lL0+arq9sdeAvp9xPr2jbJa6FBO9PVjcnb4vowU9NjNvPisfaT5sxJ69tzplPpMbbz6js8s9w2Vo
+vauAbD2FTDo9pn1hPWuVer5X9AY+9PmTPmLHMj6VstO8TmJOPSssoD6kD7g9XsQSveHxkD6zOLk9
+HjZIvUXEFL6KkUc+4pSbPtuMBz4rfmg+U0mQPU0qpLtRNTq+uEKgvZRLcz6sA50+z7cKPt0wAD5O
+0XC6GbmDvSolG71Z0xy9ccQePaYPb72CMCU9vSgFvW/VF77CS8a9/L1bPdpFRb6v6li+17pcvjWY
+kb5g5au+Xe9tvlICgb6izNa9w5EGvRhj6b3KGUe+UsSZvhE+T75T0S6+jxwTvixXhr4S6jy+JG3B
+Pav/CT45d1I9W4S3PqPBQj6Ojne9AjjiPZ7iVD4gdPQ+++UKPk0jpz19pwI+5oVRPavfFD4+ccg9
+F162PO0HDD/gBGQ96QnQvUhwQb77EIs8kBwhPmR1mj4FdgC+GzkGP7d2gj49gsW9mrIwvsfpsjyO
+zWQ+tE2vPglTM711Jxs/YHbwPq8jmT5h8CW9c7qQvVBgDT65p6w+Bf7fvWvBIz3zGo+8iBIuvDMs
+R73d3KG9isu1vTG4l7VRTUS+8N/uvpslg74TdgS+rC5vvgTp0L0y/0e9PS9uvoDkFr5WBWa+0ZQq
+vkh+FL5bh6q9Es+avV8axbcfMKy+MNG0vqF/OL7d4EM+Xq/kPjM3Aj7LObe9g3gPPtiePj5srDY+
+U/1jvvFlmD4eX14+H20cPhDh7bvd6le7J3z+PI3Yd7w7NA29GGypPlKyij7XRfg9FEMJvgvEmr2G
+70o+eN+fPS0lgD4eb4A+HBqmPvoLhD2OSPC7QEADvtnUbb44t1C+NGl1PL4hED4PQn4+kYchPQiv
+Xr1o+DC+7p00vrU5kb6SmFW8saW5PZlfp7xz6JI9Gf8IPgqm4rwg+aK96E2SvlJOAr07gIE+2HJL
+PCoopr79Xy0+w5Q3PUq20L0pRLq+5m5CvTRe9T1dREK+AHYuvskZTL0r3ao8mC/GPIgMvL6001Q+
+r15zPJ590L29hQq+7VifvSDGnT5NdJI9DB8ePg0gP72VLRM9lQWOva+bi7421rY81RwrPvdhBz6b
+8dQ9G4ucvjbxSzy127e9STuwvOI7BL6CT4Q9ju9zPaLUXTyScGW+zGHavY7Jt7tXQn69tyD+PLl7
+uj3Bni099UA0vvYh475r6lM7OBqOPZiAFL484qG9Z+0YvceirbzVhgC+OEzLvpvbhL13f649UxK7
+PfiBzrxY6gI+t3rFPCCFgL191cG+/gNCPpJC8T6qLbc9gtu/vQ/jBD5TG3M+7RjrOmNnSb6EjIc+
+4yhAPk/no70yOUK+gSO0PUagIj7/EHs8KvLwvbBKsL0mPHu9iuHJvWSJ573WYQS+wIh0vdFQv73Y
+55K+eDMZvt/ooz21GMU9wxo3O6J2Zb211iK+85LMvXs81b64wZi9vS9xPXqIsj1IrU29DHqqvfQG
+A74f52e+NvePviITN71qgS07gQrFPWGQrLwcpoi+vAKaviU4or603yi+oROzvsZcj72APjQ+Nmi+
+u7hirb5/5Me+mnrBvtKfcb7Q8MK+7fkVviXpYT7kn+69aokovjgih75C2Aa/NqluvpdjRL7Kp++9
+tIobvG7/Kj4nqIM91GlaviLLGr41c1a+eXXxvqevsL34Hdw8ZgRIPk4q6D1LAp69LlYEviKtkL7c
+w0e+gqUGvjw7zr0/WB4+FPACPq0WlT4IB6y+74DNvbT/WL7H9Ai+fqUWPc0c07300uI9Nz+DPjLK
+UL5XHFG+4w/aPQrNW73eFp08txcYPlYbsr0QAD6+Z4DIvmFOR75yoCU+MwbIPg7ghT7STqc+3sWS
+Pfhd076JiPy+aItxvnmWtz3yMMi6uwdjPinL/z37Qwg+lY3zvUTjEL1cjaA7X3QCvl0Psb7ud7a9
+yG77PC/C97y9Rv688rA+PZ8tGT45Sj6+tihrvmWU2b3XpzY+AzPBvQvstL1UXJy+liwgvZgdyjxo
+6tG9yrgJPUosJD6JXwI+EkATPLPfFL+WxcS9kxifvp5VBb8TSX6+IBe7vjI3i74B7EG+lvdyPR5s
+ez2BjoG+ZuMCv2lIRr6qd4i9dBJaPJnAI76PtAs9NEsKPj1MvT2HyoS+n6H+vAYNvj317ro9rmT1
+vtvsNjrLOdQ+21LHPmjznj4rJTo+k8SxPtgH4T3W2DG+3kmtvpxmQro1ygo9V5dwPuMNyz5M5gw+
+5j0RPmi8dj7wuYC+mOGrvoV+T77akDc+s0OfPlkeWj5UfCS9vNwEvv3/Tb641Lu+qRi4PPIZr7zA
+36g+bDmpvX98sr62w6W+MaLmvh77Pb6e8q49mxpOPp63kT5/iYy8REmCvi/+pr4BzlO/visiPTG1
+6DtBgC++10GLvsQ/R71TItc8WdWBPZVlN752dSW9L8SkvRw9pDwdEQu9gQuNPd/Qaz6Z3EQ+8i7p
+vVuX6b5WGFG+2rVjvcjUjL1mBay8qT4uPjcRkj2M4ku+F36rvuLVNb7dhlW+PDCuvgtvn72epgU+
+Uiz1Pf7klLoJPMq9ZTm8vtR+6L4Av5m+1+jQvWqnuzsrOso9OIVbPltB6bxnyKe8ihZNvheixTxe
+k1K92FxVPhQ0Dz5lpn8+nNKDPdoIgjw1ie+7xb0vPrfRLD4Fiwo9ng6AvOA0CrySbIi++oIFvp9l
+BLztrRg+T2UBPKk0lLxGu7q8dceKvWqOKD7JKKk82X0avS9Khz6pePM9g2TQvpFwKr4YdwG9bXY2
+Ph1ypT5MzTI+tlCJPqyzsj28uCO+nrCXvrhF4T0S6Sa9jeOgvGuUM77JGFq9oMtyvVsEzrz3GyU9
+WcQXvvndAz6aom++pCdavllawzxeNyQ+ZgLAPZBVHL1c6Ak9CjojPp3vmz7Mafq8ykLwPd45Uz35
+vtU9/p/EPGHuDz6aJhw+L/wiPu2Krj2E/9A8bMKuvYtCcL3FubA8mr6rPk68CD4Osos8GV3HPd+C
+yryYKgo9YIouvjdx0Dx23XQ+k6lgPeyhZL4GgTq9peg3PUr8+r1zMJ29LhMBPtXz4j0HMba+tyWe
+vpd87LwB/Wq9mcFCPpa/Ob7W1ci7e/oBvWFd2r6864S+STcxPZa0Dj5IsqU9qCBjvfMa4DwMIae9
+cgwcv4Xrdr6hBR69FJlTPhMUaz2/h5I9v4asPfjYBz4r59++bNv7vZj+uT7C95g+WjMFP2UGSD4I
+eZS9OEedPTgOt70i4hK9cgGQPtiDoT7K0Nw+Bz0/Pm/p176i8sy9hLPePSmviL3qV9C9mprJPUHn
+gz5V8Us+sb4cvrXr2D0v4ok9YNg4vqcwqzwNsVa8GjsFvsd5M7xI5n8+aWMgPmrO7Tz3zfO9z620
+vN/zKLxj+vy9tEVXvpWi7j2691o+zm12PWcYfr4W97i+g4DavToh0z0slTI+61yivb+Fu73zTXA9
+DXTXvd21Ob1VBKe9I3YZPrPPWz2vCwQ+iDNjPeqXyT12zJ29j/2CvmVxkL6GRuw8TgRGPP2DhDwK
+/OI84xsTvrmqhb7YZ6W9HO4fvt8BWrx0jkM8e6C3PUZ6DT7hwBO+3iqNvnqA377w3F2+i8z8PUyh
+GT76OnM9yCtoPieKEz6u9GM9DISbvWiEJb28rhM+Sbc+Pg9TMz7PvJw9AsxePvTi8jyp9A0+E32f
+PVc6QT3mEgQ9kPO1PRtBhD2wiuM9uCJbPudOpj500S4+icOZvT1zhz30vE4+QudbPr0bnT7oLjs+
++jjfPL5Lhj0REYO+Ow66vmxYOT6HxkM+TdKTPkvoHD5hzAg+6ZoKPtLsc76eXJm+ILcmPqHErj6p
+wI09NagmPp3fYj7jCwU9GqlCvja8M760LSM+xriIO+RoED7/1UU+c5EOPpc2+b2qCVK95mZfvpip
+qr3Jofy+R+0Wu+aihz7+uKE+IbbFu+kkjr3WdIO+XrKNPYCy9L3PkUI+Pi66Pg3Frj7KQsA9wXlX
+Pb2BtD0KB4u9IyQYvn3dsD730OQ+CS6MPlFShbxSoRk940e9vNZ/Gj6ILSA+zNp4P2waBz8PU6A+
+eduDPduYZj72G9w9EcucPYOauj6g1dE8j+JkvdnqgL1raE++/ezPvsHr0b56jLE60/YivVlQdT3x
+K72+pwkCvr/aMr7R6vO+XWXlvqTqz70EeIY+BVuMvsBJVr7Cwn29qPJavUAOfL3/uvg8EYZ4vZi4
+xj2Y8SO/cTS7viwhiTr8IDK+wIMJPkqslT43wwu9AwYXvmm6CL5qy2W+f9YEv5uRQb5eLgs9ko0N
+Pi0Wtr7NgEG/f4IGP5I1ej4XSOq+gxQEv0odcr50eI2+74vIvhcKt77CoR4/ejPCPjAVz77QwZy+
+CQSyvpXRxr0+2wo+DbjZPQ4pzj5f5BY+8iBWvuUgKr5bGxq+6txLvao8rr3MjGI+8PkrPU9U9bz5
+o2U+kVaWPjNjbz40zZc+zdv7vdW+c71qpTW+VM7IvUOPPj1sYPo9JLM7PpkmdD2T7gu9bnIRvmDq
+8r7KKNa9iX6svO4Umz1jUlg+DoRfPMZIhr0SX2G95irIvq/RwT1C+g0+/jNhPilcWj6HCx8+KzV6
+PVAQpr1R+NO+DoJ0vQ4jKz53SOI9jZ+UPLyMd71bAZ69JDvRPG7r1r37Jqa+zj9RvrCWrDzYjMk9
+/BbhvTVZwb1+Skc+n7C7PvayK76H1Ty+EO13PYNnBT6xLSu7UfJqvh2MfD4LORs/uNWvPmtjGb1E
+C7w6dEmuPQD607wrz6C+oTKgPn95Dz5czAs9txODPvlwFD7dpVu846pZPSicdj2CkUA+M+8UPVUh
+0b0zSas8uxWmvLqdZD23T+A9hmsJukqVX74xrDA9sByovfxjT73Ohes9nsolPlXx4bwdvGa+EgSZ
+vrsMKr7uGLa9y15uvVeHmD0MgfE9+CFwvZEi9LxgZKK+IY9qvqHZAL6u+Fw+z0H2PYT2YD4XSrs9
+cRaAPpU+pz16dpO+k5Ymvu/EsD1JJzs+9t1DPMSrUz2r23g+dLG4Pvrzu74fp06+fM49vhGqS72h
+zay9j+kFPkyzAr6+O70+TKAuO22wUL04AuS85NYnvlqfFz2ty4I9+GcDvfHL/z30VIe+XhTSvYv+
+rL79Qtu+vtykvkeyWj5d0e49vd2+veuUIr6Fky89J+gZvtP8o71XjvK7Xnn8PIcU07wR8E29jQEn
+vWY/rD1o8xa+OyPbvaGVLLyaQdw9RXFAPgRh/r1oVEG+bkGCPfc+H7ytZ949VTRgvkRc2Dvgupu9
+rmBQvt3Qir4qtyG+15UkvjkSlrz/0b29F1jLPYD2rrw3AlC+GXDavghIib3lZKI9sC8PvNIWUD4V
+upC96c4KPlACKD48Yt++svj6PbCuA70B95u9ispQPsigOz7n8VQ+xk/jupQGEL+OJhm+c8xIPRsn
+ub3znq09ltOUPofXoj6IEzq+XFn0vfWbSL2fayo9RqXSvSaFRr2ayNy9XkOIvp8KiT4nO+U9qsJv
+vQ5MrL0q/aW9kXKcPRkMNr7C5EW+HusUPgtfo72oSCY+YPlqvnV9Jr4sLaY9EeIpPc7NA77C9+09
+0YlDvTSkKD4bYSw+ZfExPnGiqD3T3oc+0ZuzvcItIL7mxD6+B220PDRRsT5t3aA+KKcYPrf2tL2z
+WYM8JElJvhqRzb4QX4u+0FqxO1ksCj6PiB8+xblMvuiUmr76E4m+GrCHvqdv5L5QAVS9CEbSPbzJ
+zTy1xzs9cUZ/PTpCpz1ILOm9wOy2vg+LRL7DQzw+uA3CPdcE7Lw77sI8M23BPd3LEz0qjye+fL/V
+vTMzeb5qd/G9jnB2vhBrpj0OwKs9dKknvkWwsr5bCZq+RJsbvlLgIj1ogBI+2knyPGgIkz7eMGi+
+54IlvoOLYz0yGTU+dC/ZPtKMhj59DAg+DEe5veQ0Ir6pbIg+sZAWP3iyND+WRqI+C1sIPbfIJ73M
+uW69QcoFvmQRjz3hGZs+36c6PnGvDT0ss2++bIKhvXxSrz1pepm9CXpwvbWPETqrMNI9HDwcvaHf
+Br5E5EA+6XZOPq0FJrzwPKC+erXWvk9A772azwq8FiSfPVanjj7zyY4++to4PfgSqL7Oyo++kDkl
+vqvsE73cgLG91MbzPcUBUTx3+yQ9lF0Hv8ss8b57z1m+iFijvU0KS7wnU9I856j0va2G3b3ElRu/
+FfS4vjMYD76q1sC8XTDCPa8g9D1dvri9kHwrvakDCr6+0XW95KspOiJnc72PsB89YlaLvO2ysb3z
+9YU955GVPmVAAT/cpJM+IsJQPljzBz5pAEe98R77PJa7zb3/kQ8+xvnYPfEfSD57LIU+V/6mPVAT
+LzwpgSs+MkdevojPJL72/4O9dK0Nvi8yND6i1A4+BiNyPWptmD1J8MO9WphKviexlb7NvEW+0tbb
+urwPsrvTwCu+oahKvo+u8L5Ccla+nrWBvpyNeL7v5Mq9iM8PvmPSur5jAh6/tFiYveaYCz1FZO09
+KCTAPIjtmjuVnmA+/5PGPufmCz6g+/+8de7CPanUoDuj4XI9A9mlvbmapD5s248+6xpFPF9VRb7d
+EcM9JnNvvc1Ihz35Y8S8rzpJPvjDEz5CPoc9QdY+vlfdjr2MPgW9Y50yvsYDiz21NlE+VI0KPiUA
+v73q2yA+rWYzPuFIpL0PEAY+2/H5PCVayT3ReGK9l0TDPcjboj6D3uY+2VduvdOzlT3+IAy+u1sj
+PgJIob2r/gU+I0vvPmSmpT6812O+WjgtvpSb4znzvSS9PYAaPnJ5rz2uyFY+7BSwPsqvnL40nZG9
+hzIwvslvHzxEa7482osaPuyOjD29jlA9YpadvdYPm774kiG+pWsyPvTsZbsdEXe+9O1IPm2woby7
+7K6+q5HEvlbMzr7Y5ck9+r8TPiw9RL6gfyA8CCD/vsF6+r707S+/+bmOvgLD1zw4wWs9ICgfvpAV
+gjvbOgs909C6vqpiMb//noW9ua2sPJxN4r3awHq+tXuMPcw8Jb02ZRm+mzZuvi+Y1r2gXtG9yO2K
+PZ4ow7yhb469UAIEPue8bbzXdia+12ucPU/ynz1PhDA+4qWNPaEGHj7sZFs+CaSxPDfGL740H+I8
+xjlJPTBeFj2V0vc98EKEPnZtgT65oQk+a3uHPWwher4eCdG9CMZ4vamTIz5Br2I++UTdO5OhtL3b
+ui0+oKTQPftBtbyoMB2+jM3rPHtqvT4OT+W9GU6KPfx7UD5KBqs94DX/vdHtqb7G/ia+Het7Pooz
+DD7xqag90HMGvrP8h74j4wW/ar3/vgT0I77HhKs+Uo0sPicrCr6EY82+4ENEv/GWRb/OziC/8Sia
+vQE/d73J3GA8tForvpiXjr6Cy7K9syp1Pe7rtD4PV1E/jSiePZ44GT6Jq2e9PiP6vXfVQz7MeJY9
+BXmbvVXz5T5kXEo9LeqqPStslTxZPMK9r4mlPRvSAL49ewS+8BtaPgnktT3FUjQ+eDokPuc3hz4f
+T9c9vCeKvYSnib6gY0I+jS1rvgJuBT4hE8899kooPbMzi71ci1g9BhB5PrNRGj5MnQo+b+GJPZY+
+Ej4RXK0+glzNveIDzb30joo9w3RkvGCXl70YGZq9RPEvPTHHGT7RLDs+tWpNPTCbGT3TZek9jWTa
+vRsxm72709C9IrxiPhCZjz73p2Q8KTcHPke+m72+EtC+0vUgvuuEOT4WkwE+YIioPQfBHD6NkVk9
+EY5HPmy+372dKza+9tn8vagdkj09VLc8CfVKPV+cFz6GNWg+ncu5PNKRnL0ICBi+EJGqvUO3rTxc
+euU9geXEPXiZHT7c4Xy9lZ3OPB2Nbr6EJ5i+0DuYvF0rJr0UoWE+suEaPudM2D27nOC940/qPQdg
+eb3nrA0+Ih88PrnvOD729cA+df8dvtDg676wq2u+OuGOOonFLD0Hm6Y9zbq+Pd0f6D1SHvy+/sOw
+vnBy177b0TW+KiSoPTGHNz2OnA++jjEsPAjD6L5LMui+i90jv3/Ujb50E8C8unu0PfCFAL7fDRi9
+M9Hovoxe5b43pvy+xHPpvYak/rsEIQQ+ZreFPX7qkj3A2b6+8zWzvrce775mx2u+4ngkPqYVSz7e
+hg4+wZkRPjOcfL4mCw+/t4QsvmWoKLzxzAs+kjUrPovIkz3ZtDs9J5D5veu1jb672Ja8SWQ1vTK6
+Xz46LNI9UiCWPdRsaz1gTQe+t8Oovjs1pz1msKM+0N53PlxYoz4pc9k+ZHTWPvzZF75fuE++c8s0
+PgiQyz6dUq0+c2zRPsvakz5Bu7o+KECpvmevb7xEmpI+fsXUPgRX4D4VeBY+QjcsPsCRgj6+84K+
+lzQnvoVp7LwEYuG898i4PGNcMr3Iv6W9T/GqvajejL7wAJu+WLJrvq5xkr5UVV6+u8L3vXSWiryb
+mXy9aY0zvs0Uwb5r+bm+0nT4vYC/gj4DSI8+a/JKPQBDHb4Nz648+/EavEEcTr1RVuM9cGBIPkmi
+0z3Z/f89aUKpvNwWS7z4CSi9I+69PIf3Br4UksK8+9cEvjqcO736QTa+VvMkPmKJaL3OYo48T/wO
+vtJjH742b929hiPJvI9IZj75lF8+ERgaPjYYKjwgwhY+hRDnvTMxC70x81q9buTEPDIbGz5zjC8+
+LVh/PgJnbT6THyM+sEbQvaLJab6qfdi9gDZzvBowcz77llU+NaVAPk6a9Lu9hsq8STQ4vo7HpD1V
+akE9p7ADP1Jowz77us48wZX6veF/kr1SBTO+W6LDvaVChL3aUrI+umeoPi3vpj5gSUM+UjGyPeFp
+1rwZpti++MkJPEojMD53clU+D2GePuupMT7VLnC9WhBWves2hL6tlbK+UeM0vs4/0rz0BI0938W/
+PNrCjD4kMqW8ysNGvko9Kz0Ray28RciIvcH8nzxZF008Fj8GvrtFc70RQ+G8T02pPh5fKz6+mzC9
+qB2DPQwBXL3MX5S+XP3lvAdKjb0GCUA+tqABPqk2+70ijKq7kI/VPZh8d71hX/W8Y5HQvCP3Zb0A
+E3A8ghoBvhiVVr4EIYk9FGIHPqAhTj7zVF6+s1L4vSUAuLwJEze9PeSRPdwi7rz30gA+pfIyPloG
+kzs0Gym+3OCCPYpP0D3URC0+XUKxPBQVST65rio+HgNSvjxGcb1Qvy29SZMRPkN2Xz5/9D89G8lF
+vaTXkr49+qe+K72svlN4hb1vB7M9aO92PggVAj7WXgm+ejuwvbgzo762eva9qU7qPN5oE7074xI+
+zTI4vUaglT3hKTS8NejbvRNT473ZRUI9H3yKPYQB8T3AlzK9eeXrvOlrYryVfby93CPOvSeDxLyw
+H1K9rnlzvPTDVr7yOWO8sDNOPWC8Wb2jCUW9rBXVvfvK6TzY/0C9XTYrPQqR1z2dmVk9rTmnPL1I
+Fj3os7Q96ebPOr+8ub1hRAq96OnOvWf1KL1fFcu6G7+Jvdzovz1S+O69Vz4MPe7bzjyQbC69PL+l
+vX1iwr3yI4y9ZWgOvOEyk72BT7S9jkNjvWFhe73tKhe+dx+Wu3OZNr1gwZs9duHYvQ4qtr2I3Q6+
+PhHGPYPSLD5tUME9YbH7PeI62b33iBE8br5QvFipsL3PTPo8XOVRPR8yuL0hibo8zlxjPD4zrbz0
+kA+9ytylvaEibj1ZfUw+HXsiva5sh71l/zA8AQKUvZisB72dJ4a9To7ovO4qfj1BKSE+j0YgPdXZ
+arx92AW+T10WPYPhRzyTvWy9QOQbPLFEZ71a+wG9kPfFvZHt6L3Vc869I67DvVs7Pr51YTW9UO0K
+vvWlDb1/8cw9veJEvU5OEz3gHOY8WCYivlVOVTtT8r28ODYiPa/2bD0sDC69nnTGva2y6z0EI1C6
+76i7vfeFCL0tNXe9j7TaPIjr8D0yXJO9xDbyPLm8Eb0YZcM7oBfRPbYjnD030328VyANPqFZYb38
+J66917CDPac3FTyevwm9yDKCvfMzYL1TKFq8YgbyvZQgHT1VXna7qN3/O5cZrr1WL6o9BXZDPSh/
+dDupFnI+tuEGPqEyvzyCjzK9zot3vYGufr1Uyuw94riFvQ6JS734na48Ob4dPHoOaL04Lxc+5qHk
+vC7kEL6dfta9IY7SvWkcGj0tIxU+0Gf4vLTNF72uiry9BNbkvBZ4AL7ayia9wGUKvYT6/Tzu2Fi+
+GF3bvcrztz2toxu+nMHsPfWB4rx6Nmq9Z2y6PRtQ4r2TVwk9tlCOvQQYob3k4eq9ZnF2PZtNLz2S
+U7U9TKEpPW+wlTwusP68pzYhPZ9hkjwTuRc9uEwAvjIqFb1r/HS9QakVPQE3jz2Yl5K7E4yYPTT0
+jb39B6A95iykvEyBFL2oMMI8tSFFPhx8hj136A69THkBvb6nv72kUs88IagjPAnglL36xRs9zZCD
+PX6eF75SWAe7zG0BvcIb+DzdZdC9Jc33O2Brgj3/T+O8MCu0PKLkOr0MLh09Buk4vlLPnr3MZpa8
+ymmlven2yz2Ghgc+wIAfPRtsVL1VlFa9192mvTNJ4LxamqC9RrAdvjZFHz4lNH66Eoo4vlgbhT1U
+Cie9pQc/vTwPTD0jFxI88sonvHublD35aGe9y7cgPndzn73OtBu+h5I1vg5/K7/tM6u9YMRbPUrN
+kT3QAek76AMdPZ9ItjyZX7s+FcmQvtJUNr5CKB0+EqOBPsGb3z3v7p+9+iy8vePJ5z0QHne9OI/r
+vcLZRD7kU008Vh4FPvZ7MD04zhI+CU9fvv7OVb43JCS9RcgtvmjLSj6Bhwc+udSEO3EACD4erIe+
+A7mWvmeAxb4Vgbe9av4fPHLwZD50OcK9JIIHPmj8dTy7OFm+JZxiviikyD1TS9s9a/tUPm2Lm77i
+gK+9mS6nPY8mNb7LsA693ZwgPpbF07y1mEq+oksQvWp+pbvLxAU+svn8vQVNmbwP/2a892u7vd/7
+xb6rU9y+OHoAPh9HKr2D4O280baKPu+Zoj6FgyY97f/Tvaj6X75M1EG+Ao8YPVNzST3JIWA+awOH
+PlmfYbxxTwy+4u/kvVz/jL6NjzC+5GjyvT2plz3NCcO2p7YUvgw6tL7lTAI+qZlIPZl6E75xRXM9
+R8/5vYg6lL5ZOnK+erktvbB7Vb0sJ+W9IVsNPm9DkD5xDGS9mcdRvig38LzLMYS+o5d5vuS2iz2R
+6hY/swv0Pk5aVj0Dmb48MXZnPSLhtj36q529SAnwPNj2wD75oiG8F61/vt6ksT3BzCw+0Sz8PbDd
+sbxXwVs9bGHAvrNwnL4+VSO9feyPPcnSkz54L14+CN+3PckGVD5l8kI+AugRvp/Pur5n0hS+0g/R
+PQgNLr5VYHi+OG0Pvl0ckD8r6KU+JYUuPNqpM70lWQm+6g52vjYcq72cGxC8uBiyP2QG7j6MoDM+
+1qOxPat8Bb3ATcG9MeZBvqR7pb7dGYs/2iMgP4iW2z7kOq4+eGqoPh9mZD4Fhf++HneEvx3BqT40
+b2o+fkeWPrRSND4S5iI+ZIXMPJkbdL4aruy+LSOAPrpZ9j2D3009DVUOvSym3Dxbdgc+Unb+PRtt
+lbxbSN89+EwQPt1beT4Ngny6S7IBPsqcFj0txCe+VXkSvWOliD60ZIY9a6fZPVZF6j04ZI8925b3
+vU3/kb0hr8c9vNuIvW56Dz1HB0++8mgFvuYIbz0koRE8Gb6fvZIZMj7UH6A+V1QhvLojLL5VTGy+
+3npLvmHjSz2lHnQ9aZPDPL3DyT5wOro+g2XTvJdmUD0ghYu9NNIXPVRBAT6e95Q9BU6uPQh4uj3N
+3gE9Zk0MPdXiBT1ZzjA9bnAmPizvAj68BJy+uMyFvi9XjL75IqC+DqkJvozOuD3OVgU9BvAFPrjm
+hr34zkq9dDOOvb/eAL7A5Ja9sMIiPQViKT4ygDS9ytqWPqT5XD7fHKQ9IjvePYVoVb10tTi+C8Pa
+O8EqtD2N8os+q3QjPr3XTT6wliY+iktxOzE0970y6LG8rTpCPm++Kb8FQKG+pEoLPRxxGr0rtKQ8
+F2eovuLsgr51TLi+O5PGvapl8L6dw9i9oX0Avl/fmL4SBYm+JTTLvuqWKb9iM5s9c7e5vTI5iT3S
+LUm+49y6vmd9Ab9LIoO+osyrvsTEwz4nFIE+5ZhHPm/SWb02MUm++76WvoSq0r1kRE6+wa6zPm2/
+wj7FFsQ+qhT1PezPmL2n8am8mu68PVF47Do8OkY+bGC4PlGbAT9k6Kc9GVeEvdtlnz4fVME9nYOI
+PmyB2TwW2qI+kQ9YPgS5vj1++fY9vOO3PgbGuT6TVpQ+yNe4vDSFPT4a+JS8iv27PDx5Jz5XFOY+
+kIoSP1yxQT44XWa+gVJ4vnLZB77iJoK+/YEDPtxRkD5NLCw+J3nLPYOgpz3tytS9HkCrPOo2nzwu
+gAo+s0uGPnUy1zxP0ia+PEgWPqQtPT2dlg4+q4VVPvfJSr0C3vO8JeHdvmhCB7+ir0g+ay+nPmUw
+wz48j7M9U82avVMwBL/5XtW+dF2bvqMWkDzuIwQ+f2owPg+tjT2pHhe+9ECnvpglB75zqj2+Qchz
+PY/KOD5hL6g+O+5pPaAKDL7mC9S9tB6LvbOaTz2bw728lqjuPYD0sz7xzk2+K+rovTCONz3+nfs9
+C+Q5Ph1Jhj1K6AY+nRUavs50XDz8kbC9PqOgPci9KT5hikA+s6y4vm3QC73MDba+7RcHvuSCFb7/
+eLa9E4GBvlUMRb5JvyQ+MIUGvtCTZr2VPoC9pOF5vrmsp711ZqW9oMNcvl77Hj8ylsk9VdxyPHzg
+4j12iXY+Aj8JPgEfBL5c1n29eE8IP+wyY73KoIS+nQETPa5z9z0MOZA+shcMPpQ05j0tAwM+fQRo
+vmDuV70xYUI+Oj6MPj4MoT7i3BU99sASPuKvtT1m1tu8/iMRvjhRDj60G1k9jXz/u53ir7ySBrG9
+F8eavdPoMb32Fn29Pf47PMsFdL3b31g7x+XyPX9roL0X5Ga+Yl6hvVGu6L2iRhs9/aSEu4A0bj1L
+3YI+lF1YvXSbk70HrGC+0I6UvuIyGb5yd7C+8hYOvyosu76AtwC/Oo92vnXSor6xVrm+avjvvRRC
+jb45raa+tsnvvnmAt77geFS9tHaLviYbrr6rs9e9Tp+AvSkjoL0cQA294SacvVqN4b1b8tu+pGIA
+v20MMb53CMY9O8a2PQkCoj4w22U+NbVEvd+GBb4wiYi+VH3avi+0BD3QA0M+RXp1PmzUyj7vEGS9
+HAcnvdKzaj0T81++qyqHPrY8aj6f+Io+EUdCPh97eryrAYq70KSJubPfXj5PsSY++JWiPngHyz4w
+KAS9BnTHPI5aGT2zZms+G6/rPdk8Oj462G8+EUFtPmSH/bupJqw+1IAfvrV8Uby+NPY9T7WtPhtU
+mLtslQ6+pBTPPl87GT50naW8N3+qu4AWRT4hbYS9FoUsvnwjej0hgdg9RAYCvmaMfT12sx29KrjL
+vNqaNLx2LBC7s+kcvuh6i77xmYM8/cGOPnU3VDxCVog8i+2BPYkbpzzngAg+5XDHvUmu6T5Rr4Q+
+2mqnPYrSAL1J6BQ9G6cXPk5YLz482ZE+tnFDPlFjZz5wg7E8Ff2jPQptLr1bLEA+oG6mPojokD6t
+uYA+n3pRPZxKGr4kJB0+7VQSPgkkqz7fyjw++HSdPu3JtT4KWPu92+kFPRhFBD6ImIU+bSiRPuPl
+Aj4wg+g+ZfupPYpGMr6Dvqi9ias7PELuDD5EyJI+Es4DP7Sn6D3W0ZM+HOsEPvOD1b3RWFE8d932
+Pcl/6z1Lfto+acbgPIDxED5H1hE+9e6TPfCY5j1P9HU9Omhyvp79Eb60nru+SiCCPltwXj2nsYA+
+IymmPiNSgT3DlZK+eJE0vrl9q75gVFC7SF04uzJWzT2NACY9Xj0cvJk94b0U5E48xOK2Pbapzz2w
+fr6+WE/2vl2xyb6JP3u+/4Ftvc+s6j1HA7k+4pL+Pf2x475OYxi/ww39vvEDrb6Dpis8em3IPG/0
+mz74LXw+wyfnvpy2Zb7hWqO+bgqGvs+2tr13luU933ZWPDx/3b6P1k6+Fewavkf3D76FE2W+Npz/
+vI0bVj2F+XW+F+uUvwl1nr79GOO9FBOwvazJFr7U5Si+l/ClvZwjCr5tmry/1ygav/GDgL1CUmc+
+ssjVPDDuhz5TNog9IFfVPiQtaL9pQJm+M6u0PmVgFz/rW5k+Cjd2PmtQ5j7OxSw/IYyOvni5C76l
+LKc+Q6YZP0zNkz68ewo+hOl/PuPBDj+2QJq+7mQ+vlFbFT6+Ef89H6rKvQzeiz2wvF4+BFWCPShd
+jD1e8FQ+atJNPf0OAb0yJCa9bHvcPZNshj6W6kY+b8SBPgC8gD5SEE++WdLjvRCAHr73vuQ9nSCt
+PjONvj6qOz++phanPsmEWj4FziA+roOKvCVXC70QY9K96hWzvQAxvb7BTG4+wo7IPM9oTD5z2IU9
+fOorvuTuNr2vTm28ufnyvmfxhL5c5AS+iJXdvToGFzmKeAu93o6jPE3pvT2iUCq/ce2zvnLUxb7i
+kFE9hKIjPdCRv7wOR1A+LZyCPs+nI75SRzS+8wxCvkF0m75FFdG9P22PvfeyND7HhP4+V1KQPQtg
+PL7cD5a9AluavvaPzr5XxIG+w9c9vTtKyj6izWQ9Ui8cvrpUdb6Gj/K+kDv/vqy0nL4lQDW91vQJ
+vjIsdD7KO1W+upagvsHmr74Fs02+n3Fpu2P8Mr6qKtQ+ll1fv8x3Jr/yjnW+UhMJvfgywD21by68
+nHYSvR1Zdz7ibjY+qd8kPTYG7TweZwY8XMibvVH7ozx5LSW9d6ROO2UusD4yd48+qrRLvfyQir2C
+DVe+INBOPpFNjD6uFnM+1ynzPcSVFj4u/FS9ady8vUnVCDwYI8A+JqSEPqPobj4HANy981EFvQ+G
+Ab3bojg87FdLvRok+T2LdR8+GuIRPe8+z72Dnl88gT0LPA665r2QPsK95QgtPhV6V73Q03i+P5nZ
+vTDnpj3ZP5Q+eAynPT1IRbznmRk+BnfiPA0gML5s/u08yTraPeYb1D3S1A8+H8jrPY6HUD1NiAQ9
+Ur7svtaQ0r4aOhm+fGrJvcdFLL7Q8m2+OPhhvVTTSr7xNP28+nbivdDEnL2Iruq9v9znPT86cb7l
+Egm+DYeWvlo1kL7m/Ve+bzCvvLnAGD3mk089u6VRvspizL5y6YS+W6lJvdgj/L3WZLQ9Eph/PtaJ
+3z1agok91TkcvnfLD74J0hE9OsDpPWTs8z663U4+I9iwPWXU57s/zc097A/ePcquw72HG2k+KJ2t
+Pj+9T7q1Xr+9fohRvseFgTxmuGy+kSJQvdvt2j2OzZs+MDuwPWOSKj5JTbe9iwpMvrb1970xCUi+
+FIa+PCzaJz7jYCO+ldpIPXe7Ar3WwM692yWTveQTQr3sQVA/jkclPitcDL7ERaq9gvLGvVeBMr3I
+YAo+jA3mPQVk4j+kqSs/hB3lvZdZtL0luLO92VmWvVX0yr0ruIA+l/kVQDAIhD8/+Ho+Pj/2vSxW
+k71O7Mg9G8RtPn4iu72BVCVAy2WoP2KYgD7IGhe+2N9IvpjUgzvs9cq9/5UzvySYpD9yQjQ/mMwi
+Pnvou768YWq+w0/Yvbl6KL2iHx+/KKE/P8B7fD58YzO7aSZqvj87ZL5goyO+A6KBvn1ug72SiuA+
+lpnNPV3tHryZiee9A/LCuzpPf76Sf12+iemhvWYhnb2fP8S99uuWPEHpKr7c8/y9zlVQvoZ/672A
+p+C+iDKSvfghYb5kCDm+ElSUvemMGzy4xgG+ht8GvvpMeT3gK28+s9QjPtutQD3GZJ49xT7cPXAF
+yb3oFe29tmHjPARFjj4gMbW9cXNhvYMIi71wR/w9BxOCPnv9MT7rIaA+x7ivvQ9DRb34LGS9mMQ/
+vdSDFL7drXE+1QfgPQxVUT0SsCS9ov81Pf3D/72nZP+94zzlvbLVSzxnec29LNVTvlt7ID3pEPO8
+0HqbvRT5rb3i8BK+4r0CPlVYsD1ZNR2/+HcdPqB58Ls3jJs9ZEfxvXH/jz0kSIM9JsQJPjSvzr1a
+dgI+9gF5PZukjr1MDJ+8VJqqvTATiDyM0Mu9gviJvvSj3bwBMpe96yPcPD+PjT0bp6Y9We4RvWDs
+m70qhlU8h+QPvcSgZT63z5Q8SC1CPHsfgL0sh0+9NzxIvTvITr2tiQE9MS7vvVAXTjzIj9e8MCPS
+vdMXjz31Ivu9BPfvu07/GLw0/hG+7eY3PZzr3zs4kR89jxqUvMuPNL5PNPC8bfcNvZYp0T14hcA8
+9Vq5PYynb75KVi89RFSyPL2aFb2hEwa+0gfCvUj6cr1BBd28jrGqPZGcRb0IfWg9GoI+vMijgL0A
+pMO9X94CPWy6tr2a2CU99UuSvuNRiLzEFeq8HN2lvWhgE70L/Uk8SlkVPnHuvjym/G4+3y3dPbfg
+Nr0/y3m9ZeSrvfNMvj3yD8E9WJPxvdY2vbzxjg+9senhvUILkD2lhQ++t4AmPZBtBb2JntS9kIGx
+vVwBsb1ZzxO93vauvWmrpT30S208eBg8vbWkPj1+4Ns6YSamup1U670g9F07qS4LvhsLOTwoQSU9
+z025PX22jDy9reO9Y1P4u7Iqhj3PiQe+UZZzPFYeszzPgco8819DPYQQL75QTDi9JRIrvTTd8LeS
+SJy9wJzWPTMXhj1W9lq+amKEPGUFyr02Y3i9011QvipnSTuRyeC9tbJXvu9p8rzOrJ08nxfGuzh3
+I77Vogi82d+5vS5eMz5Nhpu9JtogvK7eub2n6ra99o2FPLDmBD7OEfE8pZhOPOATMr4V3xm9k9pi
+PLQaAb7dX2q8wjPgvflcnTxS/4q9rCYNvvs6WLxdCoq+YwEDPhWWlDycMKi966IgvpmY8bxNp+G9
+Yw22vQGpTDzgKWm9OOiRO/u7Ar1LxZQ9XdkPPflzmr21nf+8DiZovh2LN76kDaU9861QPbCNFbyf
+sbi99ufovf5SRL44OHK983IEPU4JP74lfYy9y6CcPUZ4OD6qaTY97co9Pd1S8r0kUF09Eo9bvRCe
+fjyIX1U8Nr7XPaTN5T2tiZ28QxhUvocuF754q8o8RkyxvfMQI70IsQq9zpMkvv8Q5ryltei9uDqL
+u4qNL73P5Cw+gFDJvGPQh77SvI49aIpuvU19Fr5ajyq+zgUdvXf40r3dSRK+5nN+PSjZ0Tzyc8g7
+a/7EPUYqrzxwNAO8IHlPPGHK9T3WMlw8/IlfPrEvFz0aEE++reaeOJrFXb05DVE9D54WPVD/n7xh
+V3s9f2hCvP8ZgL3kZsa94D3uvZevAD7ufuC8burGveh0jTy+C9y9hCM4vseaQzxZUZU8AohLvG8a
+AD0p7xe89TjhPaVXj71bQR89jv9MvWLelD1AC6U9EEwNvh+nYb3+Ba+8OfU2PcLVKD1PO1W+d03E
+O505gjt46Ic9OYCAvnwJj7zmna48ApbRvbhZJj7PCLG++BcbvYBIhr7Vo1i+ND6FPHm8uD2vuY8+
+0b/pvB/b0b7xBOG+urm7vtLEF77Pmp88nBtxPl/4HD4oCkK+2CsSv4MpBL9KNvq+78IGPKxUBj7h
+oVs+VmW/vUkslb3XZVy+RAdRvjhlur6hY1w8HIIjPsY6/zwKUB695dI4PgVIqjvWOxy+73dSPXii
+Tr4EW4692HNuPVkJjj3EJIg+x7RYvsLWh77Wmpo99PCCvFLaGj6OQYc9djwFPhsjTD1lAJG9PtOW
+vVQs0DyjAmE9J+mvvd3uWj6O7JE7R6yRvk/hR72BSb89I76CvZwuVL7dK3692vanvTCdAr6QTIq+
+4AfrvO+MV75AaMm++qE1vm/9y72kPuu8PXx0vvF0gz0q4qy9Pa4nvmsd7b78gVa+gC1gvcZ6aTz5
+ZDS99FAjPaEwV75o6QG/MBIWvwpBHL0cHvM8eQnRPIOG0L0lQg28WevRvtoxEr8AcrC9yMh+PVHM
+hD39Tn49MOrAPBIhA7pzEy4+OAmkvK6gHL0nc5w9OhUUPt4c+L2YBhe7yuOYPYlsgT6BsyQ+6wsZ
+vdYGAD0rKHQ9sXR9vU8UKz59w2c+2tOKPsaAgz1dnsy9oOjZvTfDCT4Wpck9OHA3vGOdtT1D4Ro+
+r47dPRojHL047OO7qvtLPWhOkr2IOeO9fCXtvdik0T0bQJ49zlOhPVqiSjyIZjW+6czSvXeLwL3t
+RHY+Rt+KPpluPz7+DiE9YekMvf4I3b3/mhy+O5cRvs9zGT6O0d89G4+HPVObQDwzyF89YJ1ovK2/
+E75SO3o9tQp6PoG4ub34vjg9P+0MvvajKL4HSvG9Tr4SPlm5kz5zrwk+iG6VPk5bYD52cDI+6Osi
+PR0apj4LwJY+T6OCPRUifj46A5w+wFvVPnx3tz6gTpc+LUfcPU7JBz5cKz4+HDB5PgzDpj5EJqY+
+5kBxPQyyOz44plG9PhlPvUdij70chi69ZKbWPsrMHz54aRm+++UHvQVKKr0CiV+9TyeTvoC0GL3V
+KKg7rVvuvdjIMT7Wqnm8vdIEvh4vE768/7w8+vyXPGhvgr7Xmp2+GhWVvvkqWD09dhY+w90mPHg4
+ar1D5Cu+Egp8vkwEu7674IG+xyxoPV76g7xbYuo8pMsjPd2Asrxvuvy+reb7vidiFr7Hzok9ALng
+Pd5/zz1Cow0+WBGiPTmli76yH7m+oOCQvbVfUD78wNs9rSjYPRRWnT0FgYs9yI3vvYysMr4FALq9
+aktPPB21Gz5z0669QAZ+voZjBL4KzhI9wHM1vY4Lur1fIzm9tpiPPffQ3718Wga+Io+Zvg6OSL65
+ZQW9K3FhvhRRG742bjG+2fMwvfXgj75lDd6+FIQcP+1tcz5YFJm9jQruPJYqIT7a3IM+ur+mPWv/
+2r2/9bS9Jn4LPvjLWr0L8xC+Uar1PA0swbwx+jI92SITvsS5Xb0exZ29tURavaQL9728Fxa84uoN
+vjg0ub1vAum8dcUIPWp6MT6DD3k+9FWFPSz3UL4Ce7i9RnJ9veaslb2N/3+5zwIKPuR8Az4arqU+
+5a3/PS8OdL1pmrw8m4PfvfvJ7j2SvUc9/XKUPVrIGzysqaE9F+udvByzQz63eYa+GCOZvY3YkLxO
+Xsg9fNgNvt29pb0k5qc9aGBZPhBHC75Wv5U+QlETPmH1ZTzKaPC+xzbpvuv7LrzMj2Y+HCrTPr6O
+X74Fmmq9hhKcvW9nBb4jJPI9s9rjPTZ8/71pvby9mhjOPXM8Zr3rel+9vk7BPY4DND1vPEY+oypu
+vKi4YD5mF8g92y7XPa64Ab0NaQS9Iy4XPeOadjwi78g99+8FPxw8YD6CqV29NPEmvr2HHr5C18i9
+ZIOwPbWIdj43Jjs+Y4Z7PYkcNz50s4O9OF0uviaMIb1KmIc9/0MtPeN93z2hk6Y+Vh2IPro3f7xj
+/6y92IUzuml5wDwU87s8xR71vdwhgj2QO2c9A0VkPfLCKL3YmHs7+psUPGL2LD2CJMK9aFJSvjxA
+J74ERZ894Yy7Pbu6JL3kswe+cFZRPWGBF77VVV+/sLlnvtkMyL0+svK92/pDvpjX+L19Wnm+qwih
+vhWTm79wkv2+S+povpQ5Mr6tqze+lWW2vr5VxDuX+LQ+tVjqv/RHVr+s7m6+kYGEvbEUKL7qUea9
+NpYsPStkBT/mA9e/FgSTv/ZerL7ZOqc9rncYPjYo8Dx/NSw+PkLXPAYnhb/rVvm+o0C5vgBFkL6J
+HoS+cK0NPmniBT8rOFM+LD7ivbDGzbx1AHy+Q8zsvbEevL5Wf6W9zqqrPj+XYz7SRJY+udUNviPR
+Y70T+uo9446pPXc3nD2x7c69Ph+0vmx9m72bwgG+JKgVvlsLZT346gk8tN7tvB4XP77SP9++Xq4o
+Pf5aCL4BVx8+kB4lPvvDbb3jZyK+F2ojvtQVKr7gJae+sy/7vaR9ir648Cu9M/D9PJ2tYj2miH++
+nnznvfmGR77RYUa+uOPqvfh5QbwaBWA+OA9wvUwfQL1QmVi+gImnPvOVHT/HzPQ+PPSJvTqpmDsF
+R6c9e13+vS1utL0QPIs+QgG2Pvogpj6Gr5W8ByuTvrBTg755qIw+tdo3vFt4TL5LN8Q8DV/CPSxT
+kj69Hri9nRwjPF+/Hj7KOIm91g/Evo9SEL13vtE9bdZBPrjH/T3XXZu9BxxwPEtlyb1z6oQ8ySSH
+vdUXlT0cS3g9yuDwuxAVsj2NKGS8z45evp3Mzb7x/IO+4jQevAC/gD6lvIM+gGMvvrGuD78gVIS+
+eyUTv8fXnr560wu8oyOTPVysHb4Mdwy+WlLJvqj9JL8+uLC+UedAvd0Ahj0srn+9r3HdvXPHkr5R
+8sm+GYvVvvDboL4IcyQ9YE1avTBTIb1b8Z29kssavaWWA75FMEK+O2NIvepwAT2zJmo+4AsFPOUn
+6LvHIry9QrHmvFV8i74Rn7K9NauKvbdydj61mJM+PQswviyQBb410rq+s1KuvrTSRr4x6Ly8MhGs
+Pq7MHz7iQye8vPY/vgxI+L5ZoPW+u86+vuvRAz4ZNA4+EmhPPm6HoL1YFxG+/HfDvtEPPb/erIW+
+hKzZvoUmSzxZt4k+d0urvbuWYL6g/5O9nvDGPYFnhb79qqy+bSs8Pm0icD3ejGa8sZUrvqILFz3W
+WLe9lfulvtz5rbwvknO9d0htPojpAr40x6K+YN3ovplbg74ju06+YqwhvLwIirwVoSS8tfKAvimD
+q75l23y+4FC0vtLPjL4RL4Y9gDL2PaZfwz3vyBi+WXFYvm+v8j0ssIO+A35WvovsFT6DMYM+ph/4
+PdWnQjxisDG8BkW6PRYlmr12Qz++dopQPlGpLj6DEMs9JKlUvtPBvb73P8S+f53QvgHCeL6ZtCY9
+3AVWvESOgj1efJC9gFmzvu1kp77sf8q+4+8EvllJF70P7Y6+YqiavdJqhD0NrRm+AmukvqZbJLxb
+l2m+sDmKvvdA5731qPW8F6cAvmM2FL52Zm2+dGplvpG787tr6UC+aEQcvpdc4z3DqV8+lD4NvrXs
+LL6yXKO+WjFYORva+723U2E+4+abPkKHjD4wuYY+uEuLPi7jrb5AipS+rI36vVKDtz5tuwg/zbIH
+P/BeEj/DYP4+SflLvozCv75IM4u9rTsBPwGG5D6XmRk/hELkPuol2z7Ri/m9eooBvgW/mz1xjr09
+LGHZvLtJeb1bBgK9R+bMPTusqb0PFZa+mXGLPiReZT1Azty9udcuvnWIWb4nM0S+Ipajvj5fyz1a
+cMe+JdZQvUmjAzzpDng+UMmdvmRWDL/RqmO+X3qCvtkBpr6tbIi+/4FJPrGkXz7T1g6+lwbovnrX
+Cb+q00m+Pm4UvitAZ77+WqY9Lxi1PmfF3T5ikki96RnKvozYAL/3muO+stGfvvN0fbywwcM+vSDA
+PtL8ij55BlW+bOKFvlN3Lb5YyqS9yOqlvMyvXT5rvnU+cKBjvPx3X776wlq9rQX5vcOQbr55mZ68
+iVYyPewV4T2YAu29TA7evuGwqL6k8Ra9W9wfu+Bs97vbgo29+A8ovWbDpb7fus++jewHvp++o710
+1IQ+1icaOxSB7L1XDhe+hPQYv6Jv3L4lECU/HFTvPp3Mlj5Lq+u9vpc3PVYaSzx0qbI91j1JvQB6
+Gj5y6eU9EJ12Pj0gDD4ZVCk+lyMFvDmnoTxijIq+jmSPvjnHwr0P/0E9bFJIvWq2Lzz+mjA+vcuY
+vM2hFT3xixW+LDV5PdX09L1BSRC+RCldulkPgT6yoYY9fbJqvrkMrL5u90C8y4qYvWIbaL0Knze9
+Z52LPRbESr4NVai+NgCbvS1Tfb7hN1S8AvsEvv17Gj7FMSo93CRivgvSt742B42+VhuMvVsrXb6/
+NkK+za+OvY6+4T0DtdO9JlvGvpnrcL6O0Ni97EFmvvYOdL7NqWO9V56hPQ0nIL2lfbS+oybDvdZK
+Urw74BU+zMuBPkNAGD6N6zc9+qUTO8Mcsz5rB02+//H8PfmnLT7NH68+z2p6Pou/8L2HUqM9cGlT
+Pd33kz2x1Js9wKS8PXnmWz4AVDS+meEEvaYBsz2HiAs9wxdhPZ4EQz044cE+SO+6PpksiDzHPma+
+jokFvNpzujxaFN0+N+oCPqqicT2AXXg+HCcnPu5cmTlbSAS+uzn7vW/3zj04e688/fUqvk6PHz35
+rEY+TcHSvZSwCr7qZ0S+J53cviMMqr4Z9Ji+KqAhviLVBz6NSOG8vK4JvhB8w771ZL++2TyQvjUW
+wr6HhCC+r22ePGS6ZjxFckG+NVJvvnfHJz15DY2+LFY0vtD16LzM76C9Ls1AvmjkUr7DFSc+lf7M
+vs7Htb6+8w++ZA8svhCf0L3h8im+jracvk+jkj1c3kC/SFUZvwgLIL+cSFi+7/GIvh7mB7/PfRC/
+OQftviDAg7+yY6S/2cwfv60XkL6D5Ze+ZYA4v0FED79MYS+/2nMvv+rLG71bUrs9ucAnPkyE8b1v
+YCW+0e3pvWfGWTyJ24o+pOHZvEJxSD3MS+m8m4fMvbBuML0JYgk+/rfcPk6dYT0uUFm+ZmT3Pbcw
+Pj0r05k9Wy/uvJMWqb0Vh7U+twSUvlJ1Tr0cFnG9gYHgPRfCmbxuV0O+HwzUvs0xpb6PLVM+ytxT
+PqS1dr1sFc+88XgyPo5QIj6tTz6+bkvLvW5SDD9Vnds+ZY+/vDb3/btMET0+DpNmvLzdoT0CTqQ9
+VtMLP/qvgj4M+Tu+bTS7vXZj6j2eGDY9XNMgPdGVWz14P+k+QB8HP2YCsT5MRLC9pyzJPY0eCD3V
+5KU9r1zXvR3Elz7ffNQ+vbnPPbTOUj5y/4+9fklmPaDuQrzxjWu+HBE+vpEXkT0Vop+9gjIxPrhL
+Ir6rDT6+mm2avtNXHr2GQH08pQNUPTFW6z2axgC8Rgu7vDg3Gb4J3Jq+IQpMvc0car51vCo+MraU
+PYyj4j2B1ny9eK0nvgKmPr9GD+C+wtLMuzBS/r4QKFG+8MUTvYla/z0B7D68jsorvpFehTy3Ir6+
+8q3IvvrOu77WLXC9z81OPaWwQb72DvC9ARwCPjL7Vb6Jihq92gmGvcQKMb1sfwk+kcgxPcIwqb3F
+E9I912WvvsEarz5ztBY8x4sYPsU/4T0/4tg9c82PPVEcPz3heZu+JS9nPqvVQL1AtJI95jNgPuQO
+mD4ILiM+6LyrvaObJz247rY954i8vTG8rDyT6VA+6iw1Ptz8Sz4PAyI8Ch6FPkrm9b1ifUK+vHk9
+vWCNxb02AgU+EZ5dPhyIhztTEBc/zxhfvec/CL5Po/g8RQMePkdaYz0QICw+WVGiPgqVZL32mMi+
+DJ/8vWY8/L00/jK94uc6vrK+m74oYsu9PPKhvep9VL7igYw84nkdPcD3yzsS7+y9NzoAvgjvlj4c
+1Uq+ygNGPXT5ST1GLhW+WaFvva2mEL5mBgG+lpMCPrQMHr6EnIU+iOW1vW0xpb3jOHS+P2pIvoU2
+zT1glfA+FPY4vltHTz7aSzs+m+Hfu99fYL5WHWu+3ZgKPhHcK701kGC9lFejPuRb1r3muD2+CB99
+vrLoGr43pis+4/iuPX8Lnz5c84E+QX2FvjHHqb4jOmm+9BvvOhx7mr5jBbC9BN0CP4OoeD5znqm8
+XgmEvjJCPr7jgYC9QYUAvtm8lD2BooY9uCwhPuWJ4T1LW3K+a6YgvehNijyjv3E+GXoQPxb7pbxY
+5cK8cCPmPUkS/TyTvwM+aKVYvRvlsj7q+hA/iFcFPkMUgzsDs4A+/kh6PQBM6j2j/M89IveLPlfu
+8z4tDqQ9f+66PcKhGD5bKxw9ypw4vsCyKr4PGog+HgouPviyGb6Ytjw+Nu1lPscuvL6QZDy/CUMP
+v1RdG71Nj1o+hkXZvrA5XD7F8MY7MTlWvl157r4tTPW+LvL/ORsb3L02d7W+i0lQPm6ZcL3Z1WC+
+nsUmviBDCD3VN4U9dCXNvgP6H72krzM+jpvVvaeflL4FxpW+rOMbPuqdDry3nYy+XLcRPXd+C7/d
+zFa+R3h4vuFK3zsbiye+kCWIvqytoD50GHM9n0apvm44Zr60liq+q8rWvT3vDr4w0Na942BMO70M
+Pzwz1F6+xYPZvmTkf778Y128xGcZPqU9+7xm8BG+DoUCvlxkUrozwdu8kQ4zvsXM6bz48Ry+RTig
+vSRywr1tcpy+FnZ3PoFsDb7DUCW+t4rLvbwDDD5eRNO96bZMvmEaTjxxBBY+wSVkvTWFj75SHIe9
+bOvfPWRt1D1vTbe9uRklvSb3mjzrtz69oM0/viwnZD0TIMo9yBObPg1CRD6ZIeY+0XQivjWYm75V
+UUq+ghdcPP1F+z1KzdM+SqM3PhS2kb3yKyK/cyIhvlXwHT7qr7g+RjoIvGiQuL4mNw0/3lAjvvhX
+5775/+e9ytWxPlmG1D5dN4+8mZyYvqBN2z5MPrK7Pa1pvuV5Xz3axHc+duvzPZJenT0CySK+lfCc
+PvcxKj6NHre9tyaDvkFklDo1M9q39E5uvY3tgT7yl4g+n4nGPWIyh74bCZ89QYntO7Xg1j2h3RY+
+oaZKPkhP+j03YE89ojMUv9vmWj6rtOE9tY0bPk61Ez4/jQy+1z7IPbkvDT4rlhK/oBFWvduALj4Q
+iBM9dcSZPSYhi77ScRU+6SbMPkKt8L4oe9s8fasPPs3j/j3Vcpa98/VGvkWyHz9vw4S7UJMdv49l
+bDx/44c+olKqvSrFQr4ID9K+D8YJP5cjNL3WSh++UyAePlWBfzsmRia+wjihvv/9Br9Qfog+q5oi
+PuE5ez5ayS++Mf48vbKoZb77n6u+fAy7vm4xtD6HZtI+QsAOPueqob5DHDe+Ei2IvmZtXL68PSG+
+WX6PPkAEaz7F4Oe+FOPXvrbCq76DLK++lBwtvofbSD54rY0+yvtWPRPQ3b7hjMK+8wjJvvPP1L25
+hiq9npxrvHc4Kj4uqwe9KmUFv1LI2718fz2+HMHnPMsNobw62ZO9zykRPsL7QbyIQgW/GfdivlGn
+7jz5P2+9voS2vWVSvb6t2Ii92FT4vGxOcL5cwuO9oi7kvdNK4z0FiDI+Eg+QPufU1T59ppo8fMKy
+PVLtYb6ykaw9ssz6vcFVTTxbILo+X4qHPgHQPz2Dc5o+tjhxPje2XT7kz4Q9YtLVPUv0eT6GEMc+
+A5ExPkLIAj/8XRs/FwcSP+A2/T7EN4o+kNquPonJgz7AlcA+Uf1rPlh9RTtoEik+JjdrPsqhnD7m
+tFk+VlCnPhUdij5+reu9rThUvlzocL4nm809NvviPSaB5z1AbfQ9sCIXvlSqhr4h2YG+sBLSvYVn
+Ob5GNY+8NfesPrTWkz6TKwK++noqvrBDO77FVDW9sG5NvZPZLr5lt4O9G5piPknkTL7/Bzu/H6uI
+vjEfV7mbtjY97HsCPbDPz75Ivtg+6XzKPD9QH7+ACJe+pK9BvpFPXjwQt9G9l9fNPDjmjj7BcwK+
+8kuvvt6cz73cU9i+znYVvkk5aT0Prwg91CiePbOEwz5Rx3e9fVKDvsR/fb7BKpi+fUT3vSLMQjyv
+1iw+RDKXPj3o5z0i+ES+zYOQvow9Nb5CZxO+EsUhviZymz3Xef4970EEvuXNHb5KMa6+dWi2vpPi
+sr4qxyW+L1QDPvKO2zyTVLK+z6YWPakZQz2qrU+9lv2TvnYEib6GBRU/ll3Vu/pwEr+rKxu+8465
+PI8aKTwfHOu9V/KavV33+D7s4PK9qKfvO8bMXj2RCtU7redfvXFHBL47K6m9fSl5vvSbgD5vwjm9
+2h6ePNIKqjwvabM98zdHPvs1yD1b/uC+JguDPtNKzz03ios+XO17PKiNBz2+jk098/xLPl+v5r0G
+iXQ+Uu6EPVbqkz1uk128O0ZvvqPVA70gYtI97cDRO8UbqL6hS6k9xVkLvbT/h72zBdC9uhqjvXrR
+1Lw7ZJY8okiQvsKhmTo2nZI9XCyIPXIErjx1itW9icxyvduhKb4JncW+0cELPoAECj4pueY8D/2K
+vT0uHj5QfYs63bisvvFbUL4bS2o9ET8Xvomklr6eAN+9mxwAvf3YF76VUvu+TsFOvjUVMb7s3Ve6
++LobPpiagr1HgQG+xQt5vupfBr9uJR68AEPzut3UOT4+K1c+p3g9vVie2r719NC+eNYbv4btKD73
+4oI9TZ9GPVzmHT74K908PGR8virC5r4dSZO+BQwDPTyWYb0qhjg+i9EXPiAjUz24SQq+OK7avuyX
+2L1VfZw9cZ9+PiL0Aj4iDks+/qShvAVdFb5S/2C94mYaviZTiD5em7I+n27wvNW3WD5wGFY+ln8O
+PdmoQr4+8Tm+7i8FPo7aZL7aLci9qi+CvSTw2T33Yre9iVAqvnBAhL7wC4O+HKqNvtOxyb51SWs8
+fwkKvk56R71IyCq8gHP+vU9dqD7a3t69M9O2vrDVv72Qiz69vTzKvbzTQ74VChW+8mJtP1AwkT6T
+v4O+7BKkvaTu7L1VlTy+CXQBvnEo3D5SFqU/CnbxPtH6Kr0enou+R2VevEhK2b0tN4m+7C+JvqaF
+lz99kLk+/UROvgZsjD4Fpbw9pRauvcosJr8V5jO/qmgUP3wKB716rzY8rgWjPkJY1j5Pg34+hGKW
+vnagDb9CBMo+vL/qvQiS0b26d08+9H7hPeWlAr0694k9Y3isPrKKHz2tOFy+eHaTvh0/ZD4FRC4+
+1JLDvU8BMb72xXs+/INEvoaEub6f/Ci9w6oLPqXrGz57ADO9JiYSvmKtED7Xqb69GFabOny7JL7o
+V5g9tX3xvM7qZTzzsjS+4Qs4vp0//D1TtHa8iDFgvutvPr78jqC9O9eiPaToRb4RvBG+6hj0Pgb9
+uz3AfJe9oRujvGXdijm9zrM9uZSnPXfN8z3jLQU/hVQbPtpGRL1IAwW+5kC2vJHygT1EJ049sx46
+vVPokrpYV1Q9XxRRPsN6Uz7T24k+eUDxPRTV2jr6RH++ThWivfk9ubzx2u29NwEOPmDmaD7PRjK8
+kbkjvf1wqb11vb+8IyWquzbhOz1ftnK95ToVPO3UK77Jjd2+RnniPeX3X74IWgW9DPxBPhVwvz1t
++lq+/TDmvukonL6hFkG+wpAzPnkv7b3rgKk90ow+PaeV5T0UrPc8Ztr3PVPA6rtNzBa8vBLPvWrb
+7D2j81M8aWYvPaqSDb4fAEg+B4dDPjzuAL6FHLc9HyezPok5Ij3SBAk9YZoYup0dez5sjZi8Osiw
+vEwTBb2qyF+9ydMZPOTryTzsRpG9QpRdPWtZBb4gmBk+8A23PXLmAL0uwYg950YZvu/EJb2IAyo9
+iD/6PHFAFD45wDW92un9vc1sFz47fpe8BScavooLGb7s82m8sCEVvVmSpjyzqw+9tCTKPRxQLr3T
+p8e9zNWSvjnzHLzCcTs+cQh6PZ+PPj1D11Y6JmL/vUp+eLyOmIa+y9uLPiiapT7nFYA+kkXaPnWS
+AT9irDY+ZO2kvN/jCL3svAE80xMbPpybsj7BFsY+19PkPkvbmT5gO889UKY0PqbLDD5TYbG8i0dE
+PUDUiLwertc9+5VHPfDW2DvXRIU96WmqPeRh5L3IrSi+yc/rvvj4QL6tLJa8cjXRvQ/MMTtsqlq8
+XFFxvlbYnL7lCG2+RAclPDC0qD0bc0w9S+hoPMk+Bb5EnLA+mA1SPAv2Rr6e6Xa9foIFPiggwrwx
+VFe+i3UKPfTurj4HWpm9qyQWvmMnlr066/W92STlvXQTT77bO6y8JnuwPl6Mbb2gOvQ9XcShvVjJ
+d762kbS9W1qSvrwJmD1XsyC+OqL4vRhIAL46olU7vkmZPaSSaL5Tpie9iiloPjY5z75Lri+512Y6
+PkrsCz7NPwc9u4ktvpm1ob7nf02+Ag6QvnSeALynDT4+TFXGPfa1lb3P26G+WCabvsp75T20bNO+
+cdwkvbw12T4t6M4+spZiPiPlez6mawI/+nSsPlgC4D0Xx4q8x1RzPnzjDT4HMKs+c936Pmpzpj7t
++mM+kYQ7Pv7Jmb4OlA+++3K7PGwPyr09DhQ87vemPromIT4y1wQ+nNBVvu8FVr6ZMYW+agtZvu/T
+Iz5kLL89P7uTPmNYiz65k72+5grKvgres70glw++BgtfvmrWST2XxOk9pQlbvhYlkz7alHo8c6Wa
+uUShJT46iR4+iYbnPdPaFz669yi+ma5MPVP9gj7P8VQ+bGHGPO+Ykz5rWco9SJ65PdoHpL7zR3M+
+BnGKPlMZzj3OXpw+ilNTPdFCEz48S9W9Fs6dvRFijz6UTjM9Vv/1PS/Oqb3NU+C9mlcFvnLiQ72c
+V7e8lwuCPRBQNb5GOei9yTnXvtj+sL55KNC+iX6oPRycI71dZEe9q50zvhuojr5Q/+K+gm7JvhOP
+qb5oarQ9pLzHviHS+r015Li9CMmSvQWfs75VEEe+LxdAvoPz8j4wbA2+BmMkvuQMU71Rs088GFIv
+viAxMb6AayW9gOQAP+Wcgr6+9D67esBSPuur/rylDuc9UYuOPgLDgD64T4Q9Niw+vZuE7T2yvQA+
+c8Z0vp1GSz3kqUY+wXR2PmcY7L3RERW+1ByqPXjUrrzG3sS9gIcEPrgznz6j054+Fe4ZPe2kib4d
+FkO+vraHvjF4+72Or089vxTlPZT5IL1KmR++KT+WvmrOV76CfI+9KYHQO7v9ab401xS+PCBbvoxR
+9r59L8u9sH6SvNyRCT7BQQ87heZrvv9zjb5eqWO+QODJvm8yRL4733G9ENhZPldxuj3xyAO9HFl9
+vrJkPzxVEsa+eBr8vnnenL7CekW89NlAvuTxnb7GYBq+QIJnvQkyA7+jFF69a/UwPg5Xrz2GZpE9
+zuGyuxCvDL2SxOe9em2/vhOyYj1uyoc8AI1MPku+FDxnsuu8TyZWPiykoLwnacW8cA8UPuSnhr3a
+Ex0+n/0YvmKdAD6zNWc929sUvUyNGL5z2oa9oV+SPgVdRzycAJi9sCqGvTML+zxgWWa+88glO/90
+Cj+t5gs/NKV/PulKdT7iZLg912FDOjzkUr1z4iy+6U4VPZTzRb4KKJU9/i0HPjFRkj0VhFm9C72F
+vaJ/B75oQ9O+T0QwvxBqs77eVyG941P/PW7wMj6rM7y9Vj6gvskmJb+V7mK/MgG1vtkIwjz4jw49
+6bRKPh1Pyr0jQ/u+n8u8vYunWr70NJ++0seoOy8SBr5pqme975KLvWCPrL0lZ3I7T9U9PLLx7r0O
+ayK++Hl5vmM9BbwW7Bq+2G1WPsEzZz42wiu+OxY6vjyigr6U3GW+Jv3SvDn3Wr1kc4E+D7vEPtV4
+ET42ixi+d+ozvgThGTuZF0g+6ogqvUvqv74UZbk+VjuLPtDIIb6CcrO9uXsVPun2lz6DGbk+uOyy
+vfbDuT2ufRy+QdmIPaiUKj3VdJc8MbUIPk3/Dz4WzZE9JiYUvhUyYb4K5A2+LKeCPFwmpz1NGJu9
+EpeTvdWdnb26Z/6+QGm6vo5V7bxIszo+lwhsPkMt370GOo++JkH9vrFvAr/Jx+K9YDOpPN8It7x2
+ZSY9sMFYPlIqyj2wjws+4Cmqvp8b0b3fIRU+b2eJPvmoUjyOS749bnn1O2Z4kb20Ciq+P6akPQhM
+wD7Fhyw+rgQGPi66lzzUmk2984DGu9Y+lj5U2MM+HB4kPgDICD30u988F9savVFBMT6FOqa8T3t+
+PhzNCz5X26Q9ITfNPcubkz6iAq0+isCYvLi2mr28m+m8OYVmPk33sz73wRU+GAP4vBBOiz1Hv/89
+cggJvqzfAL0eaIw+ceGQPoa2+DxC3pS+qbLsvuUY776h2b898fYJvlZ9LD7/dU49ln5IPeAtSr5S
+7uC+EZRKvyGYV79w7/o6UL3hve0Onb3zqB298yyovRLNWb3PTh699pROPBH+RjwJN447wd0Vviow
+XL3hljG9RRAUvffPOL2sBiS9ZE0avrheGD2JKgk84NCQvbd1xzyx/cC9G6gZvYXxM7tHWY69BLq8
+PE0v/j0eQyU9AfbovTPpxT2VgaM8Bd9UvN0k6b0k5IM9A88uvedVHj1rhvI9Ng8vvs8h2j0cZiC9
+fTh2vBgrwDsCnBA8D/npvQ51Ib35vFw9AZezvXxLWD27ivi9SwCMPS4cnDscl/y83zjxvMU4zL0T
+wM69woOovamha72WYM469XeXPYgqg72rBlA9nBzWPEAxaT2cSXQ+tavevNefPz5f1uk9gzbxvYmf
+Ab39OTW+A7qXvd05GbwrbwE+dQgLPtD30r3I3EU86HtZvUUvmTsl9PS8MwlmvM8DTr0G2109208J
+PU0+zD3zfl09aiAxPbLXMbzZxPW9PcGOPaDcYz3mLIm9hTM5vidozr0QQoC9qonGPDr3QL3sGT09
+Sqv+vcQUWL046TK9Pv27vDEZ5L0LWo+9sgyxu17bGT1ddmY9kVj+PMRIuzyHhZi9NRcwvKDLGj1q
+4XA8M1uOPanV7D0tipC9UgLKvXsiirxYLo48BsDSvV/R9L0dC4W96VvEvGb64bwgRjc+EXnIvf6L
+yD2cYdM5ovuqPV99mD0Caoi9MA5lvb8Uq70NvNa9A1qrvecBID0N1Fk8ZES6vLgFdb3D0Yc9WB6D
+PEvpVz3MYzy9nXSiPQbnwb38AXi+lfysvV2psb0miJG8QicIPJyADb4JbuC9QwJEvMxL9byeAWK9
+TlZ/PPc4qr34lCI9DaxCvkvWwLwCxhC95Pl3PZx95D2yWcY9ZCCIPQZIrrzEpqq9DdzlvUbpCj69
+aZo67j17PbAEizzhHkU92bwzve8JGz2gHZw9GQE2vbxJKD7lCMi9UIgivWWNz7y5h5G99VgZvfOz
+sLxCP5O8x09ovBb2RLz2O6+9HtAfvQEqEz2KEKW9CXvWvaureT0QFI29LBDOvTV3sDywLsE8XGST
+Pb5RIb1Wfry90uCTPdBqED7zBdW8Yco/PLaB0j2lUr89V/ojvZeVtTwBva29IcsDPD7S2b29UPm9
+OHcxvm8k9bw86wG+jeZpPp9JTz3/Asu8aJ6dvVvANLww+s29JvvoPeJrUb7/Hts8rihlPaYPWb66
+xtS9W8K0vb/QnzpcplM9HUFsu6wHNL6Q8qc8RRwQPjlMqz2ui647oBwdPsdYu71QJ3M9lKVuvTaE
+S70RS0u9Fa+HPf0h7D1RZVo96H6HvMRbET20gpw9z6yaPKqlUD0LSD47K8yDO44HTr2w6oe9Aura
+PJbOGT4XuJ49PB1WPV1GqD6lYIS+Tk6Qvghnib2V1HE8CW2OPvNj8rzlgX292LtkPi3sj72pQy6+
+teOJPiGnJD5F5IA+vG8lPoHoQL4JTDQ+ABgPvsB96L0p7pK7V3/fPYTZmT5WCx0+ClCWvifDA7wW
+oYc9qVHyPcsWnz0z/1g+fv4+PuH/YT233py+v0Y7vQwTwD2iHty9fVwXvhk8Hz4rNbk9ciIPvs4n
+JL55d24+Ks7svRbX4r0VM5e9TXYWPuoIBT45Xs+9o75SvRHOxz6oDbA97bUMvf7QPL1CyuI9nm2x
+PgAtvz2/sxE+Amh9PixDXTkjESW9Zs1rvmLr4j0lzt4+qnyfPkvFWj2c2Qg+hipQPT6r0j2jt729
+RUUtvjksG78vlPO+2VIwPM4XJ71/4ho906Q0vqX3Yb6QPBm+M8giv6UECr83YQW9GXZivtiSKj0f
+cFm+ycQPu+vfGb7P/JO+pjQwPdV22bteIo+8SyiBvG82Sz5joOs8v54bPgObqD7NKvs+dQcWPs8L
+oL32x0c+uZxTPVMmbT4D6f49CO8QvoSxLr05T7g+cKoBPRx7aT16ZgM+v+kjPay5PL0qPUi+Tdd8
+vkNzmT2QKwA9q9RMvVyYxz3J0zE9xtpsvEkTSb4PCiO+fjKyvHd+qb7T94e+ipknvsgua71CRwc+
+RhqdPWmaQT70mbC+eWO8PT6UkL0uuHS+3B69vtau1L0MIN+9IPLBvbwJ5r7fR269e7Eyvq9Jlb7a
+un2+jZNWvVNcRj2Mnbq+Ptskv2Ktq72mwr29fkFLvNaTJb6emdW7Wl16vc2IjL5dGQm/SEHdvgmw
+fb28EWM8RFMCPtfeSj5VHZg9gqvzvvw8fbx9+Dk8/5ohvs0CzL7h3ee+QNLRvsT2j72obu++QHVf
+PrKVGD4Z1SK+LAaUvtgoIL6wO7G9X7PGPuKmOT5iM5k+3KARvQ7X77wtsgM+9D+JPji2QT7NtE8+
+QJjEPksFGj0kNKC9tWgGPjT1ez1dYeA9l8jaPQkqfj5HqBs+cNcdvhJhxr4NIE+6a6MJPY7SQr5v
+9A6+P6SjPH+2Ez5Scby9quStvhquaL4h/nm9CIZRvgB35Tys+kY+4rCXPsH9rL39Lgu+kiEcPo59
+DD7ZIz29kOIrPuh14LyyhyI8DL0GPgRe3T705LY+EJJfPoUyFD6e22S+N7UXv+4J1r4VBr693ytW
+vT7ebD5tFRQ+zak4vecO1L7dMDK/yx9lv9YdQr6fnFi+ulaKvr28I74JWqq9PrDivGECDL4YowG9
+wtMRviR5Kz1LchE+5qK/PdScz72lIqy8g8E0PkyHpT3kRiY8klJDPngdZD0hba28mqpWvo6BfT6k
+85g90K5MPtlTHD+OkAo/LjWNPt7tbr6STq6+sUy0PWq0lTzOVPs9jtiDvqSuT7xsQrO9NM+1vkMF
+v70sTE+8gYEPPl4f/j1KfLO+Fek+vuRkyr18oaa+5z+PviVSmj08ziC+mBivvejQMr44ilO8OxBk
+vucReb4s6q+8apduvVqWQT0leiy+0AwNPV+7SLtCIZG+bR2KvnQqwL29CkQ9eQRVPSusJbzNpow+
+h1mHPv88pL2Z1Hm9pxGVvnfFyLw5dCC8ufgbPY965Tw7isc8s3+MvvNNDL4mVJU9tNn2vYiXi70C
+vRe+QUknvWiYq770IpS++t4qvT/LMD0opLI8y1+wOW0izj0LhJw+yB5ePgKnLLxixc89uLs4PUPc
+Vz4K1bE+0z9vu/obBz7fY/M9zroUvg7Av722vi0+pd5DPioUyz1P+LI9Ssk0PlhD8b3Tm6i+JQtB
+viy0bT6n+og+zT8sPqKNtjyugNI9u2BYvvRRv76a0xq9EIaUvfFuyLvNdbe9Rj8ovoLePj5Lmkm+
+JUqIvk0plr0FDna+94IRva5Osz3CahE+GLK0vnJseL7i1iC9ECRmPargZDxAsBA+ltQQPtg3ED6M
+1MW+yZXrvZA+Er63Io09st2HvPFJsT0/0L49J2gKPmNnq74xyJ++NyqWvUdm7zyt6iI+Xd1bPB4w
+nD33UIy9hot4v3gS6D7GJEQ+cysOPvA35zsr9T8+tm2+Pg9V/b3fz+a//NKNvqItiT7oEqk8BRY0
+PUz+5D6Cfec9F+xOvialGsCxX1S/wDaEPplktD1JCB69N4L5vTqeaD4JohU+CW8nwKXme79ZYom9
+Ea4xPkMkGL7LIRG+WRWFPugJVD+RnYu/UzR2vsIa5j0MgAA+2gTdPa7udT2y9mw9bzQNP+RyyL5a
+jRU+xbyoPIZFJT6D5MA8yhdVPiw++D3Gdq49hBZLvjFXCb6jDaQ9GZ6ZPb0MKz3GfSO9MsVgO5kU
+NDzOAYy+5XWBvQ+HCT6ZgWE9LQyoO8qwST6VZho+O8NzPQO/ib5/0DY+UVUpPn++Cj1G1lO9fkik
+PYxnYD5keiQ9ONRdv0Iqa74zxO+8B5zDvaXXAL4WvBU+iLINPkXp1z0+Jzq/eXfVvotWKr6h0ti9
+puIXvnsEN7157Ae9e2vXvG0vNb40gQo+smqOPqReXD5qzBu+xGj+vRIHGjw+wkQ9PY6vPoQp/j0S
+aLY9131/vmVsO760DT++tvCDvfbVGj4TOp4+vfNBPUu1jT018LA73sY/vnXwV76+N/C+/ImSvUjY
+oD0ShNY9VwJ5Ohgffb1VQWK+KiiovXrmzb5Q2Xy/C54yPuGNXz5598O9WZemPYH4Ej4bnNw9mRvv
+PlSRgTyEgUA+f2P+PgXpAT2axXG92r9KvUbQBT0rpbk9/ADKvRbQ9L31T6U+yez7Pe1RM7wg9Ys9
+SlTXPLKKvb39zvE9mb4qvSjXnj4wgL88Oq4YvmI/a72kKx++bpbyvYyZ9LzzMew98RoOPtzxvT30
+9jG8SR8TvenLFb7GJWm9EW1mvbiVNz0RZYg+OMMbPh9mHj3e9m298hCgPV3COD70iJ0+eb8DPlJy
+OD4y0lw+xAlvPQmHwL7dvoC+Az+0PX8qTT4IA8M9a9G7Poyqbj4VNXY9Bla6vkQr0r5FOtk9gKI+
+Pg9NjD3fZLc+SidvPp3HtrugEoO+ji+zvltvyj67c5c+pvmOvYM0Dr4ZPEC+YmGovGifez6+CtY8
+r5G8vYR2Wb5ccUe+QFMcPR94zTthfVS9cAyvPoNBzz2Hreq9lk7yvd5/Q77TegO+mobBPF5bTj75
+tRo+ARgyvqfBy7zobae8tdB6Pf9xZT64YpA8NjV9PrPDIT1vdCa+biHXvSRgRDzHaSo+gypbPUf7
+wj08oXs+mUmmPUu4pT0qzBE+ikgCPj6OUb0W36O8jXYTPU5wxbyvp1G9jEojvjTq7j2vEzk+62uf
+vUsYcr5kQ2I8mQxoPSHPrL4sN7u+oOoEPvwRYz4JPkS+JHJFvoMngz2voKm8Rm9SvuSH5L4aeVu7
+x/cWPudvtz0bxbA8FLyIPbtwAT15XTi+QByVvQKykb035y6+RDGqvViger6j65I9/j2TPCBxY73F
+Q5A9mAV8vrzLu76B6va9UVjmvvj3zb7sShS+shoYvQzy1bvZYZK+zHS3vhCrJr/9ojq/fX2ovnn7
+U74w6iC+FKyrvaaRzz6VR5w+ZAq1vrIa+L5dp02+/5EFvqPARj5NL8Y+EJEWP1y4gz4Cg1i+paWE
+vNfUaL6sd5e94NpFvnHuLT7H5Z0+MFinvm2j371pGyY+ZBWBvo/vgL52F9G+QGbXvFwOOD7oCO2+
+Q3PBvUBd+T1p94o9Zuk7vk8y4r5ZzIc92FSkPigIEr8sBhI9iXa5PnkIBz+8bvy9gxcCvCWtoz3n
+2/g9GiciPl4q0j0e9ok93TiBPlXSpD2p+fk9NopzPQlJmz2rb9A8cnaoPVAh3720DZU9W8mHvL7g
+pz7mCYU+kqkjPieh9j3Weoo6xM2qvoP1ib5Pr3A+0S+5PpZMsj72hrM+u+TyvfD72L62aZ6+pXNJ
+vpuriD7Lxxk+2unZPMjjPz7ZCa29GlyqvhG2Vr4nEWC+OqsDv71FCr/ECFS+oIjAvTM+672k4mq8
+kHy/vU9ddr55jka//1Bdv9Senr7jeCm+190Ov9N7GT3hV809GckBvqigGL/7HkK/KaL0vARMc7wT
+iKe+M0nlvWpmYT4Si6g96cSyvZcby7vhntc9Eo9rPU5jmL5L1xa+XNukPTE7zzzgNxO+3o0Cvn/+
+hj6/g8a8EGE8vseGmL5oeES9hym9vTNY+72gRz++ekCDPZ7EVD2afFO+PN8cvesQuD0oWeY7Yb6b
+vFJsVz3LuAY+MrsWPVCwEb20bB4+3FwbPrOtwr0rfzi9hAuTPXjYWzxsqvw94aWUvVOjaD6yyeo+
+0q4+Pq7xvTzzplO+u/hJPkEkkj7R4lg97BRsPRGAGb0H/aw89yOavKEztT3Vukw+iPyLPpkR7D3k
+wf49CeyqvY/lA70610+8c2nRvFkHJT4IcdM+keQ3PYpJcj7WvKy9IlC/voUePr+b6aS+eEEuvjHC
+vLxUIIi+zFxTveFWvr0Py4O+QWANvy+Y571LxQM+SiPKvb3K+r1LG7Q9KSF9va23hr7+4iy+HBMO
+umC6jj1sSUc+22H7PVTyxT2bMws91fVUvv4A87x0Lfu9SeVwPlQAzD2dNY09bPmuvcXtub0CJAm8
+xtVyvj3mWL3KsO89BlSRPk4fUD48fVC+x2dPvbhuoz0UXKe9dCvOvZxwDD5Dt6I9GRS9PUlPuLyZ
+uaU+eYyHPXrGZjwlWW49LoKAvJYfDb3EtGU++nTqPedAEj6szJk+MglIPq/PHj4fWOY9t+t5vchy
+Y72vAMy+Tf6zPpZXHj7cGRK9FT40vm1KEj4EOvM9elDpvgpRpL8WOO295ag3vd/oDL2cBNi9/yid
+PXGqhD7BaVe+2Mb1vyAKF7/2C7q8NMUBvohd1b2Fax0+xB9SPmrkpj74vpm/Ii6TvihU/j2dg0U8
+nYatvgiGkrs6Lcw+xFEOPwOrsr5Rnxo8P+ZNPr4aPz2K2BC+Xmb9vfoipj4NMG8+mvoDvs2UKb6y
+o6A9XHsFPvzkAj3NCA69RpXTvaS/g75WRje+iziqPbQGWD5BioS9GeqwPdf7WbwohLc9b8cQvtXz
+LL1s3nA9MeTnPeEYjD179LM7c4e4PSoMHj5Yaba8cC2Qvi4dt77p8um9jykGPnpxhrymJQy+ApeY
+vZ4ftL60C/S+jADfvnc05Tyx3S88+a3ZvYcFL7xKK/o8uweYvpsgC7+2nkC+SSRnPecJTz0TsG89
+JO+FPSxBEr719ka+19oMPRHSbD5yT809/b+svfC5bLzZFvS9+JS2vYAy970RSYY+CG+OPjixqz6r
+uCI9mUUcvt4BHb5O5xU+hN+4PdcW6jxHrcg9NDvDPdMV0bz1lbm9CF9DPCUsKD4drHY+OTEUvu/P
+7L3sY36+HgZlPUFfLDyexw097uqCve9sZr5BjBG+QrWkvb6ACL6gHFC9Wnz5Pd4Arb0LCpG+HZvb
+vpQvWz5EBVe9ut8Xvi3jnD2eCDc+rZ0SPv6Ejr5cFRG+Un4EP+xCoD7JObQ5yE/TPIP9aT5QnRK8
+14gxvLr6D71If5k+2HBCPkxjgj2YUyg9ilp5PSsmbbqux7Q9SZGcvdlhPL6W3bc8MNsBu9hEJr6v
+90S+BOKHvdQV9DyLZYY8WCpgvphKmL63JQS9yr2svvyx0D1atgk+ZKWLPBPxoL0iY36+BdEovqfu
+Ar2q7G8+Yt6yvevXpz1R2UU96O41vnVmir3NG0E+yx6zPm4iML2SKCY8zJ9oPlq7Uz6ohxa9gEPo
+va4IvD3sJjM+eCTGPf4QgL7mxXW9AN2BvXIhsr01j6y+HHPsPEXFRj7qtK89gKi4PJJGd7x6xks+
+bewHPgTjkb6axkO9N/K6PUbzMD7VaF++KJj7vD92irwooyE+wPnMvj9DQL74T2Y8lryWvbFvIL5E
+1Lu84CDQPC5sND6NoXW++9FZvj5oVz78L9c9ENGvvYNDX72LJCi+ZYEXvghiiL5su/U8ksq+Pqdq
+cT5Rt4O9Ql6MvaSB+L2a11e+AAzqvXiLGr4LSdu6AuEePVaPr70D/cq8oqUhvgc5rL50FJ++fJZI
+vgqYgr7HwSM8Apy7PdVXwTreCuK90NCcvhimL78vEUm+734EvoYiCj1jUo85Re3oPdq3Kj6vKR2+
+qK3nPh+PhL1o+Ri8Tb6UvE7stzz/I++7uEyFvWnBcz6IbSo/zzTFPrQWpLwojqi9mxgOPp6wFz0y
+4yY+yp0XP4/giD+cAfE+QMHjvQ2B272AIAw+4UxjvGAuKj4ailc+7V6UP7K46D6Aod8+JNerPmjH
+2D7tjQc+46iFvuAY7r6X2Pk+CQDGPpp16z7w0PE+Ch+sPc5m87020cy+6e4fv9Ueg77gpy696q7q
+PRLJer3ctBy+NBgevgVjdb78N+C++K5MvshqTj6+P+o99kdZPnGHyT0YCSW+vZAHviFoIr5qc6m+
+bneQvdPk7Ttv3CI+fJuevInntj2FVae9I/9Bvl7Jpj6SjyU+sluBvn3uE77CnBo+7P+3PW21lz3X
+oym+iGoyPw/exT7c3Na9dMeLvSa23r2LyIo8slMrPcv09b3nTYM/fXwRP0oICT6/3bg7fVYSvgju
+pjxwmRq+RXozvmk2xD2QD0s80hz8vS+wcL0/h4g9bcZIPi2uc72UklK+V0+hvnYWZz0AEWG9zgqD
+PfEp8j2T86w+ZE0yusxkTL52shK7IXLdPCEYCz2zeVO96VGAvSpihL4dKBq975Zdvvye/L20Tlo+
+MGsEPgoWkD2671++gdjivgZNCb+E3Ei91pKvPTISJD5B2hc+shCnPNEZbr6r3gO/6FvFvkDZxL72
+d7u+jJL+vSdUpr1V87i9YPIFvHVXmT2Yae89d/6WPh25k76sQ4O92cavviik9728zaO9MBwIPs8+
+WT60HD0+qpJJvtKtM76KgZa9GKp8vREu8703zHA9G/OHPpwDij6a9W49I1/CvJ92Sz1qGxq92fKw
+Pds2Oz5n0KU+2NjmPuvDwD36JU0+bsYbvlQskT3SzDM+JgG3PjyZoD5I8l49nLMIvnS06z2pTAW+
+QG6NPdSXqTwDrRY+gnd8Pg7K2zxevbc9vJK3vaaPN76c3Q++LmmEPfQjBD7F45o+xpLdvSnIwL3H
+4xK+6Vo4vrcMF76purI9aOElPg/rkT4U5fo6EyGFvVIA+70w+CO+sKjwvVz9Fr6+fLm8pDoPviVO
+vL3kk4w86YAnPgD0Cr6Vaj691hDFvVF7K72IZaI9KrYAPZSGzT0U3eU9Y3QrPYP6Yr5qhYu9CRgo
+u5aGO724J0m8V+rYPhaAtz5ZbYM+QlDIPFU01b1ZySq+ap7wOyNOgLyq2Zw+eGLDPjCLxz10xDe+
+L8XHPLXBEDwooB4+Z6enPrOxUz5Y5ME+MW1wPQaL0b1by3W9sS8gPtP8o73ukvc94/OpPQagUz6Y
+gLS90XMqvplLaToEyQE+HjG2OeSRdr7dsxs+sE8NPYYUGb5QnhW+Xcn9PEU5jT3hku28QyM8vtp8
+Sb2xMIC+OEvPvT03S74uL5o8/ZA6PqciUT4r5Wg+mQJOPsMpXj5LgNw9v5MePXA0k7wqSF8+2Agd
+PtxVYD7LH5M+TfuoPuCxdT7r8os+KEyPPpigjD6yBzA+nbG8Ppvoyz54Nfw+GK3KPulMAj0RBx28
+dyI2vLaarj73fPc9rPSePIa5Fb4Us3m+N9C7vsbb6L6bIxO/HaFUviggFL7O5IC8hU17vuM/0b5q
+H+O+7m//vu42CL/sOI++na2Gvhh8Eb7n5pK+pzySvqflo76lqki+JrlFvgDOlb7Esqm+ssk8vi+u
+kb6LwFm+YrVHvmCjPLs9rbS91h0lvn2rIr/iz+G+U32NvuLu+7zs1+q+xyLUvSf9z72P1RI+aVDX
+PESbUL49Qni9Gcy6umdJhr2YM5u+6SSpuzDQoz0P8j4+QLolvvVQgL7HUZG8wayKPMdSYr12xIY9
+MjYrvlb5Qz4O50y+xiuwPSIpBD5oh34+0XiYvMJPobvjMka+UbuNPcKXM74RTUO+1DLdvfIw7L2F
+IRQ+u+I2vr0XW772jI+967ILvv9eur6qSQe+qrSevb1Agr2Nx7o8V/4qvU8tLD4i9TK+QovwvmZ9
+wL5zTtq9jGZVPedYAL6UpV29aynIPKGK170cg9u9/9+dvlZy0buze4I+HyBQPrAlEb4YPZy9IVhg
+PTBuVD4rTDS+zC+avRC3pr0hwkC+Cmpjvg+xfb7YXW0/ABdnPmEcVj7G6R69tWMRvmWAN74Qwhq+
+sqwZPT1ILj9a+pE+TpNrPuYeVryWDTC+68DtvZClCL4tHf893LVHPunYST2kyBE87kxOPmLnqzzj
+DEY+VZUlPmVEmz6UG8m9+gSdvSnk0r3Gs4E9suYoPpH2e77xH5c9ClmIPf81vb4atHy+vNxTvolr
+EL6r5/C5ayz8PZFjAL5hXXq9Or48usvSzL1jTSW+PJ3avR82dj0kFIQ9WN+2vGqRMb7rlKO+lJq7
+vf2meb2jLIe+d2bVvT3k571TGgy+pG7avp2lWb7us0U94fF4PnwGkDw/KKG92fh/PTFQqz2bwaG8
+GqMxvbUVVj6OTMQ+sTkhPh9yL75xV7u9ohBCvjOrwL5jpcU9FEWhPkkX5j5lyq4+VsATvmryVL55
+Zqm+nAWivmeWC76SXEU+ntD3Ps/kjz68Rh69IIgVvrKXCr2ThC49B5SBu6y4EbxGB7A+lJy2Pafl
+vT058xO9wzKsvM124730fMw9aRI3Pl1Gr7zu0pO9lEZKPrwBgD1vMjm9WWzAvL4lib52KXy+7pfO
+vtAtXT4hPhs+FqB9PcViB7384ru91nEMv7s82r4ARu++o52GPZo21j01hEY9eWOevVOthDwYMWE+
+ej04PCPxkb1V81Q71shRvgBGZL2bwI2+uUCzPhwZNz+XK1A+mNuEPJZ2O76nJA68pbeGvVa6dD3p
+XWI+Bia8P2aT9z6aV569I6CwvZu8pTzeFJo8Kmu2vZHFH746udk/kUwgP+zAxr020ka+3/m4PcIS
+rzrrHxK/Kb6yv++0NT8ejsY+2MC4PRxihDzxaLa91kGtvQ8Vlb6J3Ye/cPNzPUw2RD0zhr+9CDp/
+vTrpezzZgR++b8qNPRapAb47pXq9+rCNvRIJ6LwOYhY+LmZwPaEoBL40V1e+vxAQPjyvi74Sdr++
+Ls8HvmjZaj1WBNw8pw8sPVAko77hqn++u6hUPurR+j24vMu+5ro6vsuAo73rCO29eD1hvl+kTb6o
+v8E+WeehPmvrmb6VbQO+TZcivnvPDb7edk08XZEMPiIuXz+FAuI+OLsivesoVL7IEP45Kvo0PcLj
+or2I8kM8LmuOPt7A4z4SIZw9XX1qvDn5+jpyGhk+mvpRvFSERrztnvW9qHcYPg6/8D0Jvbm9+vSU
+PJRHJD5uJSy84MYBvqeJ4T3q6tw7E4ZGPv8sLz7QA1o9HH78vXPepLyDgLi+/ueDPtDGhT7Z7bg+
+wWN8Pg6JB77VfoW+7o4Jv9uiFr9XRGo+UzxBPoGvoj5fSP09Atwivn6EBL84sjG/uoCJvyl5zbsz
+/AW/Gfgcv6r35b7aUTg9SC+DPlJZoT3/Drg+8NhNPKK/hjspNIu+7XHTvjzW4T0SYLq9UpT8PY8n
+Iz12OWk+mEZvPewaEL55qHi+9+BFvEhFkzyXyjW8GuGfvqofQz46/Ik9AXlZOcmlEr5o8Uu9v9EI
+vmuYr70oPmO+PA5APbVkmj6mGwQ9+d8Svg69cD4bELE9+nnjvUZNzL1jQ+y8X7Gju1Ewdb497dU9
+uEgLPoqvXj5mqzw+kNYFvij+573hzJS+21hAvQinMbwLsMQ+WRPFPXuv6z3rsui9QpHOvkIv177w
+7FC+aTsLPuUQDj9SJJM+Wd+kPlXvOj0av7k9ZpGavoC6Or7tbwW+9RbEPnj94z7ZZ3Q9KjaAvcv+
+Ij2cSA2+ymyYvBXz4j3bcoM+nbKhPY+p8j1Y8oM9b42oPGyX+L3t7Qk+RfQhPniqTD32QDm+3J9l
+vn0svr0wnoW9/TeZPe0KNT7jjfo9WeQkvYBZTb4WSY297Xovvtc8zzpRDv89TdPgPrMgvj7SZzE+
+ces1vQGLLD3s9mi+UUOKvpak/L0qlhi+KYRlPb69cr3wF1O+MX9kvZ772LvcW/a+abobv78sjL4X
+MCe+nACDvje4yjx5Esu9m1JFvlQuQ74vqua+vh3rvpXKZr1t13a9iOSWO/FSBb4B09m9KfXevMGK
+ur2+ZAe+RXLTvuwIjr4XJHc9g+4HvUuz3T5zJAC+/BpCvRJRHr7r09W7sl3xPViL/T2FWZ4+chEw
+PrD4xz3H4pK+riGjPitHnT56MrY+fR5oPja0Mr6pPYO8Izpqvgd9tb4leU0+KPHTPmgVDj96Cp48
+VvvXvoIrEL7w5xa/V2Egvo1gsT5OLMQ+wph5PhFsib5a2bq+HfU5vo4VBL9+Kwq8REC4PXa76D09
+28G8U8AjviGUk74oEJe+zdaXvt+QKL0mtaA9N4gIvVUy3rzc3w2+meppvnOyqr4bg12+yxJSvjJz
+nL11sxO9Q+yEO9NfXjxrc2O9N6YMvhU1Fz6zOSK+p9QMv0DlFb8w2Si/3l4Uvl+3j74t3JG9nlV2
+vXdJYr5bqr6+uSrdvqgZ3r4bPsq+jgLbvsb7xr21T+2987NpvTCQ9Dz782K6EJ5QvdEUoL5UVJ++
+FTgHvh+mrb4cvM2+bVmvvTIQJD6g1yA+9+TNPdgVhz3h+hi+eUYYvu2Bj70QBSg++9khPgGAYT26
+heo9UJpNPhHzRD28W7s9dtOfPaxBID4yuyu9DdD1PPmeqj0Ysf29mhs4vVTgSD795R4+T68NPr5g
+XT5B3fa7E5lZvXtjHb5Uqpe+7hitvRocBjx5lbE9cDCpPYP/qT2LDia99drmPLjQgr40WAk96DD9
+PFM0z76XPRW/ua4Hv/OLa72XjWo96+5ovqKGSj5eGQc9jGDGvahA3L4Nx5y9Wl/KPMVLYj0bYI8+
+p4XUPaG8oDzHmng94kBHvnzuHT4ANzE8DEDCPRfWAj4LuRm+iCMAPIMLoL0Tqwk9055TvYP1CL5y
+jKe+bKCFvu7+cr5LtNy9ASRRPdLohT73sBG8BPEqv8ykF7/MFDK/DSRfvtifir01WzE+co8DPlg4
+xD3OH6C+wCbUvot+z74vihs+yhQaPH36Z71fRzo+ZYoIPsX9z726ARm7Zg3DPaFuCTzAmTY+qm8m
+vvrfcb6pcAs9AAN0Pl1iuj768l89t8CLPqdVpT5SGUg+kuOzPBoZXr4pjam+8mp1vuRjBr4Enoc8
+b9l9OmAqn732Z9k9YjjGvEx+EL4zhFy99s+5vdgKnr5fvZi8EaAqvIyXIj71dQ89TUd9Pe67tz1C
+gns9W0khvs2Jib6sIru89KiTPQwnC7xBqtS9645HvtrgEL7BKAk8K3fMvUK0gr45dSE+Mhk5Plwm
+Fr5JrZS+qy0Ov32xOj1NYlw8eh43PW2uHr3H7+88eWM2vlwueb7/nAm/jaUSPTJ8sr3LGx2+23me
+vMzRfbuoqAc+yBofvluVI75oNl28LSE2vkLTJL6/vKW9VVyxvAAvcr2COha9pG5evhhxGL4b5rg8
+kziCPrKELz68nW0+WmtlPrpeyrz1Hoo7fCmfvuFzmbw91kw+Frx0PlBHTD5N0tM9OxNhvd5N3Lza
+Faq+NmnEPb0Onz6q8pE+/rYUPvu5WjwFYYu9vpp6vRB0171REou+QX7Hvq2RHb5ZslA9a5rOvCr1
+ib0y/si9jLuhPmQ+ET79zO68ffwlvYwoHj7ICxc/leIhP+N05T5o4gw+ZoWQPjTjJ749wyU92JEL
+PogT4z7H2OY+NdgKP0rFlj3FxHc9GYhJvZ/XVT3Poq0932nJPREO9D2aiVE+TkSgPColor2AS6c9
+PDdcPofOkD0wa6o+JX9VPYO1ab6JUcy+n7zWvvBLBL930Dq+S1BxvYvZEj7317Q88DDJvXHbg77P
+5A++7r3gvkiTMb6VYmQ934DwPbkwk711mRQ9DQ2fPZ0TyT3mEka+7qRsvlBmQ73/o9Y70S6XvPXC
+h7786Fc921DWvcRuuT2H4Gq+0G/Jva69Sz7ruz++7iKyvgvFI72vuBK+dSkrunbqjb2JRUU+GASC
+PoEdlj1yuK48tgxkvbWnML4O5dU9c0eCPn1nmT7+wsA+Ra2uPgT5oT7CSsK7d3+5PdYiAT4l1QA+
+cVZCPuifmDwTPnI+8PK0PnOKjj7861U+vHenPS/sRz5T700+RfzYPTQvZr7N+7O+eOrOvs5rNL5A
+yy+9LDQfvo5xGj3os6o9XfmMPveuED7Eqg4/waGNPpLAjj5ysje9VZpLvgmsfj6qSI0+irxCPs1V
++z4mjb8+19Ofu3JBwr0tvyE9D3cnPkz3vT6diTE+mIBmPhw4LTzuy0O+UnRfvk58SD2aW+I+Hs1F
+PgViWj7v6QO+Vv5pvuG9mL7gMY2+SAaGvdAZyD0EGLO83GwZPlWh+72MHxu+eRK3vXCnij2UwAQ+
+jV0GvFMoS76NBr29lUMUvhPxC722dw0+tAZePqtSmr32zQg+Yn9DPDkvm76gNiy+mgx+vToEMz4M
+gEc+4FjBvECTbz7Oniw+kKBbvbmoSr7i8M69+G7CPRA4JD1lMZc8RobNvX75R723WKQ8gnEJvq60
+4L37vlc+GbvRPWszTr5iNUq+vedAvrnKDr6VIs89+7FEvFs/ZD77ZmE91WWnvjTrq74rNj2+m67V
+vSjMF77x73S9jGsmPvvjLD0cyCk+ZoCjPTUOkj01Tjs+8WASPjMLLj78YHA9bAIyPicmGz3drlU+
++Fj3Pd8B1DzOp04+R1B4PilkIr1b7xc9Esp6PUR5m7078yw+iMfBvfRRsz7jqZY+Hv84vo3QwL1l
+uwm+fgOPvd3/AL6IFFG+k3yiPqBzjj2T9o6+/dyRvfHuN7zy3gm+TmaFPXO1G74TAfQ+HW1cPTUS
+zb0QNOS9Z12IvrhGbr1a65S+N0v+vKeQqz91EBA/qxxkPGWI0j0osEk9xQfwvR2ki76FxS29+ULg
+P88iaT8gXos+l9onPi4SwzzvmKC9bjAXvvUMe77JGd8/3QVSPyA7AD6z9lW9+UUOvjCBZb72Mia+
+wvs4v7PoMD/uxto+mpoAPZvyS75f1AK+8EFpvum9zLt5Cme+s5amPjYinj6omVg+iu39vVmYt73K
+PQS+/DQzvQvfSj0qVq8+lw2cOt0VBL5Pa8E9gpLOvWgHh70XXgm+9dBVvkO/mj5jvMK9Ook6vnKr
+wrwEo1s9R7EtvneETL516MK+qEpgvg1gnz7d0sQ+Llk7PJkdWD7iocs91cH7Pdt/ebxYQvI9dAF8
+Pv3rpj5cOHU+WT7SPLr4ND43czw+Dc3tPV8aJr0mkpS8QxJgPB+l5D0JREQ+/ECuPX3usD2S7wC+
+ep6qvodCuL68Mv2+zYyGvs3MH70Z3ws+H2uOPZq2Lz4FS5u+6aEavmxXzb5qLBu/YmUsvyoXLL8u
+yQW/cDpmvnhY/L2SDHS+kK+TvgZhi76paPa+TQC/vvhGEr98o5u+lcRjvVafob1tJ2u++6bFvA+N
+pD0FcH68no7YPmn7rD7x0o2+rFBJvtWAXj1pjtq9OIIzPibPAz+2ui0/9GAoP1UEkL44YoW+iktM
+vktfT76kY8u+AtSDvgCDwL4PZKO+OOAEPw05xL2+oDC+fuG8vWV6rr4urM2+4olJvnkckz0hfAY/
+aih1PQ41XT71Efs9QslevcqrIr4hxkM9eZQRPqMNWD7e0Yo9sEQHPqeK7TzRGls+ytwwPPWE0j0o
+rSs9hMgHvnWmNr5uJjG+HfKzPSyV97zPXNs9KOM9PeLvqD0jPa6+XQFdvksYBb0t7Ye9NnDbvbxx
+kz0XXls9LABMvhevlr4C0l2+tLEBvkDEhD5Disg90iV9PaPBAD0USJ89eRBpvj8dMbymI/Y9Pit3
+PahYW71Q6u296WZzvpZkWr775qm+JzeNvSbtIj7gO1E9ohSRvjsEh74J8ga+4s2TPRphcr6EmYg9
+VZwfPUj4HD424JS+suWCvgAQRb5KdZ09KKJ6vPRFED4izaM+AfSMPgMslbxk0lS9cfSbvHfAKr1x
+VZ08VizlPb5nmz69q1o+5hgpPvDYlj7Z2OY9HtxDvcQOBr7Dsky9LwHyPRE4nbw1CAQ8c8/ePDGU
+dr4RLjy+k4HpPQHNlj1gKgy++nOWvYv3ID62WZO9GBykvrYbwL5ae4c93EyZPayiFL5w12u94Ghv
+PZiKmj0rzLe8qNQ1vs0kAz6M6mK8W5HTvroFI74Eig0+YZOLPGt+xT1XIYI+uhfhPp4RNL2jpl6+
+pnOfvZf82LzjQpu+PzkqvttTBz7/lIw/76fDPpiMdL3kF5y9ZdGhPckjqr2H2qk9eCWzPhAJ5D9g
+aik/aONRPdIPiL3PzUu8KzoxPsB5/T2mcyM+iKgIQBqtPj/BYOw87n4zvtCTHj6cbO89sEZYvpsE
+f78t/IE/BWeIPgrRg75vPBC+V6StvQ/IUL6qXAO/X9hzv/T6bT6DBla8bdvOvLwcUL7S72q+4WAe
+vnMeur4I7se9KOAoPDrDk741aGI9SThbPVE7eD1kMme9S7c+PbPWlD0xwju+g2i2PKKouL3T0pg+
+L/aHPuqtwzt/71C+ANXfvB/9Uj42Dwi+Aoq4vo1WaL47evI9WadYvc1TEL53api+pTiQPpMH5Tws
+0Ry+RihOvrPphL0uKRU+BNWSuCKfPrx44NA+EIFGPf+vR712v7O9SzVSPRqV7z0tY1G9+94FPg+k
+Kz7ZvXU9/sLmvQx/AD4nkhQ91Yv2vYQQ4L3DSW88mZvuvSKtgr5jB6c8ssB0PTif5Lw/eYO9n1SC
+PlrML75T/Wm915Ilvaqb2jtKVB8+3yScvL6c8rvjx1c+fx0ZvnPUCL5Cgry8FQ6CPMBG9T0Qepg8
+HzJhvniMyDxne169pVz8vZjbd7wUxVo9uXqhPfepwL1x7JC+U0VwvbNRyr1qJQg/pIrIPm40Ej7C
+/5O++w6oPPSSyT0qi7o+hH1lPfFcD7+s5RS+MLWgPbPIXLzsPIE+7J3nPtMXwj4VPuO8wDLXvkzM
+0b6ot7K9y29hvrUKWj6VARk+Sj8mO6cyrr3bEF6+CrEbvm2SVL5KfkW+loaAvXzJ5D0foli9AsAK
+vjJ7ID4EGZM+tNaJPdYxkr731ey87uoFPv4/DT0vxii8wlXiPvm5cj5wjV0+CJKFPWXiu711TkM+
+AoVSPjds7Tw/HGE+MtafPjwqWT7jlCK+KFzBPXKgiz3FVMq99Xn3vRvO8D0n9Fq97We/vZMFsb5H
++Q+9ynuQPjrV7D0I4lS9cnJHPomc7L0HMr+8U6AsvmsSpb0khVm+/TKNvezmyL3vfeU9c97KPEdM
+rL6KXFW+sGVTPe5fgT3V/OY8LapyPoZRqD1jzrC8lmO5vqtogb64oLo9s9I4PePgDj5rS04+SWTh
+PhOPmz04JOu+8tsSvkx99j36c10+E9e/PjIVZj6NgY0+xjj8PS/MWb5/ESG9TsFIvj+eh7xaKJy8
+v9yLPvdTG74f46q+ZxnNvdCkND7QPs+9i0mTPRzvLD5JFYY+IT86v0cjnL4Q8zK+7qLmPeVgZryB
+KYy9Zf8OvpDdaj1GJCq/Hq5zvkODU70Fpr49jMC9vWhrcz0m96u9OszBvodOfb8eOCw+W7MTPiiz
+0T0Z5j89ee4RPcRk5z2QAHS+j8ABwLFw/7761wc9RBNCPsOOorkyX6W9UPEnPAoAqr7xBiXAF9Bj
+v5UpGr6P8/k7gJDjPAQqt70iTou9xCeqvYNeJcDBFZS/FwN2vQsMQT4sK2A+FN3CPYkPRD0FPmM/
+WGNbv7bS0b414pG9zPC2PqRVqT6d/w89vDiQPX2VKj+cl1W+L6UmviuPBj6h49C95yycPcXJIT6k
+0iQ+6CODPf7G3L2kpwG+HK1ou+AnZD0G+uy8WfY+PQchtDwF/S2+0yuyvaaQ1jwj9Xa9GLhtvuD8
+kj3QFUW+N30CPe3XCb0AX7e+ApSvvW/wOD4lo0E+BwWyPG6XBbz/fTA+x35ePh6bbL/LwDO/mPk/
+ve/XAz1n/T0+GRtPPidz4j0NToy9yH2Av8kqPb/nlP69tQOAPg33wrsEihm+4/Q1vsVhP739ndk7
+tWs6PHv3dz1K2Ag+EhElPX0i3r3mS0u+wmqaPeaP/D48NIw+gZSEPnK32j3n9FE+ZSWWvm+HhbzK
+KtQ9K9CvPlKQ7D3F/VE+xtQFPmBJ6D1V1y8+3dLfvj6Uu72r5JY9FLx0PaCbFj4yRyw+XAlGvuEl
+Q71zNAS8m8EXvxCoOjzarry714l2vfUSCT4QO3m9O/mMPVuxWj7131U8ZK6OvgS7nbz5kKk+rMkM
+PmCtrL3hKiU+2oW6PcREh76QwUy+1/QAPhnnhD5BL6899PGbPqImvD2g3UC9/CKVvlH60j35dZ0+
+jEWZPvTIQj3f7T49UIsQPTbm+b0w2B2+i7iHPueQJT62vYA+0VdqvX+UA75EKzI7CbwsvoXbUbwP
+Iz49nqeUPmdblj74fX2+LnUlvuCKDb4Iclu+x9sZvlV2tj00mSc9wghEPktKAr6dxC080AgUPq2A
+ML6B4yu+OXfKvqnDQ769Yd+9loS0vDPeXr27H4Y+seIHPi1BT75GObe+Ob9LvixbCD1iuVs93RSy
+PTKLgj3yJis9ATtyvqEg5758R56+t0rHvvKQW77JWrA98BQJPhlMBj64klO+Okdgvgssib7hiHK+
+8aSvvu7yDL7bTb49eq0NPVWCRr6NZuO8GwyMvFg4eb6OJ5y+b59rviRUyr0d6PS9qx98voAS3b3X
+5P49vaUFPTPtwr0iFLq8Idc0vKLRGD6OdDQ9luvWveTNPL7Am20+Wf3BPiRlDD5bIyG81QaQO4TL
+A70BLFK+mRt9PQRztT3frHk+tNa3PTP3cT5XNC89aIp0vdWxh76tPxC+xYFDPvF6Az5qRoU9S9DU
+PR6HCb0s6hq+0xCwvp/2Qb5SPVo+C4t/u61tdbx+2J49u9S9vZ53nb6Puam9njFCPS2DZb24uRG+
+4tx4vkS7LL4RLIe+a1chPrb5Fz3xiPS9cenZvH9mHLxsSyO9g/5LvrUlPr1vWmA9hJnUPhp5Eb4E
+Ssw9nryoPdi5nj1hojQ+mmL/vDW6ET1Zb8c+LRbiPeY6Nj75Vvc+1jfLPju4MT4GG0m9PkqkvgtZ
+a70HqH8+LiCKPowIAT/AlhE/hShyPhobTr0dSB6+CuA2vtjyQz4PMgU+BGE3PhloFD5P2dA9UujH
+vZEM+b0IuRK9slKivfCrmT3Siek9tJ6gPIHBnL0IBLO+qGmRvlafMD0E2Yy+UvdCvuIrID74MGq9
+FGijvD7QnL6Jx8e+Ly5Ivkg4BD3KDpY91oHovZ5Cjr6qhYK+/srCvv8m376+LQa+ufIOPZNeNz3+
+ihu+x6CgvsE0sr4vNJO+d5GTvnkxHz5vBp29aL7pvbdIZL6GIGG+31qGvpIHxL1p+Am+29hEvp68
+q7zqy5C+14znvXiEor2RuUQ+F14yPu3kKr0ZLIG9BOfhvaEbYb5kX6C9DhiHPvo43j5HWzs+BDXW
+vdvGSL3BbBu9LCK8vb51gr78TUs+kpJmPfk1+j2E/pe+3ViavaGbpr0/aWa9P/WdvRtCjz63iTS9
+Pl3PvdXpwr2KSrS9ngOAvsiYdb7YCCW+IAgEvj5plL3IFge+hCk/vsZzmL+VJEW/8qGkvseI0z3I
+wfW8ApuOPdpGQr0VqRa+z3CaPqyveLwYfgo9ptupvb913r1O9Gi+EGEdvjW8Z76JtOI+np+pPhbj
+ej63IE27kxnxvUv/e77GjYa+r546Pm3fHz4CRVM+cRw5Pd0KWL5TzS++QDLEvbPr37twlx4+Qb6e
+PCINazzfys69n99CvgKfVL6nZnm9HiZVPV95ND5BJGO+PDmQvdO1aL5/aD2+sX4pPXk2aj50niq+
+4AzLPfItmLykvMa98eb9vXqo+r2htdg9LMc9PiYf0zsTBja7WqHzvVC94L0s1xS+hgDOvLB4ND5A
+kEI+47QpPjGKmb5iXmm87E7bPBBA8z2JDFk+Pr+ePtWNdj64ggc+oGNwvl7fMT6TggM+w0PGPmiR
+sD77bhw+sfspPvgCCz2M+bW+tPjEPcfajry62qY+3LKDPpDoVTxucIw9iUW5vm+QXr+klVm+szeA
+PsAvaD7/fb49BRENPle4Ab2hHU6+CI8kviaqfb6u0R0+2DPAPsRxOj6SbfW8EdASveRGnj1K6pY8
+cIUCPu2yyrzlZUE+rt3GPJ9zC74cT+w9qB2WPWh0jz4S20++DyaGPcL5IbxA8GG+rhGUvnr7rbx7
+4ro99o39Pgh0hr03drY9LpvrPCN3nb6Ol5G9zbH0vRLWwr0rwyM+o5EoPwcuB74GP4K+IuYdPcwh
+HT3I4gi+eh/kve6ih7ytNfc/zvT4Pqj84b36nMM9hOarvHgvEz17zO+6FfGoPsnyEkD6mHk/8wEA
+Poj94D3A/00+21N5PjHKsD3k4MU9jIsMQNGzWD+H4b8+7x7gPijI2z5Ze5o+XiKZvXOd9L416TQ/
+4JyzPuMtoT6DKCg/x5MMP0VAjT4+1ni+3NAFv6VDtT5Kyxk+zgpQPibIoj6QRQQ+aRsKPauM3rzM
+2Wu+K20nPmy8Pb19Gnc+ERoaPrEakT3KCDY+4jAWPeySEL0ECxk+ze6nvCv4hL4zo7C944ETPjeH
+Uz0yjRQ+RN8uPsoH+r5ALea+1Gb6vSwtJr5tKj++f1aYPTpFXr6a3Gq+vgQovWfP4r7TliW9GRXv
+vJf+S74K0T6+v4BDvfWkIz0nhxI/A5K4Plwr+T3wSNA9E7tevrmX/D0H24Q9iJ5zPuvKgDuHQby9
+BFWJvb4d+j0YpZ09tIYIvRgDAb2o6w0+WxELvvPii75U+6m+3OdzPDi0dD6MwT2+Yc6gvr7vPrzK
+N4+9792gPHoW3Lqborm9DXW2PUmsOj63rga+txqwvuWE4zzxFDU9UsCKvUu44r08bHw+5GKlvSYd
+9b2Am/W+YQorvbTs4L0/FDS+cTGeveCnRD4c5Mg9HA7NvWBtVT6fQAU/wLcLP08A5z70Pkg+ymuI
+PtH8wj4qmKI+tw/XPeR6wb6sFlQ95z3hPELDNj7ycJA+BXJdPmVGiD6sL9i8jH8Lv20ru7zY62K9
+9aLJu9RzXr3hYwM+IyAjPTGLC75uF7S+/HO9vRFfqr0sic29YEJKvityBD1teDC9z9SGvgyIMT6G
++V8+iVTHPvn+MbwdwtU9b8BgPXc+gj27pi++PCpwPh5eWz4exjw+gDOkvexli71umMQ9WxSgPRT4
+qL1n3v48s6mUPWL5mL78nLG+XK/jvTJyszx0QqQ9n8AlviuZ/b201JS9GFZkvtvf3L63sgK+gR1C
+vfm0Pj3mwtY9MjUePlRu1Dyrpdg8fD7RO3U9iDzRZh8+mZqkPtQEAD6w9oC4/jTQPMdksTz1yxS9
+uP8DPpUxYj5lJeY+vCfHPoHIUb2Eq869JFkPPWqIF75Zjsi9rLplPkm1mT4E//g+zjf4PUtvgb2q
+NQg+RWzWO0uHOT4QlDO9VigBPp7bHT2WeQ4+DpxxvVELybyAt4U9yOMKPAJFxj2WIPA9adinPDdw
+d74NA6W+/zKqvgl1Tr472Vw+SslVPokSvD3ggJE9JHG7vj6yPr9xtdu+oOurvog3172xJVE9CZuJ
+PZM/DL7gKsa+qcTKvg+tAL9S4Yq+2T3nvQbbiDzxnzG9F2o3vuSMSb/Bd+e8pI5dPtkvRj4dhQg9
++G8RPuk0VD7vF8c9vM/hv+i+Cr8EAgg9/KX5PXD8FbwhlAY9IfvDPRyazD1wJBHAAKB8vxcGgr6x
+zwq+nMhzvb5f+L3y2Iq+YzkRPXEtL8Clft+/T2VLv7kwnr1P/yK+YgFovpMDVz7W3Dk/qcipv2UT
+2b7j+0g+buLMPhiOkD7p6TM+nqp8PgmsFj+uSye+CYUFPqFPUT4uExE+HQS8PdfhTD4mkrO9pkWJ
+vjw84D1pMJ49o7ESvR4Fpj05/IU9epD+vTJ5Yr4K8j++spupPA7aq7zumiC+sDUwvQUUJ71GYhq+
+/SbFvsZgk70wCfo9PTJ5PvWjvz5w8do+eRBoPn07iT0tEOI9+xycPku6WTvITVw+tmsjPrz+PD5O
+LnI+n4cLPi3NIb7+wtc83SP/vn3SU7614HS+K280vvKqiD6f7xe9MnkIvSGqZL5w5vy+jagzPRYG
+Mb0q7WC+tdgAvinXgT2S6Lw91AOYPOVxGrwtNPY9NCgqPV66HD7u7R89xmnKPov2eT6g8K08igA7
+PhPit71JeLO9l4tdvRFwsL1Jg1o7YwtlvU7Ntj4I2aK97sAmvrkWlDwoDcK9P11ovo1I1b7oNJO+
+pC4+PtJDCb7rrqm9JoKCvbGx0b3Ielu+I+yKvpDOzL7esKq9h8UiPhWcHj57Zqa9zUsWvTOGvLzH
+Gl0+vbWmPQDSQL1xfsq9mY+iPcVKaj64dZ49XcG0PimiBb5yZX4/aXoFvj5nyz5fGW69+NcSvuBv
+Kb7d3i68S0loPi5eDz566Gu9jUgjP++yhD1wgri9o170u27Kb7xm2Cw9jyuIPAqeTz6Q3lq9f0KU
+vcxeKL5wXn898BOWvDygjz8NABI+4+RJvqQJND6HwTY9j3Y1PgUeEb6EPFm9iTKlu36Ajrxp6SM+
+ejENPWShyD7+dhe+ZF4YvdUvnz1mIEi86HjgvDisp70OE6u8oPUzvmmwzb1pTkY9QxEKvkS1gj54
+Oq8+WA2UvMeI370xBzG8rrICvm/Nkb1OcMM+uMUuPv/087xut9s+xzIXP9mfAL5Me1y8FSeAP/0K
+Yj0aMtq9BDiUvcs2qD2rycS7mHH3PghIRr35JIw/Zz2pPl1fGL6W7Pq9EpxsPoAYGz9vD/w9rvAX
+PhicSz7p9LS8ES6+vcMqD74qGIy9GleXvq38hD2LioW+kOiovNEdjT2N+vK+2N9vvgWq1r0op6G+
+SK0QPsofEr8x6VvAYf0PPh+rND1TNpDAjsoyPsuJOb8vwsq9oFqBvuBgXTzCC7c+zpZGPhARI8D2
+930+2Z3kvDa7Ej58kd09zf+PPgzfqDwIsnE+0FKIvL1hGz652Sc+MAZKv9xayT1Rz5S87QHyvLLq
+ob1bo2q/ORhdvXGhRr/DEeY9qH+rPnNuGz5vi5M9ASxbPkWJWD3G+VA+P0cKPpz6mr9LOKw+3y85
+v0qa571ZqKi9ojRwPh+Jmz1vMZs+gnCdvxCFGDw8lHI+9HrtvYP+WDwuk+S/OvcxvzGZ5r4PMbS+
++MfRvTkdm74PrUC9/3eLvVp0sj1bYcm/lVTaPUUTFb1uY5Y+9rO1vkMV5r/deQc+wJGkPmuVlb30
+FD0+9LJ2PgAOtb1vkpm+ZiNkPbSjDr7JnoS+qlkQPhqSOL9hajQ/S8+QvqW/Fj2fVx0+uWsNv9IR
+Br7uxh8+TU1DPspupb5+pUi+65V4PhABFT7lA5vALjoCPmiwir2zBve+xPB5Purg3TxoRJI+5x8O
+PirQor5c6Tq+/BtQvrQAZ71Wlhu/k29Kv/4PMD+bPkm9p1IePjBIb73pSEa9JzvIwP9XpL6iRz29
+1VGCPcLIoL8dXW2+PR19PUz0374f25++/UFWvudVAMB0zKu+y6xUvo6Iub+84DC+B4JEP9SQC78d
+bcC9wxhdvoFkGb+a9Zm9nsoXvjM6eb7OFsg9NRwEPs9fST2EanS9Km1Rv3rMv77uqhc+9f6NPYNZ
+d772Tyq8MtMRvg57377jUc29YQ4IvL/2yr1VHke9XRY2vmzeTD+2PUa+Kk+PPozuZL8Z3gq+zLEq
+vq+8TL4QvR8+3TESPiAX3L31DEC+UallPg4gkD7D3Vi+v4GYuyGxXr4yzlA+7D4mvGluub5HlkW+
+Mhe/vRApDr8zhoS5V6kovaBeB7veXJ69n4vTPIV8Cj5Y3+++r/AhvgzLFj6EQjS/yucSv5Eao752
+bYg986/lvR5TRb1jpCM8O20Fv04uzT2g15e/+nDju9N4+D56GJy8alZCv7zqpz3M+E0/5hS0PgbH
+Pb5w1ze+u6IJP0Tfv77q+Ck+kYGhvj550b6a29y+/jMtwJhjE7/WF/w+o/OMPiLxMT5/R5o+n2Nm
+v2s8Yr2coWC/eWBwPuSoJb4ZkxM/WUhJvS3lHr8Mfec90hQjPlqVh78383a+iRwBvwbzAT8Zvj4+
+7lT6vgLtwD3o2JW/WpzEvuajAz48DPM+BBCSPlwvCr64JEA96AxXvyu+vb527Mi8XbWlvgClQr/0
+rba/LzjgPskKEr9m6qS+yy9RvxGsB75J6XC8CEIyvmxYAz8LvxU/kFA6vsGaWL3wb4C/cBGGwOTX
++L1/QJQ91ycUv8G4YT7gAy69qr6RvBbXnz7lCPG9JW8HP/r45z0cBxu/oWqjv5Aurb4PYBg/cdT7
+vpGIHL9mWcE+0Zv+PnUbmz65avW+Lz1/vg6SuLz5JeC+aDQ3v4cyFb+3ORe9wY6hvaEKPb4FLFy+
+e+Pav96pqj2yhAe/tSigPjaK+j16Nqy+RtSQv5C4Bz50fNI8N56RvoQuCD8MfNk9HF7QPWf+DT73
+Pqg9AK3FOwCwHb205Sm/VKEZv2A2CD59bVg+nyiRv1Uvh77SMos+gOUzPk2PGz76Qrc++7q7PXWJ
+GT94RBC/yA76PaNRw76xYsO7LAEiPmzXCL+P4yy+DRVFv+Yb0r1xe3m+qj9WPlJN+T0QjZ+8S8/i
+PvwkP7+O+0E+Abx3v2WB8L6n6ow+iJeuvz89HL4eubs9Acxsvpyjgr89Bsc+4II7PiJfH7+FeEW/
+1AU+vw9/AD931DK+xOrZPfpfFb8wPDa/0hzSv5g26D2Rf929Nwazv13UCL9A5Xi9BDQ6wEt+675a
+he89JQKPv0I9jj6Gkam9dp1CvzC+PD0IQ1vA0N3xvmPR7z2P+lO/LhtwvNQYJ796EFI+kNy6v+k9
+DD9ApfI9iKCNv6GziL03oro+hyo6vFL/Ab7Z4X2/KUtLPs1VVz0Osoa+7mbXvs+QO70B1qU+Lmum
+PjcdCb+365e+7pcgPbuQfr+fP7C8ohC4vmF0hLvXfNq+gIJYvrBhzb6d9sa8bUH0PriYSz5KOw0/
+kgMnwP3H371bENi+7dm4PpIXUb9LkfK+ABknv3SjAj4w9ei+SeSgPUuetr+Y65q/hKEAvmbXDb8v
+E2Y+gR+hv1h8AT4RUk2/C1mVvxR4Br/tggS/T0hFv0kOsj3dN70+0S+Bv5WGvj5aLaq+bcX6vk3J
+Az4vzBS+4PWDv4uZOb7VRNQ9otkFPwJeFD7VfAe/mVXlvaSdor5VGwm9EIVnv3O6YT4Dev894TDZ
+vnBKk79rQPg+IZZov5iABjtqbsc+aDZqv6DeFj9oyGq9Fu85vzibpb5d6K0+vta5vrUNuTyZ8X++
+70p8v3AhMb7GBI7AJqYbPqiGNr44a3S/dICuPigzsj7iWZe+yWGFvzMrKb7QGlW/SAYlP0jAxj6B
+Bm0/TqQUPHDvyL9PZNa9CqHZvVxYBb6MRHq+LW+Tv2R70L1ehtO9IecSPpiJBz+vVIS/NjJfvmRS
+q77D4eM+7nmjPfoVU75+f/I9Hv6xPpK05z3b1QC9/j5ZvK3x/L6cYWq9HXwlvyK91Ly2u8y+Ogea
+vuA+JL9rb9u89fbvPlSOLT5Ce4c+8GVavlvShr5MgEA96Wh6vViWcL4DVya/6vTmPR6Wzz4eWNa+
+lNxCwELyDL0/zHe93xz/vkSrg77ln+89fHDbPJMN7DwQ6ya+PaZLvpk4v723jas9QFbvvnlFnT43
+XuG9vwibvln4n79K26I+gE9QPhfQ3D1JLD0++DIKv5NyoT6Jhiu+/PGfPtui0L6FDbO+ARplvYl+
+Zz7h5GS/8HkOwNPIMb1t/oM94TtiwM7CAL+6WKC9bdvZvjL1bb/LDY6+XlwJvpZBCz7U7hnAUy8w
+vwq20D7n4xm/XD06Pm1DWT4jPca+WMASPnmpar3O/xu/yrm6Pmf5Nr0YHvu9Nk7WPjXoEr5kIlq/
+jN8ivpuAHD2xo9K9rddQv3mXajxZlRg/Q357vWMBbz400rW/pv0XvabCT75E9iC/lt9evsDOs76n
+VtG+epIVvhsrS77EXby+r+AQPnu51r/3swc+8Z+nvgpzLL6oPHu+ow5CvyIYvL56yC2/ZY0HPFz9
+qL0hIaU9NcQ1vfn0Vryhi7E+dPgGvovsT73AGjq/stEgPqf1Fr9VS00+yGD1vjb4jj35a4G/mp8Y
+PQBw5763+zu+zmE2v3vD+j3fyjy+LmBNvHgRkj7Eig0+foM7vwzbDz6cFbi+kHjpvviLGz4jlSi9
+u+XNvRqIIb+DvFW9KVQCv/cWCL94XjQ+2twavijGGD99Uuc9P+WvPm6ECr3k4OS+Jsv+vkyc/Txc
+uaQ9WXJawKMLcb42g10+VnBrvuACtL4/yeW9jpKfviw/4L52P4K/u6JUvtQkAD4ceX2+BuoCPzlT
+Tj7OkKa+LQdRPilt4T7GzUu/ZWdZPiou2z7RLIO+JxFSvq1Kgr9ZYwc/E3+kvqQ3Kr5sDgW+e9RQ
+Pl183D1l8Zu/E53vvhtmX70jyas+ecJdvlMglr0Nt3E+vjYMPpJKbD4i+co97yMHv338Mb+Y3vo9
+hAJgvlbA5T7wuKm/MmGWwKXzyD7agj2+fZ7BPWIG4b0t11W+3dQtvhlzrb5tB6O+1XqqPvK1hr3C
+Gxg92SgqvROxGb4Cn54+yse7PopanD4Xj8K9QixBv6yuA7+ChWM55j4KvqxP5b7m0B+/LF+IPteI
+br/+mci+q3byPWGcjT0cev09zP6Kv6jp2L4EErU9dwwMPovlWz5CvJa+tZN5vgIcgL+qCSa/1CsX
+v/P+er5haxO+aaB+vsnW0L8H6ZO+xBd+PrYvET7UjwY+K47FwE+Woz7EVaq+lpX0vo9mkT0FcL2/
+Nq1tvj/sFb6fqoe+UNwXwGIXCT151Fk+IeDcvpd+Db737/U+qyEjv8RcFr9uAzM+t125PZ7a6L5h
+goA++kSQPRnc2b7aIMA+CdAKv5PTaT7FtOy+qAmCveF/iDxHAIi+nZ8av09W2b0pi/W74udIPtNg
+Sr8Tio+/ab1iPjR++j4nSLq/31gQPca7Rr7kBrO8O8o1vi4JPL/54cC+3zYGv+vYgL+/r1u/5uQ4
+v1fSBb6GR/k+3rIbvznU0T5WhIC/2X08Pr8H2bu6YaO9QENHvxnUaj5CXES/R/62vd2qMr+Toy2+
+k8c8PuTHQb68nlU+S7e/vuxgGj+qgby9bVmOvoaeU71N/0K+ZO4TPosJCT6XqrY+EwopvU3na780
+LVu822lxv3cV5r0HfJK+w+z1vm9JqjzLTRY+s0S+PvpDo74cAes+jtK2vyV4jb/ruW46PR8KP5st
+Sr/ZpOQ8DMIxv20B672dhde/MLiPvjjxdr69f7Y8fe6RwFeq373bpEM9TzWmvlPs0z5+Rng+cJui
+vdtO0D1iG0e/JrLtvjLf8z7C/xC/Fs0DPfD4pD3wC8m9Lnnlv/UT/rsJAPY+Me7KvWmznD6BTte7
+FDMDv23goz2AJSi/CgAavbx4P73PZbA+4ALevsvihj0Kbvq9R+x6vje9Lr9Rp5e/LjMKPnQU8b1f
+qW6/JohevUjblT6XFP49SJw4vrXAFj5WSvY97D9OPRgBfD6ICoC+TEK2PiYcBj6NHQg9jyV9vmfW
+ED81oZY+qrpiPhrp9z28N4U9Xx30PogVwj211Si8MRw+vjyGmL6tpHG9PGEavloT8T5d5xc+jMgh
+vsbQHj6HBzi/qsFYPoYnHD62OU6+Yam1vvwv/78jRXm8815avhPVgb1QCS89IDGrvQ4KLT4lgRo+
+cQLfPZbLgD1DMPK9rJIzPT7shb6dNNw++O/cv28lbz1QcNs9YWZuvWE1K72TtzA+jbPyvZdvrrxJ
+4QXBEbbwPRoDYb0pywk+cFinv18eoT2MnfY9aaAhvMkFub6jKUq+LNSRvd9hkT5vhRO9ZLytPfgf
+Tr5zXte+c2EyPZQrXj51koS/8sPPvo+UNbxO1FA+NCyOvq9Xhb3b6yM+RW/Fvy8ugb2vBfQ9Ezev
+vbQ1R77zOyS/TAQePSUg1r1JTpY+e8HIvuUrmb6s5p09xqPYvU6LNjxCL0O/M2SBv2S/iT5MsNY9
+Wf5vPkIPL7/6BxC+gg4kPpMkgz6upia+wG2owLW3sj1O9Ym/R5vfPILqG78/QeO+JuR3vs8vvz0C
+2Gm/mB0evlZRIL8MQKK/Q054PHLluL8IBW49Pp4wv2/on7wk6g4+GTKGvyszjj4sI4W6rJgbv9vX
+Fz+J6TC/10SXvjoM1D7vDMG+tP4Av6HDub6C5v49nR2Kvya6GL+egLK8GmvUPShCoL8kS8M+SZIG
+P0LH+j1QHHI+M5Owv13cO7/QOGy+aPORvlXkLT6qhc6+uIh8PjQBDz5AGNQ+ytBvvxsOAz9mPzi/
+jSyqv3H9j78bA8I+M7MewGPeBb8n5y47GJ4Evkb3nL1IDZu/+GImPplyPr2X6ya/p0euv/QsmT7W
+8N6+dx48vf881r5+B9E+eAYgPzn/6TzYH7G/2NYzvsvcsbyOOrk+y7c3vvK1JD4GWMk9fTWyv/EM
+IT6k+B+/ZaY3vO585750kU29Ng6OPhy8qT7HVKC/LxMJvqFVBL6YD7I9PtbDPfu7Ar7sWZm+6p4A
+P3RtNT+s7EK/9BZavzaqmj6Wf9I+yHJGvmF74r7lgcu+RRJMPcoWKT7sqwq+0acmvsMKyr07MQHA
+Xx2KvDCEnb4yYbm9n2QfvxMZij2kHfm+TyLyvxO3N7zETQw/s1exPsiyMT2t7yY+5c+dPtEXxD0O
+trS+j+6XvVylAD+iNmg9I+aTv5CoOL7hXno+4RmWPn2z3b5BTCO9PcydvVNtIL6msQc+73a5v8OC
+TT2yoYE+GWAOPt2wIr+0Vk+853w2v/XGgL40JZS+3S1Uv0GiP7+f9FC/mEGcwCGLi7+BjyQ9VmWT
+v3A2vL7THCe++911vjSUtb7q+l+/nTqCO3Pxjj7T6LG+yoUHvwescL+VBb++iN0Dvx6s+z0NOAq9
+7s0VPYpqUL4TCx8+8W6hvuYLoz6siSk+YEx0PWyD9L2iyts+n9zMva3GRL4t6QO/2TyePRjqIL9j
+Ibi9bO64PoUna77vItO/SrgCPy1bCL8xQbw+8zeIvqC/074fZfW+1Miav8albr8oV7Y+pASOvx6H
+LD6Ju+++2veuPqHq7r5MBR+/mZAnvsuVCb990JW+js8mv58nt777VDi/ER73PPtzhL/MsLi+OTpm
+Pnq5Fz4kV0e+uCmxv443i70z64o+cme2vihmLr+OQRI9uQMdPlELWD6WElS+zpxUvx+mSD66PIE+
+z/PMv31vhT3JVEW9+/5hvvAsdz6qRFQ+NT8Qv0AKXj5ZNva+RhXBv9ZzS79R4hg+wlBQv0cZDr6K
+IxA+kpyHvwlDNT5TMSm/sLiRv+iw2D4En8++FJMVwHq9WL50HPE9OdLrvdyCVb/DFIG9X5mcv+4J
+4TwVMBTAcAZZvwUlBD4PeIE9VQF5v3RsjT1Blqo7hpv8vnCB+r5J8FG+EUQtOn0YmD5ZgZq9lzfU
+vm7vQT2wrAs9MF8pvt/k+T1l+Sm+3/KgPmihmb1zX6g+XOReP0ZVBcBO7Q3Awdg2PgXLFD8mA4e/
+FAqKv3bvR7/ic0G/XIwrvPuEXb+Fmd6+tev5vfI72D60agY/KJBowGD6Oz1OaOW+qkUtPqTVB7+i
+KlK/2fYGPYM0FLwkxlu/UzRHv+Jd9L4TUHy/jXr+vosS377wk4+8hN5Ov38McD4mWKE+Knw8v9H7
+A71sZN+97iKcv2nDeT1uLRy/Nj+OvQ0MFz770cC9PEi7viaybr7948s9Fd+Qv9OdUb+o3by9knyO
+votXNb6bVYu+D0pPvlrf371Y6Sk9VHtxv3d2W79h00s9gG9nPPZJDb9VJ60+dJXPPXEj8zz8A5e/
+mGmqvuwrf798WTW/JZ8OwF2BID89JVw+tGiGvGeYvL5/MFM+kZCpvvJag75N7K2/fLuUvuXCCj89
+AT6/3j7mvzu/zD6dhpG/TPQKPglozr9p87M9AySlPjv38b3wlRq+SNjYPQgJvb0ePgu+pnqgvbV1
+x71R1s8+xHNdvspUnL6jjSu+RJIXvnodjj1Hlhy+B/IpvcnCrT53D9A+E1wsPhmRLj5/jiS9sxLl
+voQYCL+anKs9Au0SvrlEI70ytV6+XPbEPkNDfbwJXJq8bzwFPY0duz3k/yi+BLu0vrAEFr2vtcO9
+7uiTvsATx7xlEQa+ZgvbvX5DKD9WGKc72JcsvvDSEL4VY6M5xjEJPdiwRL4X+aG9wUyGPe3EgL5z
+Wji+1oNBv41+Kj2FVoW9WnSevIb3Ib8MyWc+Gi3Vvqlthr/f6GU9bVyYvRFSBT4/Ucu8qIbmvo2w
+bD43xAg+D10uPrXvL71zS7i7XVHNviz5Nb9n+nU/YU+Ivehvg74KeUo+OzcRPrbdAT8DDJA+zmWu
+Pl8DBb8y94C+GGS/vnUNoDwKqeq8mQlgPtp5hL8he4o+Re6zPQB7wL2QFEW9nbMIvMJENz4MLQK9
+sSsYvBPAxj2u3Zy+jGlrvhE3pr62MLW9a13Lv9/KGj+ND+s9DyAkPBeFeT1vOo09BqolvKIper1R
+LXO/yogNP3M1p8B4T1DAHEroPc6AIj9RjhHAFHE6v4zBi7/Ywfa/bZZ9v56BCb/JbJO/YIhSvWC4
+Pb43mcg9Qrx5wMPBer4M8Lq+hWOKPsTf2r+DejC/PXM2v16rjL8dYhe/Y4mpvz/xZj6Qwcc+MJMX
+PinUA7//nn49LMTPv4b3Sr92EYQ+UAY3P9e9e75qK0I+RSSSvvxm1b2aFw4+O7SPvpMvMb7Dgii/
+zr2lvobR5z2XUq69VRV0Pu75jL/XsR++vCZ5v6VFID6hnaq+Sai5vqyS4D7fTXa+43mMP0wNSL+6
+Mr89tiXjvM/7Zb+6ihW/raHOPotr+7u6Af6+93/dvCMRwL9XdM+/GPjOPQXQkr9Zqmm+93VOv9Dy
+GDuK5G+8sR64PuXSib7B4am/YgSDPdZCK7/TfZW9hy6kv57sir94xYu/yuXDvusK7b9rAhQ+jWaC
+vrv5qL85sQ8+ICHsveop3D2Uocg+QVONvUKPUD46240+k0ZcPoSOBT4EbLS/CLxjvzRHwDyQwSW+
+JeW5vSRI3D1qUai/epG5PhFqj75H3GM+7OuzvUhXaT5kw/u+i1hHPs/UJD5UtHi/BUqRPhn1yjzp
+0Jk+8qz7vWhl1r4OhaC+OHBpvcS6Rr7ZYjG9pXQpP7mkHb9SwJG96QGmPVXWmj5hjhO/cIlPv91f
+B783zIa+zOAlwPxdz76LQsk+WA95Pen2Rz3S8L48erQUPkjPhL9nY0C9LgfUPUAsyD3ShBw+athl
+v8xNJ77/ZHY++oUDvxddZ71N7AG/Z5IKPYr7Hz4lLpe/RS4TPh5Dbj5Qpt89TSWIPrkjeb8tTTm+
+TXxuPrcCJD2izd4+VdwGwAlfTr592v69YGEHwJ4b7r0zFgu/qyypPor6a75/AlA+ERTWvqrRpL3u
+rJ89nsdwvnLLDr7y1Jg+4/8kv8sLVcBf3/6+sey5Pr+Zc77HuKo8+9rNvZ6Y3T23Jxe+QMn3v2tw
+0z5d5aU+L86VPA35iz39EUy/d6h+PvVsjL7xSeC+fE8EwAa0lL8Ppg2/O3VTvsGABT582WK/BJhX
+v5EXfD52KPs95zahvrtFYb2RWta9ppn9PnpbVD7Mdbe/UI04wN0Lbb4bALc+WOiIvkXKLL/DZko/
+B3eHvxhkrb9uzaG/13TYPopanr08sDq9VIjrPQaq7r9gi58+STd4vy+V6L4Leg8/qeTPPvWIQ7xg
+Xxu/FTLDvoZrCD6OD4699u6TvVh/X7988H2+xivdPW5EAj9L01++kO1Dv2dmgL/FyEk85Z6sPuC2
+lj7fxr28Fu6JPkz+9z5KiME+ZHUFwD2f3TtiQ0m8r4cRv2Mhr7/rLSW/w7PtPnDdDz5ykem+hw3N
+viSeRb4R8RI+iiS5v+Y/B7/wQxE9BdFVv9dc8r4Ab8C9giU9vwirLL9xIb6/UEbxvm0CPL7k0a69
+BH9hv6Do+z3zsbI+tmtavugdWz6LQSY/vo0QPmvIKL8YFy2+b34TP8BuJ74dfde+GFNovkjIaz5a
+9RE/sW5gv7o5y7tjJtI+tjzAPMIcSr/MJUfApwqQPB+3h7yQ8obAYDd8vYEddT4+4IS/eJ+GPoTN
+Gr7xTQ6+U/+qvlHDpb99tzM/EXiWvz5sXb/IEJe9xA8GPi6akz6lKDS8sxCBPnKasD7zFGe+ZF92
+v4TnjL9v8E2/2wGqPvnoub1bMQW/SrOFv7t5Dz/iXOs99QMfvgoVub6Wm74+xSshvvDDD7/P4Zo+
+72tru6nhrj56LXC9up0pP/vcL7+yaW4+R5UyPjHuLj2wTCA+FRcpP7U4Br7kAOO9gBj4vdL1J7/B
+obi/0DgZP9xAET4Iafa8qQvYPI7Gzz4gfGu/vgLivnI1yL2/fYG9NfaIPfknML6TSqm+S1amv/jG
+yD2Y6SK+W1Chv94PHj3seh09wkYzPGASAb0QfOy+HwrePkzpO77ckjK+NZbsveghp79gHIu+n5ZU
+v+MDf7+d1Yk+QecQP8/u0r7/R+S9uAWXPhYzMz2M/Se+YPUNPvPFST5owvO+Ohc7PieY1D1I70k9
+8abuPZk4QcDfrTfA+tqpv2kkEb/QN4C/hR8lvvdkHL5u9MO+tUXgvsMyK7+k2Jq/j2tCvwCwOT67
+rTe/L7aqwCdSh74cK7a+lAzfvYprrb5JiP2+nZGOvuka477MM/y+tfiqPjRoF77P8qa+0H+SvbfW
+Rr5ztew9eXKWv3GMIz7qDT2/mSQAPzRTVL8qFfs+MfElv/gIrr8EqGY9ixUav7QTZz1DjjM+O+Dk
+vizndb5hbaG++FEeP8vYmL6RWrO+6N8YvVwSvT5wgJ+/1b3jvqFozr971fm9QQYrPyv3wr4Veum9
+2/ekPTytTj4+2K6/0AmSvm4FnD36You+92/MPXuD/r6v5T4+BFGHv66lT78EYhG/1Np7Pqhxrj5W
++yk+1Lg7PocawLvzugk/msBMPes2Eb+lubg+osQuvgaLZb5bqxi/PQHZv8ItXb8mFTy9CIqgPfRK
+hb8fl9k8HQJjPt2i2Lzeuta9v6JKPo8woz3DMCO+cGuAPZYzrr7Vxag94hpOPoGMTj0blCO+tb89
+PmdH+L7w76s+GYbovnhh2z0czv67xWUxPV0alr6P4VO9n3UQPsp1nL2NcEW9HJdYPzOJVL1V8D+9
+i7yAPVkKmL8IqeA6qmgLPQZdTb5VpvS+tjJYvuYbgL/XdNG8aZ0rvU3gLT/dgg091SfDvuBoxz3n
+Cj4+OWCAOx2Jt73cS/q9psFwvk7tYL6NHgI9vf7CPcXp+zxV0c691PkCvveloD6ms3Y+yQnJvcg3
+nL+E11k+IIX6vSF4Cr7gtNi94WjOvgkF3jyyuh8+Q9XGPf75Qr/IZvs9j/EjPhSJND62pya+0IV+
+PbHxlb70O0g+7/UVPnlymL64ksa+vJVjPmxThz6s/7u+uEQuvHrRLLzvzxW+qNx2PqY/tr7kHkU+
+B1X3PUXGBb/Am2E+vkTNvSLUMT6Fho+98bIyvIUBxD14E46+1XRAvt6imr9f1mu/szTrvm2YuL6l
+2FG+GQwtPmsonz7REza/rLTGPnZ/XT4CCoQ+unimv+W/jz/Axbu9Lm8iwG0/pb7YPRXAnYlHv2a7
+4L7o898+xsKbvovL0L4oPcK+ixuZv5ioij8Jy0y/diMVwLdOL76lW9W/hFBNP4J8q79V7F6+dQaW
+v82HYL9YKHW/7eQxPq/eqb5r8ly/KF1uvvOFX7/vhrQ/Iy7CPvM0zL5g2Q0/U17Fvzd+/r0fpjM/
+SjZ/v26knL/jweE8EsSyv4BaTD91Q6K8M1KcvwynR7/Hivc9O7HIvo0CLr8aooO9/rX5vsZeJz+H
+lK2/tzEHvez8QMCCFDU/JDvav8giBL1Q6pk+1QEnvwtLIj+9yOq/GNqiPhUP0b2zk6y/od6IPygR
+Kj3Vb6i/j9kDwNbI0r97STC/3T6Lv4BZOT8W60s+3bhev86Zv72unTHAsN8rP8KTgr8wLyG/yEEV
+vxj/gD7nvwO+aUuGwJuko74zOLg+r+w4P3zHBr85flm/Mi7ZvpQ4oD3xX+m+T/2jPfvchb2BVam+
+w+Axvw86Ur36sc++YMxAv+f87j68ABy/Q0wGvgrFHr7wR3S/Vfe3Prfw7Tt49Vc+3hcYOodsHD4f
+lKk8DfrIvubySL4qSHW/JZRfv6k/xj6CPIO9U3oZP8dyg7/7uiA/ZTPcvt6rFL+29+6+NhsXPcPL
+Gr4rqqq+BvyyPr69Ub6MPg0/owGVPs8uE75zkwE/qJimv4SsFj6goNk+Oah0PjEdgr7EwoG+2i2E
+Pq/TxTxcG6y+YUQ3PROkU76ioO+9bbBcv2Jgdz6zMDm9ej9gvZ4Elz44DwK87eowvt/dBr+3eV0+
+KHsPwOvUCj59elQ+52JyvdQT3760EXG+WRiwPgCCP7wUaDi/WAaHv97v7z42QCO/wP45wHkHNb/7
+MoS+srVvvwYKdT642D+9VafEv5ojjL5m4dG/VxTbPow6oz4qTdy+6P2Tvuw/l75KVpe9QXU5v5AS
+rT0Q79S+jFt6vXtN0T2pHMq69BYfPk1ypL2vWAI9q0r4PasQXD2TLDS/DA+8PSfsjTzpG4u9nk0V
+PqE0fj3riuW81q6pvZvV/b7CyrY+pL+lvktWIT4wXFc9FWaDvuiVnr63qq0+BLfHPT2D97xg5ay+
+wGLZwOtaEb2xiUM8Dl4ZPU/YtT3Bnca9tDAKvgu1wb/kfl++oTBfvuyNLr18bAC+AwAlvnmmIsBN
+aR88Ouw3vmHoar+WcZ88Lts/PcCb+738LbS9yFlTvI0wpb5HKb88LEKxvKiPLT0+Kyi9wHOsPRzc
+A78DYxK96psZPdB6Ob4bU/C9i6msvR2b8j3QyIC9i6POvtUYnz67eRQ+ctaVPkoqhb75S2G+ut5s
+u2G7tL4kojG/YlMGPaImPL7tnU0+7FgQPhsvbb4TyQS+DMmTvUHvE7+9k16+/Iz5vOdNhTySExi9
+PeFbPk5KDb//h2Q+PkTePVJbN74wgEQ96Nr0vE+3Ij5huCS9NG7nPQRZHj7Ir26+mEcuvoMHlr6i
+RIu/AMvlPDf80jxJpQa/TqTCPNxhu72VIEm9pdC4voBGxD0ww7Q+8qc6vzNZfsAOf1rAE3OLv+v+
+Vr8GNvi/U3j5vmi5Iz5gIui+4le5Pj/mN748ysI+zWQuv/K1NMBXilG/eXuWvyp8mb/9DR0/w3Bg
+vvF6Sj8oXUA/gUsIv7PjkT0s1j++tpVlvy46i74ujxE+QSttPr/U7L7NP069gqx8v0aXfj6a8kY/
+aQ8Av/9Ehr995fO+Rch+P3OJhL8iERC/VWaTuK3Dgb4u9ni/vHykvlFng7/8nrM+nDAcv9kaWr/G
+DA++V9eVPmWHKb22L469Cu6SvoAAFMBx2wi/3PLZvgTluD4qM3O9MW3Ivhzqmb5IBhTAnqZjPSe2
+Sb2s+aS/Xy9fPIpky75LZK6+LGzdv0Wjmr7TMDi/ZfQ5v3t5oD4caWq92dIrvyES4D5BcdO//gHV
+PtcF4b5cqAu/jKkevvXabz31V7y90ip7wDUoJb8H2mA/6xDLusXCkb+EZmm+e2RhPWrFi71g6gi/
+n+HlvXScVL3Itcq9a0yRvOQQ/r5inZG9jTCivTX5Qb4eI8693STDvgqbmL6oGoY+KXG5vrpXhb6X
+Lxm+yprhvgSxqL4UhuM+HtMhP7iMA7791Qu9My4Tvw==
+`
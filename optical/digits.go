@@ -0,0 +1,469 @@
+package optical
+
+import (
+	"image"
+	"math"
+	"os"
+	"sort"
+)
+
+// Digit recognition for data boxes. The ink in the box is split into
+// separate marks, and marks that overlap side by side are treated as one
+// glyph (e.g. a 5 written in two strokes). Small marks near the bottom are
+// decimal points. Each glyph is scaled into a small grid and classified by the
+// digit model; the confidence is the chance the model gives that every glyph
+// is the digit it was read as, so anything that is not a digit, or that is
+// not clearly one digit rather than another, gives a low confidence.
+
+type DigitReading struct {
+	Text       string
+	Confidence float64 // 0 to 1, the chance the whole reading is right
+}
+
+const (
+	glyphSize         = 32
+	glyphFit          = 24 // glyphs are scaled to fit this, in the middle of the grid
+	featureGrid       = 8  // the directions of the edges are added up over this coarser grid
+	featureDirections = 4  // across, up, and both diagonals
+	featureCount      = featureDirections * featureGrid * featureGrid
+	inkThreshold      = 0.5 // fraction of full luminosity that counts as ink
+	minMarkPixels     = 4   // ignore specks smaller than this
+	dotSize           = 0.3 // marks smaller than this fraction of the tallest mark are dots
+	strayMarkPenalty  = 0.8
+)
+
+type mark struct {
+	pixels                 []image.Point
+	minX, minY, maxX, maxY int
+}
+
+func (m mark) width() int  { return m.maxX - m.minX + 1 }
+func (m mark) height() int { return m.maxY - m.minY + 1 }
+
+func ReadDigitsFile(inputPath string, boxes []Box) ([]DigitReading, error) {
+
+	var results []DigitReading
+
+	reader, err := os.Open(inputPath)
+
+	if err != nil {
+		return []DigitReading{}, err
+	}
+
+	defer reader.Close()
+
+	wholeImage, _, err := image.Decode(reader)
+
+	if err != nil {
+		return []DigitReading{}, err
+	}
+
+	for idx := 0; idx < len(boxes); idx = idx + 1 {
+		results = append(results, ReadDigits(wholeImage, boxes[idx]))
+	}
+
+	return results, nil
+}
+
+// ReadDigits reads a number written in the box. An empty box gives an
+// empty reading with zero confidence.
+func ReadDigits(im image.Image, box Box) DigitReading {
+
+	checkImage := im.(SubImager).SubImage(box.Bounds)
+	bounds := checkImage.Bounds()
+
+	w := bounds.Dx()
+	h := bounds.Dy()
+
+	if w < 1 || h < 1 {
+		return DigitReading{}
+	}
+
+	ink := make([]bool, w*h)
+	threshold := uint32(math.Round(inkThreshold * 3 * 65535))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, _ := checkImage.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			luminosity := r + g + b
+			if box.Vanilla {
+				ink[y*w+x] = luminosity < threshold
+			} else {
+				ink[y*w+x] = luminosity > threshold
+			}
+		}
+	}
+
+	return readMarks(findMarks(ink, w, h), w, h)
+}
+
+func readMarks(marks []mark, w, h int) DigitReading {
+
+	// drop the edges of the box, if any were left in by the box shrink
+	kept := []mark{}
+	for _, m := range marks {
+		if m.width() > 8*w/10 && m.height() < h/10 {
+			continue
+		}
+		if m.height() > 8*h/10 && m.width() < w/10 && (m.minX == 0 || m.maxX == w-1) {
+			continue
+		}
+		kept = append(kept, m)
+	}
+	marks = kept
+
+	if len(marks) < 1 {
+		return DigitReading{}
+	}
+
+	tallest := 0
+	bottom := 0
+	top := h
+	for _, m := range marks {
+		if m.height() > tallest {
+			tallest = m.height()
+		}
+		if m.maxY > bottom {
+			bottom = m.maxY
+		}
+		if m.minY < top {
+			top = m.minY
+		}
+	}
+
+	small := float64(tallest) * dotSize
+	middle := float64(top+bottom) / 2
+
+	strokes := []mark{}
+	dots := []mark{}
+	confidence := 1.0
+
+	for _, m := range marks {
+
+		// part of a digit written over the edge of the box is cut off from
+		// the rest of it, and is not a decimal point however small it is
+		edge := m.minX == 0 || m.minY == 0 || m.maxX == w-1 || m.maxY == h-1
+
+		if float64(m.height()) < small && float64(m.width()) < small && !edge {
+			if float64(m.minY+m.maxY)/2 > middle {
+				dots = append(dots, m)
+			} else {
+				confidence *= strayMarkPenalty //stray mark, not a decimal point
+			}
+			continue
+		}
+
+		strokes = append(strokes, m)
+	}
+
+	glyphs := groupMarks(strokes)
+
+	if len(glyphs) < 1 || len(dots) > 1 {
+		return DigitReading{Confidence: 0}
+	}
+
+	type item struct {
+		x    float64
+		text string
+	}
+
+	items := []item{}
+
+	for _, g := range glyphs {
+		digit, c := classifyGlyph(g.pixels)
+		confidence *= c
+		items = append(items, item{x: float64(g.minX+g.maxX) / 2, text: digit})
+	}
+
+	for _, d := range dots {
+		items = append(items, item{x: float64(d.minX+d.maxX) / 2, text: "."})
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].x < items[j].x })
+
+	text := ""
+	for _, it := range items {
+		text = text + it.text
+	}
+
+	if len(dots) == 1 && items[len(items)-1].text == "." {
+		confidence *= strayMarkPenalty //a trailing point is more likely a stray mark
+	}
+
+	return DigitReading{Text: text, Confidence: confidence}
+}
+
+// findMarks labels the connected regions of ink
+func findMarks(ink []bool, w, h int) []mark {
+
+	seen := make([]bool, len(ink))
+	marks := []mark{}
+
+	for start := range ink {
+
+		if !ink[start] || seen[start] {
+			continue
+		}
+
+		m := mark{minX: w, minY: h, maxX: -1, maxY: -1}
+		queue := []int{start}
+		seen[start] = true
+
+		for len(queue) > 0 {
+
+			idx := queue[0]
+			queue = queue[1:]
+
+			x := idx % w
+			y := idx / w
+
+			m.pixels = append(m.pixels, image.Point{x, y})
+			m.minX = minInt(m.minX, x)
+			m.minY = minInt(m.minY, y)
+			m.maxX = maxInt(m.maxX, x)
+			m.maxY = maxInt(m.maxY, y)
+
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					nx := x + dx
+					ny := y + dy
+					if nx < 0 || ny < 0 || nx >= w || ny >= h {
+						continue
+					}
+					n := ny*w + nx
+					if ink[n] && !seen[n] {
+						seen[n] = true
+						queue = append(queue, n)
+					}
+				}
+			}
+		}
+
+		if len(m.pixels) >= minMarkPixels {
+			marks = append(marks, m)
+		}
+	}
+
+	return marks
+}
+
+// groupMarks joins marks that overlap side by side by more than half
+// the narrower one, so strokes of the same digit are read together, and
+// joins a mark less than half the height of another to it if they overlap
+// at all, e.g. the tail of a digit cut off where it crosses the box edge
+func groupMarks(marks []mark) []mark {
+
+	sort.Slice(marks, func(i, j int) bool { return marks[i].minX < marks[j].minX })
+
+	glyphs := []mark{}
+
+	for _, m := range marks {
+
+		if len(glyphs) > 0 {
+
+			last := &glyphs[len(glyphs)-1]
+			overlap := minInt(last.maxX, m.maxX) - maxInt(last.minX, m.minX) + 1
+			narrower := minInt(last.width(), m.width())
+
+			overlapY := minInt(last.maxY, m.maxY) - maxInt(last.minY, m.minY) + 1
+			fragment := 2*minInt(last.height(), m.height()) < maxInt(last.height(), m.height())
+
+			if 2*overlap > narrower || (fragment && overlap > 0 && overlapY > 0) {
+				last.pixels = append(last.pixels, m.pixels...)
+				last.minX = minInt(last.minX, m.minX)
+				last.minY = minInt(last.minY, m.minY)
+				last.maxX = maxInt(last.maxX, m.maxX)
+				last.maxY = maxInt(last.maxY, m.maxY)
+				continue
+			}
+		}
+
+		glyphs = append(glyphs, m)
+	}
+
+	return glyphs
+}
+
+// classifyGlyph gives the digit the glyph is most likely to be, and the
+// chance that it is; a glyph that is more likely not to be a digit at all
+// gives notDigit, with zero
+func classifyGlyph(pixels []image.Point) (string, float64) {
+
+	_, chance := digitModel.classify(glyphFeatures(pixels))
+
+	best := 0
+	other := 0.0
+
+	for k := range chance {
+		if k >= digitCount {
+			other += chance[k]
+		} else if chance[k] > chance[best] {
+			best = k
+		}
+	}
+
+	if other > chance[best] {
+		return notDigit, 0
+	}
+
+	return digitClasses[best], chance[best]
+}
+
+// glyphFeatures scales the ink to fit the grid keeping its aspect ratio,
+// then finds which way the edges of the strokes run at each point, and adds
+// them up over a coarser grid, one for each direction. Where the strokes go
+// matters more to telling digits apart than exactly which pixels are inked,
+// and it is much the same for thick and thin pens.
+func glyphFeatures(pixels []image.Point) []float64 {
+
+	features := make([]float64, featureCount)
+
+	if len(pixels) < 1 {
+		return features
+	}
+
+	grid := make([]float64, glyphSize*glyphSize)
+
+	minX, minY := pixels[0].X, pixels[0].Y
+	maxX, maxY := minX, minY
+
+	for _, p := range pixels {
+		minX = minInt(minX, p.X)
+		minY = minInt(minY, p.Y)
+		maxX = maxInt(maxX, p.X)
+		maxY = maxInt(maxY, p.Y)
+	}
+
+	w := maxX - minX + 1
+	h := maxY - minY + 1
+
+	scale := float64(glyphFit) / float64(maxInt(w, h))
+	offX := (glyphSize - float64(w)*scale) / 2
+	offY := (glyphSize - float64(h)*scale) / 2
+
+	for _, p := range pixels {
+
+		x := offX + (float64(p.X-minX)+0.5)*scale - 0.5
+		y := offY + (float64(p.Y-minY)+0.5)*scale - 0.5
+
+		x0 := int(math.Floor(x))
+		y0 := int(math.Floor(y))
+		fx := x - float64(x0)
+		fy := y - float64(y0)
+
+		addToGrid(grid, glyphSize, x0, y0, (1-fx)*(1-fy)*scale*scale)
+		addToGrid(grid, glyphSize, x0+1, y0, fx*(1-fy)*scale*scale)
+		addToGrid(grid, glyphSize, x0, y0+1, (1-fx)*fy*scale*scale)
+		addToGrid(grid, glyphSize, x0+1, y0+1, fx*fy*scale*scale)
+	}
+
+	// thick and thin pens should look alike
+	for i := range grid {
+		grid[i] = math.Min(1, 2*grid[i])
+	}
+
+	grid = blur(grid, glyphSize)
+
+	cell := glyphSize / featureGrid
+
+	for y := 1; y < glyphSize-1; y++ {
+		for x := 1; x < glyphSize-1; x++ {
+
+			at := func(dx, dy int) float64 { return grid[(y+dy)*glyphSize+x+dx] }
+
+			gx := at(1, -1) + 2*at(1, 0) + at(1, 1) - at(-1, -1) - 2*at(-1, 0) - at(-1, 1)
+			gy := at(-1, 1) + 2*at(0, 1) + at(1, 1) - at(-1, -1) - 2*at(0, -1) - at(1, -1)
+
+			size := math.Hypot(gx, gy)
+
+			if size == 0 {
+				continue
+			}
+
+			// edges either side of a stroke point opposite ways, but run the same way
+			angle := math.Atan2(gy, gx)
+			if angle < 0 {
+				angle += math.Pi
+			}
+
+			d := angle / (math.Pi / featureDirections)
+			d0 := int(math.Floor(d)) % featureDirections
+			d1 := (d0 + 1) % featureDirections
+			f := d - math.Floor(d)
+
+			offset := (y/cell)*featureGrid + x/cell
+			features[d0*featureGrid*featureGrid+offset] += (1 - f) * size
+			features[d1*featureGrid*featureGrid+offset] += f * size
+		}
+	}
+
+	for d := 0; d < featureDirections; d++ {
+		area := features[d*featureGrid*featureGrid : (d+1)*featureGrid*featureGrid]
+		copy(area, blur(area, featureGrid))
+	}
+
+	norm := 0.0
+	for _, v := range features {
+		norm += v * v
+	}
+
+	norm = math.Sqrt(norm)
+
+	if norm > 0 {
+		for i := range features {
+			features[i] = features[i] / norm
+		}
+	}
+
+	return features
+}
+
+func addToGrid(grid []float64, size, x, y int, v float64) {
+	if x < 0 || y < 0 || x >= size || y >= size {
+		return
+	}
+	grid[y*size+x] += v
+}
+
+func blur(grid []float64, size int) []float64 {
+
+	kernel := []float64{1, 2, 1}
+	tmp := make([]float64, len(grid))
+	out := make([]float64, len(grid))
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			for k := -1; k <= 1; k++ {
+				if x+k >= 0 && x+k < size {
+					tmp[y*size+x] += kernel[k+1] * grid[y*size+x+k]
+				}
+			}
+		}
+	}
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			for k := -1; k <= 1; k++ {
+				if y+k >= 0 && y+k < size {
+					out[y*size+x] += kernel[k+1] * tmp[(y+k)*size+x]
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,228 @@
+package optical
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeDigits draws digits into a white box, with a slant and pen
+// that differ from the prototypes, like a marker writing with a stylus
+func writeDigits(text string, slant, pen float64) *image.RGBA {
+
+	height := 60.0
+	width := 0.75 * height
+
+	im := image.NewRGBA(image.Rect(0, 0, 40+int(width)*len(text), 100))
+	draw.Draw(im, im.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	x := 20.0
+
+	for _, c := range text {
+
+		var strokes []stroke
+
+		if c == '.' {
+			strokes = []stroke{line(0, 0.97, 0.02, 0.97)}
+		} else {
+			strokes = digitStrokes[string(c)][0]
+		}
+
+		for _, s := range strokes {
+			for i := 1; i < len(s); i++ {
+
+				x0 := x + (s[i-1].x+slant*(1-s[i-1].y))*height
+				y0 := 20 + s[i-1].y*height
+				x1 := x + (s[i].x+slant*(1-s[i].y))*height
+				y1 := 20 + s[i].y*height
+
+				steps := int(math.Hypot(x1-x0, y1-y0)) + 1
+
+				for j := 0; j <= steps; j++ {
+					t := float64(j) / float64(steps)
+					cx := x0 + (x1-x0)*t
+					cy := y0 + (y1-y0)*t
+					for y := int(cy - pen); y <= int(cy+pen)+1; y++ {
+						for x := int(cx - pen); x <= int(cx+pen)+1; x++ {
+							if math.Hypot(float64(x)-cx, float64(y)-cy) <= pen {
+								im.Set(x, y, color.Black)
+							}
+						}
+					}
+				}
+			}
+		}
+
+		if c == '.' {
+			x += 0.3 * height
+		} else {
+			x += width
+		}
+	}
+
+	return im
+}
+
+// TestReadDigits checks that digits drawn from the stroke models, with a
+// slant and pen the model was trained on, are read back
+func TestReadDigits(t *testing.T) {
+
+	for _, text := range []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "7.5", "42", "0.5", "369"} {
+
+		for _, pen := range []float64{1.5, 3} {
+
+			im := writeDigits(text, 0.1, pen)
+
+			reading := ReadDigits(im, Box{Vanilla: true, Bounds: im.Bounds()})
+
+			assert.Equal(t, text, reading.Text)
+			assert.True(t, reading.Confidence > 0.5, "%s confidence %f", text, reading.Confidence)
+		}
+	}
+}
+
+func TestReadDigitsEmpty(t *testing.T) {
+
+	im := writeDigits("", 0, 2)
+
+	reading := ReadDigits(im, Box{Vanilla: true, Bounds: im.Bounds()})
+
+	assert.Equal(t, "", reading.Text)
+	assert.Equal(t, 0.0, reading.Confidence)
+}
+
+func TestReadDigitsScribble(t *testing.T) {
+
+	im := writeDigits("", 0, 2)
+
+	// a crossing-out is not a number we should trust
+	for i := 0; i < 50; i++ {
+		for j := -2; j <= 2; j++ {
+			im.Set(10+i, 20+i+j, color.Black)
+			im.Set(60-i, 20+i+j, color.Black)
+			im.Set(10+i, 45+j, color.Black)
+		}
+	}
+
+	reading := ReadDigits(im, Box{Vanilla: true, Bounds: im.Bounds()})
+
+	assert.True(t, reading.Confidence < 0.5, "scribble read as %s with confidence %f", reading.Text, reading.Confidence)
+}
+
+func TestReadDigitsFile(t *testing.T) {
+
+	boxes := []Box{
+		{Vanilla: true, Bounds: image.Rect(10, 10, 40, 40)},
+		{Vanilla: true, Bounds: image.Rect(10, 60, 40, 90)},
+	}
+
+	readings, err := ReadDigitsFile("./img/test.png", boxes)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(boxes), len(readings))
+
+	_, err = ReadDigitsFile("./img/test.svg", boxes)
+	assert.Error(t, err)
+}
+
+// stylusBox is a box on a real page marked with a stylus, with the number
+// written in it, or "" if there is no number we should trust (an empty box, a
+// letter, a slash, or a mark out of a total like 6/12)
+type stylusBox struct {
+	bounds image.Rectangle
+	text   string
+}
+
+// the inside of the subtotal boxes on the stylus pages, top to bottom
+var stylusSubtotals = []image.Rectangle{
+	image.Rect(1480, 107, 1568, 195),
+	image.Rect(1480, 228, 1568, 316),
+	image.Rect(1480, 348, 1568, 437),
+	image.Rect(1480, 469, 1568, 557),
+	image.Rect(1480, 590, 1568, 678),
+	image.Rect(1480, 710, 1568, 798),
+	image.Rect(1480, 831, 1568, 919),
+	image.Rect(1480, 951, 1568, 1040),
+	image.Rect(1480, 1072, 1568, 1160),
+	image.Rect(1480, 1192, 1568, 1281),
+	image.Rect(1480, 1313, 1568, 1401),
+	image.Rect(1480, 1434, 1568, 1522),
+	image.Rect(1480, 1554, 1568, 1642),
+	image.Rect(1480, 1675, 1568, 1763),
+	image.Rect(1480, 1795, 1568, 1884),
+	image.Rect(1480, 1916, 1568, 2004),
+	image.Rect(1480, 2036, 1568, 2125),
+}
+
+// the inside of the section, question number, and mark boxes on the stylus pages
+var stylusSection = image.Rect(1755, 438, 1839, 522)
+var stylusNumber = image.Rect(1652, 565, 1842, 649)
+var stylusMark = image.Rect(1652, 707, 1842, 791)
+
+func stylusPage(subtotals map[int]string, section, number, mark string) []stylusBox {
+
+	boxes := []stylusBox{}
+
+	for i, bounds := range stylusSubtotals {
+		boxes = append(boxes, stylusBox{bounds, subtotals[i]})
+	}
+
+	return append(boxes,
+		stylusBox{stylusSection, section},
+		stylusBox{stylusNumber, number},
+		stylusBox{stylusMark, mark})
+}
+
+// TestReadDigitsStylus reads every box on the real pages marked with a stylus.
+// None of these marks were used to train the model. Every number must be read
+// correctly with a confidence we would trust, and nothing else may be trusted.
+func TestReadDigitsStylus(t *testing.T) {
+
+	const trusted = 0.9
+
+	pages := map[string][]stylusBox{
+		// a tick, a slash, 2, A, 1, and 6/12
+		"./img/test2.jpg": []stylusBox{
+			{testCheckBoxesStylus[0].Bounds, ""},
+			{testCheckBoxesStylus[1].Bounds, ""},
+			{testCheckBoxesStylus[2].Bounds, ""},
+			{testCheckBoxesStylus[3].Bounds, ""},
+			{testCheckBoxesStylus[4].Bounds, "2"},
+			{testCheckBoxesStylus[5].Bounds, ""},
+			{testCheckBoxesStylus[6].Bounds, "1"},
+			{testCheckBoxesStylus[7].Bounds, ""},
+			{testCheckBoxesStylus[8].Bounds, ""},
+		},
+		"../parsesvg/img/stylus-1.jpg": stylusPage(map[int]string{0: "", 3: "2", 5: "3"}, "", "1", ""),
+		"../parsesvg/img/stylus-2.jpg": stylusPage(map[int]string{}, "", "", ""),
+		"../parsesvg/img/stylus-3.jpg": stylusPage(map[int]string{1: "2", 3: "2", 4: "1", 6: "2", 7: "2", 10: "3", 13: "5"}, "", "1", "17"),
+	}
+
+	for path, page := range pages {
+
+		boxes := []Box{}
+		for _, b := range page {
+			boxes = append(boxes, Box{Vanilla: true, Bounds: b.bounds})
+		}
+
+		readings, err := ReadDigitsFile(path, boxes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(page), len(readings))
+
+		for i, reading := range readings {
+
+			if page[i].text == "" {
+				assert.True(t, reading.Confidence < trusted, "%s box %d read as %s with confidence %f", path, i, reading.Text, reading.Confidence)
+				continue
+			}
+
+			assert.Equal(t, page[i].text, reading.Text, "%s box %d", path, i)
+			assert.True(t, reading.Confidence >= trusted, "%s box %d read as %s with confidence %f", path, i, reading.Text, reading.Confidence)
+		}
+	}
+}
@@ -0,0 +1,506 @@
+package optical
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"math"
+	"os"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// Registration: pages that are printed, marked on paper and scanned back come
+// back rotated, scaled and shifted, so the box positions from the layout no
+// longer line up with the boxes in the image. The renderer draws a solid square
+// fiducial in each corner of a box that the layout puts on the bar, clear of
+// the previous image (see parsesvg). We find them in the scanned image, fit an
+// affine transform from where they should be to where they are, and resample
+// the page through it, so the layout's box positions fit again.
+//
+// A page that has been through more than one stage also has the fiducials of
+// its older bars on it, to the left of the newest bar, because a page only grows
+// to the right. So we take the rightmost set of marks that has the shape and
+// size of the box, which is the newest.
+
+var FiducialSize = 10.0 // side of each fiducial square, in points
+
+const (
+	fiducialSolidity      = 0.8  // fraction of its bounding box that a fiducial fills
+	fiducialSquareness    = 0.7  // ratio of the short side of a fiducial to the long side
+	fiducialSizeTolerance = 0.4  // fraction that a fiducial's side can differ from what the box spacing implies
+	fiducialMaxSkew       = 0.1  // radians that the page can be rotated on the scanner
+	maxRegistrationError  = 0.01 // worst fit to the fiducials, as a fraction of the distance between them
+)
+
+// fiducials are always in this order
+const (
+	TopLeft = iota
+	TopRight
+	BottomLeft
+	BottomRight
+)
+
+// Affine maps x,y to A*x + B*y + C, D*x + E*y + F
+type Affine struct {
+	A, B, C float64
+	D, E, F float64
+}
+
+func (t Affine) Apply(p geo.Point) geo.Point {
+	return geo.Point{
+		X: t.A*p.X + t.B*p.Y + t.C,
+		Y: t.D*p.X + t.E*p.Y + t.F,
+	}
+}
+
+// FiducialCentres gives the centres of the fiducials in the corners of the
+// box (in points, with the origin at the top left, as in the creator)
+func FiducialCentres(box geo.Rect) []geo.Point {
+
+	c := FiducialSize / 2
+
+	left := box.Corner.X + c
+	right := box.Corner.X + box.Dim.Width - c
+	top := box.Corner.Y + c
+	bottom := box.Corner.Y + box.Dim.Height - c
+
+	return []geo.Point{
+		{X: left, Y: top},
+		{X: right, Y: top},
+		{X: left, Y: bottom},
+		{X: right, Y: bottom},
+	}
+}
+
+type fiducial struct {
+	centre geo.Point
+	side   float64
+}
+
+// FindFiducials looks for the fiducials of the box in the image, returning
+// their centres in pixels, and whether each one was found. At least one
+// column of the box must be found whole, so that the other can be looked
+// for where it should be.
+func FindFiducials(im image.Image, box geo.Rect) ([]geo.Point, []bool) {
+
+	centres := make([]geo.Point, 4)
+	found := make([]bool, 4)
+
+	across := box.Dim.Width - FiducialSize
+	down := box.Dim.Height - FiducialSize
+
+	if across <= 0 || down <= 0 {
+		return centres, found
+	}
+
+	candidates := findFiducialCandidates(im)
+
+	bestCount := 0
+	bestRight := math.Inf(-1)
+
+	for _, top := range candidates {
+		for _, bottom := range candidates {
+
+			v := geo.Point{X: bottom.centre.X - top.centre.X, Y: bottom.centre.Y - top.centre.Y}
+			length := math.Hypot(v.X, v.Y)
+
+			if length == 0 || math.Abs(math.Atan2(v.X, v.Y)) > fiducialMaxSkew {
+				continue
+			}
+
+			scale := length / down // pixels per point
+
+			if !fiducialSized(top, scale) || !fiducialSized(bottom, scale) {
+				continue
+			}
+
+			// across the page, square to the column
+			step := geo.Point{X: v.Y / length * across * scale, Y: -v.X / length * across * scale}
+
+			// try the column as the left one, then as the right one
+			for _, side := range []float64{1, -1} {
+
+				otherTop := geo.Point{X: top.centre.X + side*step.X, Y: top.centre.Y + side*step.Y}
+				otherBottom := geo.Point{X: bottom.centre.X + side*step.X, Y: bottom.centre.Y + side*step.Y}
+
+				gotTop, okTop := nearestFiducial(candidates, otherTop, scale)
+				gotBottom, okBottom := nearestFiducial(candidates, otherBottom, scale)
+
+				count := 2
+				if okTop {
+					count++
+				}
+				if okBottom {
+					count++
+				}
+
+				if count < 3 {
+					continue
+				}
+
+				// where the right column is, even if it is missing a mark
+				right := top.centre.X
+				if side > 0 {
+					right = otherTop.X
+					if okTop {
+						right = gotTop.X
+					}
+				}
+
+				// the newest is rightmost, but the same column found whole is better
+				tolerance := FiducialSize * scale
+				if right < bestRight-tolerance || (right <= bestRight+tolerance && count <= bestCount) {
+					continue
+				}
+
+				bestCount = count
+				bestRight = right
+
+				if side > 0 {
+					centres = []geo.Point{top.centre, gotTop, bottom.centre, gotBottom}
+					found = []bool{true, okTop, true, okBottom}
+				} else {
+					centres = []geo.Point{gotTop, top.centre, gotBottom, bottom.centre}
+					found = []bool{okTop, true, okBottom, true}
+				}
+			}
+		}
+	}
+
+	return centres, found
+}
+
+// fiducialSized checks the mark is the size of a fiducial at this scale
+func fiducialSized(f fiducial, scale float64) bool {
+	return math.Abs(f.side-FiducialSize*scale) <= fiducialSizeTolerance*FiducialSize*scale
+}
+
+// nearestFiducial finds the candidate of the right size nearest to where
+// a fiducial should be, if there is one within half a fiducial's width of it
+func nearestFiducial(candidates []fiducial, want geo.Point, scale float64) (geo.Point, bool) {
+
+	best := geo.Point{}
+	bestDistance := FiducialSize * scale / 2
+	found := false
+
+	for _, f := range candidates {
+
+		distance := math.Hypot(f.centre.X-want.X, f.centre.Y-want.Y)
+
+		if distance < bestDistance && fiducialSized(f, scale) {
+			best = f.centre
+			bestDistance = distance
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// findFiducialCandidates finds the solid square marks in the image
+func findFiducialCandidates(im image.Image) []fiducial {
+
+	bounds := im.Bounds()
+
+	w := bounds.Dx()
+	h := bounds.Dy()
+
+	ink := make([]bool, w*h)
+	threshold := uint32(math.Round(inkThreshold * 3 * 65535))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, _ := im.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			ink[y*w+x] = r+g+b < threshold
+		}
+	}
+
+	candidates := []fiducial{}
+
+	for _, m := range findMarks(ink, w, h) {
+
+		short := float64(minInt(m.width(), m.height()))
+		long := float64(maxInt(m.width(), m.height()))
+
+		if short < 3 || long > float64(minInt(w, h))/10 {
+			continue
+		}
+
+		if short/long < fiducialSquareness {
+			continue
+		}
+
+		if float64(len(m.pixels))/float64(m.width()*m.height()) < fiducialSolidity {
+			continue
+		}
+
+		centre := geo.Point{}
+
+		for _, p := range m.pixels {
+			centre.X += float64(p.X)
+			centre.Y += float64(p.Y)
+		}
+
+		// pixel centres are half a pixel in from their corner
+		centre.X = centre.X/float64(len(m.pixels)) + 0.5 + float64(bounds.Min.X)
+		centre.Y = centre.Y/float64(len(m.pixels)) + 0.5 + float64(bounds.Min.Y)
+
+		candidates = append(candidates, fiducial{centre: centre, side: math.Sqrt(float64(len(m.pixels)))})
+	}
+
+	return candidates
+}
+
+// FitAffine finds the least squares affine transform taking from to to,
+// which needs at least three points that are not in a line
+func FitAffine(from, to []geo.Point) (Affine, error) {
+
+	if len(from) != len(to) {
+		return Affine{}, errors.New("need the same number of points to fit from and to")
+	}
+
+	if len(from) < 3 {
+		return Affine{}, fmt.Errorf("need at least 3 points to fit an affine transform, but have %d", len(from))
+	}
+
+	// normal equations, for x' and y' separately
+	var m [3][3]float64
+	var vx, vy [3]float64
+
+	for i, p := range from {
+
+		row := [3]float64{p.X, p.Y, 1}
+
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				m[j][k] += row[j] * row[k]
+			}
+			vx[j] += row[j] * to[i].X
+			vy[j] += row[j] * to[i].Y
+		}
+	}
+
+	x, err := solve3(m, vx)
+	if err != nil {
+		return Affine{}, err
+	}
+
+	y, err := solve3(m, vy)
+	if err != nil {
+		return Affine{}, err
+	}
+
+	return Affine{A: x[0], B: x[1], C: x[2], D: y[0], E: y[1], F: y[2]}, nil
+}
+
+// solve3 solves m.x = v by Cramer's rule
+func solve3(m [3][3]float64, v [3]float64) ([3]float64, error) {
+
+	det := det3(m)
+
+	scale := 0.0
+	for _, row := range m {
+		for _, val := range row {
+			scale = math.Max(scale, math.Abs(val))
+		}
+	}
+
+	if scale == 0 || math.Abs(det) < 1e-12*scale*scale*scale {
+		return [3]float64{}, errors.New("points are in a line, so can't fit an affine transform")
+	}
+
+	var x [3]float64
+
+	for i := 0; i < 3; i++ {
+		mi := m
+		for j := 0; j < 3; j++ {
+			mi[j][i] = v[j]
+		}
+		x[i] = det3(mi) / det
+	}
+
+	return x, nil
+}
+
+func det3(m [3][3]float64) float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// Register finds the fiducials of the box on a scanned page, and returns
+// the page deskewed and aligned, as it was laid out. The box is in points on a
+// page of the given size, as in the layout. A page with a dynamic width is wider
+// by however much the previous image needed, so it is lined up by its right
+// edge, and is as wide as the scan reaches to the left of it. The registered
+// page has the same height in pixels as the scan.
+func Register(im image.Image, box geo.Rect, page geo.Dim) (*image.RGBA, Affine, error) {
+
+	found, ok := FindFiducials(im, box)
+
+	count := 0
+	for _, isFound := range ok {
+		if isFound {
+			count++
+		}
+	}
+
+	if count < 3 {
+		return nil, Affine{}, fmt.Errorf("found only %d of 4 fiducials", count)
+	}
+
+	if page.Height <= 0 {
+		return nil, Affine{}, fmt.Errorf("page height %g is too small to register", page.Height)
+	}
+
+	// positions from the right edge of the page
+	from := []geo.Point{}
+	to := []geo.Point{}
+
+	for i, centre := range FiducialCentres(box) {
+		if ok[i] {
+			from = append(from, geo.Point{X: centre.X - page.Width, Y: centre.Y})
+			to = append(to, found[i])
+		}
+	}
+
+	// maps the page, in points, to the scan, in pixels
+	a, err := FitAffine(from, to)
+	if err != nil {
+		return nil, a, err
+	}
+
+	down := (box.Dim.Height - FiducialSize) * math.Hypot(a.B, a.E)
+
+	for i := range from {
+		p := a.Apply(from[i])
+		if math.Hypot(p.X-to[i].X, p.Y-to[i].Y) > maxRegistrationError*down {
+			return nil, a, errors.New("fiducials are not where they should be relative to each other, so can't register the page")
+		}
+	}
+
+	scale := float64(im.Bounds().Dy()) / page.Height
+
+	pageWidth := page.Width
+
+	if page.DynamicWidth {
+		edge := a.Apply(geo.Point{X: 0, Y: page.Height / 2})
+		pageWidth = math.Max(pageWidth, (edge.X-float64(im.Bounds().Min.X))/math.Hypot(a.A, a.D))
+	}
+
+	width := int(math.Round(pageWidth * scale))
+	height := im.Bounds().Dy()
+
+	// maps the registered page to the scan, which is the way round we need to resample
+	t := Affine{
+		A: a.A / scale, B: a.B / scale, C: a.C - a.A*float64(width)/scale,
+		D: a.D / scale, E: a.E / scale, F: a.F - a.D*float64(width)/scale,
+	}
+
+	return resample(im, t, width, height), t, nil
+}
+
+// resample makes a new image, taking each pixel from where t maps it
+// to in the source, with bilinear interpolation, and white outside it
+func resample(im image.Image, t Affine, width, height int) *image.RGBA {
+
+	bounds := im.Bounds()
+
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), im, bounds.Min, draw.Src)
+
+	out := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	sw := bounds.Dx()
+	sh := bounds.Dy()
+
+	white := color.RGBA{255, 255, 255, 255}
+
+	at := func(x, y int) [4]float64 {
+		if x < 0 || y < 0 || x >= sw || y >= sh {
+			return [4]float64{255, 255, 255, 255}
+		}
+		i := src.PixOffset(x, y)
+		return [4]float64{float64(src.Pix[i]), float64(src.Pix[i+1]), float64(src.Pix[i+2]), float64(src.Pix[i+3])}
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+
+			p := t.Apply(geo.Point{X: float64(x) + 0.5, Y: float64(y) + 0.5})
+
+			// position relative to the centres of the source pixels
+			sx := p.X - float64(bounds.Min.X) - 0.5
+			sy := p.Y - float64(bounds.Min.Y) - 0.5
+
+			x0 := int(math.Floor(sx))
+			y0 := int(math.Floor(sy))
+
+			if x0 < -1 || y0 < -1 || x0 >= sw || y0 >= sh {
+				out.SetRGBA(x, y, white)
+				continue
+			}
+
+			fx := sx - float64(x0)
+			fy := sy - float64(y0)
+
+			p00 := at(x0, y0)
+			p10 := at(x0+1, y0)
+			p01 := at(x0, y0+1)
+			p11 := at(x0+1, y0+1)
+
+			var v [4]uint8
+
+			for k := 0; k < 4; k++ {
+				top := p00[k]*(1-fx) + p10[k]*fx
+				bottom := p01[k]*(1-fx) + p11[k]*fx
+				v[k] = uint8(math.Round(top*(1-fy) + bottom*fy))
+			}
+
+			out.SetRGBA(x, y, color.RGBA{v[0], v[1], v[2], v[3]})
+		}
+	}
+
+	return out
+}
+
+// RegisterFile registers the page image at inputPath, and saves it to
+// outputPath as a jpeg, returning the size of the registered image
+func RegisterFile(inputPath, outputPath string, box geo.Rect, page geo.Dim) (int, int, error) {
+
+	reader, err := os.Open(inputPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	im, _, err := image.Decode(reader)
+	reader.Close()
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	registered, _, err := Register(im, box, page)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	writer, err := os.Create(outputPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer writer.Close()
+
+	err = jpeg.Encode(writer, registered, &jpeg.Options{Quality: 90})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bounds := registered.Bounds()
+
+	return bounds.Dx(), bounds.Dy(), nil
+}
@@ -0,0 +1,164 @@
+package optical
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// the newest bar is the right 100pt of a 300pt page,
+// with its fiducial box 20pt in from the left of the bar
+var (
+	testBar = geo.Dim{Width: 100, Height: 400, DynamicWidth: true}
+	testBox = geo.Rect{Corner: geo.Point{X: 20, Y: 10}, Dim: geo.Dim{Width: 70, Height: 380}}
+)
+
+// makeFiducialPage draws a page with the fiducials of the newest bar, the
+// fiducials of an older bar to the left of it, a solid square like the centre
+// of a QR code finder, and a black box at (200,300)-(240,340) pt, all at
+// two pixels per point
+func makeFiducialPage(width, height float64) *image.RGBA {
+
+	scale := 2.0
+
+	im := image.NewRGBA(image.Rect(0, 0, int(width*scale), int(height*scale)))
+	draw.Draw(im, im.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	black := &image.Uniform{color.Black}
+
+	square := func(c geo.Point) {
+		r := image.Rect(
+			int(math.Round((c.X-FiducialSize/2)*scale)),
+			int(math.Round((c.Y-FiducialSize/2)*scale)),
+			int(math.Round((c.X+FiducialSize/2)*scale)),
+			int(math.Round((c.Y+FiducialSize/2)*scale)))
+		draw.Draw(im, r, black, image.Point{}, draw.Src)
+	}
+
+	for _, c := range FiducialCentres(pageBox(width)) {
+		square(c)
+	}
+
+	older := pageBox(width)
+	older.Corner.X = older.Corner.X - 120
+
+	for _, c := range FiducialCentres(older) {
+		square(c)
+	}
+
+	square(geo.Point{X: width - 40, Y: height - 40})
+
+	draw.Draw(im, image.Rect(400, 600, 480, 680), black, image.Point{}, draw.Src)
+
+	return im
+}
+
+// pageBox is where the test box is on a page that the bar has grown to this width
+func pageBox(width float64) geo.Rect {
+	box := testBox
+	box.Corner.X = box.Corner.X + width - testBar.Width
+	return box
+}
+
+func TestFitAffine(t *testing.T) {
+
+	want := Affine{A: 0.9, B: -0.1, C: 12, D: 0.1, E: 0.95, F: -7}
+
+	from := []geo.Point{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 0, Y: 200}, {X: 100, Y: 200}}
+	to := []geo.Point{}
+
+	for _, p := range from {
+		to = append(to, want.Apply(p))
+	}
+
+	got, err := FitAffine(from, to)
+	assert.NoError(t, err)
+
+	for _, v := range [][2]float64{{want.A, got.A}, {want.B, got.B}, {want.C, got.C}, {want.D, got.D}, {want.E, got.E}, {want.F, got.F}} {
+		assert.InDelta(t, v[0], v[1], 1e-9)
+	}
+
+	_, err = FitAffine(from[:2], to[:2])
+	assert.Error(t, err)
+
+	_, err = FitAffine([]geo.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}, to[:3])
+	assert.Error(t, err)
+}
+
+func TestFindFiducials(t *testing.T) {
+
+	page := makeFiducialPage(300, 400)
+
+	centres, found := FindFiducials(page, testBox)
+
+	// the newest bar's, not the older bar's, nor the QR code's
+	for i, want := range FiducialCentres(pageBox(300)) {
+		assert.True(t, found[i])
+		assert.InDelta(t, want.X*2, centres[i].X, 0.5)
+		assert.InDelta(t, want.Y*2, centres[i].Y, 0.5)
+	}
+
+	// one missing is ok
+	gap := image.Rect(int(centres[BottomRight].X)-15, int(centres[BottomRight].Y)-15, int(centres[BottomRight].X)+15, int(centres[BottomRight].Y)+15)
+	draw.Draw(page, gap, &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	_, found = FindFiducials(page, testBox)
+	assert.Equal(t, []bool{true, true, true, false}, found)
+
+	blank := image.NewRGBA(image.Rect(0, 0, 600, 800))
+	draw.Draw(blank, blank.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	_, _, err := Register(blank, testBox, testBar)
+	assert.Error(t, err)
+}
+
+func TestRegister(t *testing.T) {
+
+	page := makeFiducialPage(300, 400)
+
+	// scan it rotated by 2 degrees, shrunk a little, and shifted
+	a := 2 * math.Pi / 180
+	s := 1 / 0.95
+	scan := resample(page, Affine{
+		A: s * math.Cos(a), B: -s * math.Sin(a), C: -20,
+		D: s * math.Sin(a), E: s * math.Cos(a), F: -15,
+	}, 620, 800)
+
+	box := Box{Vanilla: true, Bounds: image.Rect(400, 600, 480, 680)}
+
+	ideal := ScoreBox(page, box, DefaultThresholds).Fill
+
+	assert.True(t, ScoreBox(scan, box, DefaultThresholds).Fill < 0.8*ideal)
+
+	registered, _, err := Register(scan, testBox, testBar)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 800, registered.Bounds().Dy())
+
+	// as wide as the scan reaches to the left of the bar
+	width := registered.Bounds().Dx()
+	assert.InDelta(t, 600, width, 40)
+
+	// the box is back where the layout says it is, from the right edge
+	box.Bounds = box.Bounds.Add(image.Point{width - 600, 0})
+	assert.True(t, ScoreBox(registered, box, DefaultThresholds).Fill > 0.95*ideal)
+
+	centres, found := FindFiducials(registered, testBox)
+
+	for i, want := range FiducialCentres(pageBox(300)) {
+		assert.True(t, found[i])
+		assert.InDelta(t, want.X*2+float64(width-600), centres[i].X, 1.5)
+		assert.InDelta(t, want.Y*2, centres[i].Y, 1.5)
+	}
+
+	// a page that is always the same width comes back that wide
+	registered, _, err = Register(scan, pageBox(300), geo.Dim{Width: 300, Height: 400})
+	assert.NoError(t, err)
+	assert.Equal(t, 600, registered.Bounds().Dx())
+	assert.True(t, ScoreBox(registered, Box{Vanilla: true, Bounds: image.Rect(400, 600, 480, 680)}, DefaultThresholds).Fill > 0.95*ideal)
+}
@@ -0,0 +1,109 @@
+package optical
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"os"
+)
+
+// Scoring boxes: instead of only saying whether a box is marked, ScoreBox
+// gives the fill ratio, and how confident we are in calling it marked or not.
+// The fill ratio is the fraction of pixels that are not the background
+// colour, scaled up for long thin boxes so that the same thresholds suit any
+// shape of box. Fills between Low and High are uncertain, e.g. a faint
+// pencil tick, or scan noise at the edge of the box, and are called marked if
+// they are above Mark. Confidence is 1 outside the uncertain band, and falls
+// to 0.5 at Mark.
+
+type Thresholds struct {
+	Low  float64 `json:"low"`
+	Mark float64 `json:"mark"`
+	High float64 `json:"high"`
+}
+
+type BoxScore struct {
+	Marked     bool
+	Uncertain  bool
+	Fill       float64
+	Confidence float64
+}
+
+var DefaultThresholds = Thresholds{
+	Low:  0.01,
+	Mark: 0.02, // this is a "good" thresh for a square, in practice
+	High: 0.04,
+}
+
+func (t Thresholds) Validate() error {
+
+	if t.Low < 0 || t.Low > t.Mark || t.Mark > t.High {
+		return fmt.Errorf("thresholds must be 0 <= low <= mark <= high, but have low=%g mark=%g high=%g", t.Low, t.Mark, t.High)
+	}
+
+	return nil
+}
+
+func ScoreBoxFile(inputPath string, boxes []Box, t Thresholds) ([]BoxScore, error) {
+
+	var results []BoxScore
+
+	reader, err := os.Open(inputPath)
+
+	if err != nil {
+		return []BoxScore{}, err
+	}
+
+	defer reader.Close()
+
+	wholeImage, _, err := image.Decode(reader)
+
+	if err != nil {
+		return []BoxScore{}, err
+	}
+
+	for idx := 0; idx < len(boxes); idx = idx + 1 {
+		results = append(results, ScoreBox(wholeImage, boxes[idx], t))
+	}
+
+	return results, nil
+}
+
+func ScoreBox(im image.Image, box Box, t Thresholds) BoxScore {
+
+	_, markedPixelFraction, aspectRatio := markedFraction(im, box)
+
+	fill := 0.0
+	if aspectRatio > 0 {
+		fill = markedPixelFraction / aspectRatio
+	}
+
+	return scoreFill(fill, t)
+}
+
+func scoreFill(fill float64, t Thresholds) BoxScore {
+
+	score := BoxScore{
+		Fill:       fill,
+		Marked:     fill > t.Mark,
+		Uncertain:  fill > t.Low && fill < t.High,
+		Confidence: 1,
+	}
+
+	if !score.Uncertain {
+		return score
+	}
+
+	// how far through the band from Mark to its edge
+	var distance float64
+
+	if score.Marked {
+		distance = (fill - t.Mark) / (t.High - t.Mark)
+	} else {
+		distance = (t.Mark - fill) / (t.Mark - t.Low)
+	}
+
+	score.Confidence = 0.5 + 0.5*math.Min(1, math.Max(0, distance))
+
+	return score
+}
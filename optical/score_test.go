@@ -0,0 +1,80 @@
+package optical
+
+import (
+	"image"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreFill(t *testing.T) {
+
+	th := Thresholds{Low: 0.01, Mark: 0.02, High: 0.04}
+
+	tests := []struct {
+		fill       float64
+		marked     bool
+		uncertain  bool
+		confidence float64
+	}{
+		{0, false, false, 1},
+		{0.01, false, false, 1},
+		{0.015, false, true, 0.75},
+		{0.02, false, true, 0.5},
+		{0.03, true, true, 0.75},
+		{0.04, true, false, 1},
+		{0.5, true, false, 1},
+	}
+
+	for _, test := range tests {
+		score := scoreFill(test.fill, th)
+		assert.Equal(t, test.marked, score.Marked, "fill %f", test.fill)
+		assert.Equal(t, test.uncertain, score.Uncertain, "fill %f", test.fill)
+		assert.InDelta(t, test.confidence, score.Confidence, 1e-9, "fill %f", test.fill)
+		assert.Equal(t, test.fill, score.Fill)
+	}
+}
+
+func TestThresholdsValidate(t *testing.T) {
+
+	assert.NoError(t, DefaultThresholds.Validate())
+	assert.NoError(t, Thresholds{Low: 0.02, Mark: 0.02, High: 0.02}.Validate())
+	assert.Error(t, Thresholds{Low: 0.03, Mark: 0.02, High: 0.04}.Validate())
+	assert.Error(t, Thresholds{Low: 0.01, Mark: 0.05, High: 0.04}.Validate())
+	assert.Error(t, Thresholds{Low: -0.01, Mark: 0.02, High: 0.04}.Validate())
+}
+
+func TestScoreBox(t *testing.T) {
+
+	reader, err := os.Open("./img/test.png")
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	testImage, _, err := image.Decode(reader)
+	assert.NoError(t, err)
+
+	for idx, box := range testCheckBoxes {
+
+		score := ScoreBox(testImage, box, DefaultThresholds)
+
+		// same answer as CheckBox with the default thresholds
+		assert.Equal(t, expectedBox[idx], score.Marked, "box %d", idx)
+		assert.True(t, score.Confidence >= 0.5 && score.Confidence <= 1)
+	}
+
+	scores, err := ScoreBoxFile("./img/test2.jpg", testCheckBoxesStylus, DefaultThresholds)
+	assert.NoError(t, err)
+
+	for idx, score := range scores {
+		assert.Equal(t, expectedBoxStylus[idx], score.Marked, "box %d", idx)
+	}
+
+	// a stricter template calls nothing marked
+	scores, err = ScoreBoxFile("./img/test2.jpg", testCheckBoxesStylus, Thresholds{Low: 1, Mark: 1, High: 1})
+	assert.NoError(t, err)
+
+	for _, score := range scores {
+		assert.False(t, score.Marked)
+	}
+}
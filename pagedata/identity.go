@@ -0,0 +1,77 @@
+package pagedata
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A page identity is a short form of the pagedata that can be printed on the
+// page as a QR code, so that the page can still be found in its chain if the
+// hidden text is lost, e.g. when it is printed and scanned, or optimised. The
+// UUID is what matters for relinking; the rest is there to narrow the search,
+// and so a person can read it. The exam goes last, so it can contain anything.
+
+const (
+	identityPrefix  = "gradex:1"
+	identitySep     = "|"
+	maxIdentityExam = 64
+)
+
+type PageIdentity struct {
+	UUID string
+	Page int
+	Who  string
+	Exam string
+}
+
+func GetPageIdentity(pd PageData) PageIdentity {
+	return PageIdentity{
+		UUID: pd.Current.UUID,
+		Page: pd.Current.Own.Number,
+		Who:  pd.Current.Item.Who,
+		Exam: pd.Current.Item.What,
+	}
+}
+
+// String gives the identity for encoding, with the exam shortened
+// if needed to keep the code small enough to print in the header
+func (id PageIdentity) String() string {
+
+	exam := id.Exam
+	if r := []rune(exam); len(r) > maxIdentityExam {
+		exam = string(r[:maxIdentityExam]) //by character, so none are cut in half
+	}
+
+	return strings.Join([]string{identityPrefix, id.UUID, strconv.Itoa(id.Page), id.Who, exam}, identitySep)
+}
+
+func ParsePageIdentity(text string) (PageIdentity, error) {
+
+	if !strings.HasPrefix(text, identityPrefix+identitySep) {
+		return PageIdentity{}, errors.New("not a page identity")
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(text, identityPrefix+identitySep), identitySep, 4)
+
+	if len(parts) != 4 {
+		return PageIdentity{}, fmt.Errorf("page identity has %d parts, expected 4", len(parts))
+	}
+
+	page, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return PageIdentity{}, fmt.Errorf("page identity has bad page number %s", parts[1])
+	}
+
+	if parts[0] == "" {
+		return PageIdentity{}, errors.New("page identity has no UUID")
+	}
+
+	return PageIdentity{
+		UUID: parts[0],
+		Page: page,
+		Who:  parts[2],
+		Exam: parts[3],
+	}, nil
+}
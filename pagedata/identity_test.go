@@ -0,0 +1,44 @@
+package pagedata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageIdentity(t *testing.T) {
+
+	pd := PageData{
+		Current: PageDetail{
+			UUID: "8f2e1c9a-2a4b-4d7e-9f00-5d3a1b2c4e6f",
+			Own:  FileDetail{Number: 3},
+			Item: ItemDetail{Who: "B999999", What: "PGEE00000 Exam | Resit"},
+		},
+	}
+
+	id := GetPageIdentity(pd)
+
+	text := id.String()
+
+	assert.Equal(t, "gradex:1|8f2e1c9a-2a4b-4d7e-9f00-5d3a1b2c4e6f|3|B999999|PGEE00000 Exam | Resit", text)
+
+	got, err := ParsePageIdentity(text)
+	assert.NoError(t, err)
+	assert.Equal(t, id, got)
+
+	id.Exam = strings.Repeat("x", 100)
+	got, err = ParsePageIdentity(id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, maxIdentityExam, len(got.Exam))
+
+	id.Exam = strings.Repeat("试", 100)
+	got, err = ParsePageIdentity(id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("试", maxIdentityExam), got.Exam)
+
+	for _, bad := range []string{"", "hello", "gradex:1|uuid|three|B999999|exam", "gradex:1|uuid|3", "gradex:1||3|B999999|exam"} {
+		_, err = ParsePageIdentity(bad)
+		assert.Error(t, err, bad)
+	}
+}
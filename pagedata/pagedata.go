@@ -6,9 +6,10 @@ import (
 	"hash/crc32"
 	"math/rand"
 	"os"
-	"regexp"
 	"strings"
 	"time"
+	"unicode/utf16"
+	"unicode/utf8"
 
 	"github.com/timdrysdale/unipdf/v3/creator"
 	"github.com/timdrysdale/unipdf/v3/extractor"
@@ -202,9 +203,10 @@ func unmarshalPageDatasFromPage(page *pdf.PdfPage) ([]PageData, error) {
 // >>>>>>>>>>>>>>>>> WRITE TO CREATOR >>>>>>>>>>>>>>>>>>>>>>>>>>>>>
 func writeMarshalledPageDataToCreator(c *creator.Creator, text string) {
 
-	//drop non-ascii characters to avoid hash issues?
-	re := regexp.MustCompile("[[:^ascii:]]")
-	text = re.ReplaceAllLiteralString(text, "")
+	// the text is drawn in a standard font, which can't be relied on for
+	// anything outside ASCII, so the rest is escaped, as JSON allows,
+	// rather than dropped, e.g. so a student called Zoë stays Zoë
+	text = escapeNonASCII(text)
 
 	crc32c := crc32.MakeTable(crc32.Castagnoli)
 
@@ -221,6 +223,30 @@ func writeMarshalledPageDataToCreator(c *creator.Creator, text string) {
 	writeTextToCreator(c, fulltag)
 }
 
+// escapeNonASCII escapes the non-ASCII characters in marshalled JSON, which
+// can only be in strings, so it unmarshals to the same thing
+func escapeNonASCII(text string) string {
+
+	var b strings.Builder
+
+	for _, r := range text {
+
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+
+		if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+			fmt.Fprintf(&b, "\\u%04x\\u%04x", r1, r2)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\\u%04x", r)
+	}
+
+	return b.String()
+}
+
 // We put the text off the page so we are not merged with visible text on the page
 // There is a historical challenge in cropping PDF to the visible page
 // because, well, it's not that simple to be sure you cropped everything.
@@ -2,6 +2,7 @@ package pagedata
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -550,6 +551,56 @@ func TestMarshalling(t *testing.T) {
 
 }
 
+func TestMarshallingNonASCII(t *testing.T) {
+
+	text := `{"who":"Zoë","v":"Łódź 😀"}`
+
+	escaped := escapeNonASCII(text)
+	assert.Equal(t, `{"who":"Zo\u00eb","v":"\u0141\u00f3d\u017a \ud83d\ude00"}`, escaped)
+
+	var want, got map[string]string
+	assert.NoError(t, json.Unmarshal([]byte(text), &want))
+	assert.NoError(t, json.Unmarshal([]byte(escaped), &got))
+	assert.Equal(t, want, got)
+
+	pd := PageData{
+		Current: PageDetail{
+			Is:   IsPage,
+			UUID: "69197384-fd15-42ac-ac16-82dbe4d52dd0",
+			Item: ItemDetail{
+				What: "Mécanique",
+				Who:  "Zoë",
+			},
+			Data: []Field{
+				Field{Key: "tf-comment", Value: "Łódź 😀"},
+			},
+		},
+	}
+
+	c := creator.New()
+	c.SetPageMargins(0, 0, 0, 0)
+	c.SetPageSize(creator.PageSizeA4)
+	c.NewPage()
+
+	assert.NoError(t, MarshalOneToCreator(c, &pd))
+
+	var buf bytes.Buffer
+	assert.NoError(t, c.Write(&buf))
+
+	pdfReader, err := model.NewPdfReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+
+	page, err := pdfReader.GetPage(1)
+	assert.NoError(t, err)
+
+	pdout, err := unmarshalPageDatasFromPage(page)
+	assert.NoError(t, err)
+
+	if assert.True(t, len(pdout) > 0) {
+		assert.Equal(t, pd, pdout[0])
+	}
+}
+
 func TestWriteOutputForAdobe(t *testing.T) {
 
 	outputPath := "./test/adobe-page-data.pdf"
@@ -0,0 +1,131 @@
+package parsesvg
+
+import (
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+
+	"github.com/timdrysdale/unipdf/v3/core"
+	"github.com/timdrysdale/unipdf/v3/creator"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// Black and white scans take far less space at one bit per pixel than as a
+// JPEG. We threshold the page image, pack it eight pixels to the byte, and
+// store it with CCITT Group 4 fax compression, which codes each row by how it
+// differs from the one above, so long runs of white paper cost next to nothing.
+
+func newBilevelImageFromFile(c *creator.Creator, path string) (*creator.Image, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	im, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := c.NewImage(bilevelImage(im))
+	if err != nil {
+		return nil, err
+	}
+
+	img.SetEncoder(bilevelEncoder(im.Bounds()))
+
+	return img, nil
+}
+
+// bilevelEncoder is Group 4 (K < 0) for the whole page in one strip
+func bilevelEncoder(b image.Rectangle) *core.CCITTFaxEncoder {
+
+	encoder := core.NewCCITTFaxEncoder()
+	encoder.K = -1
+	encoder.Columns = b.Dx()
+	encoder.Rows = b.Dy()
+	encoder.BlackIs1 = false
+
+	return encoder
+}
+
+// bilevelImage packs each row into whole bytes, with 1 for white,
+// as DeviceGray expects at one bit per component
+func bilevelImage(im image.Image) *model.Image {
+
+	b := im.Bounds()
+	threshold := otsuThreshold(im)
+	stride := (b.Dx() + 7) / 8
+	data := make([]byte, stride*b.Dy())
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if color.GrayModel.Convert(im.At(x, y)).(color.Gray).Y > threshold {
+				i := x - b.Min.X
+				data[(y-b.Min.Y)*stride+i/8] |= 0x80 >> uint(i%8)
+			}
+		}
+	}
+
+	return &model.Image{
+		Width:            int64(b.Dx()),
+		Height:           int64(b.Dy()),
+		BitsPerComponent: 1,
+		ColorComponents:  1,
+		Data:             data,
+	}
+}
+
+// otsuThreshold picks the grey level that best separates ink from paper
+func otsuThreshold(im image.Image) uint8 {
+
+	var histogram [256]int
+
+	b := im.Bounds()
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			histogram[color.GrayModel.Convert(im.At(x, y)).(color.Gray).Y]++
+		}
+	}
+
+	total := b.Dx() * b.Dy()
+
+	sum := 0.0
+	for i, n := range histogram {
+		sum += float64(i * n)
+	}
+
+	sumBelow, countBelow := 0.0, 0
+	best, threshold := 0.0, uint8(127)
+
+	for i, n := range histogram {
+
+		countBelow += n
+		if countBelow == 0 {
+			continue
+		}
+
+		countAbove := total - countBelow
+		if countAbove == 0 {
+			break
+		}
+
+		sumBelow += float64(i * n)
+
+		meanBelow := sumBelow / float64(countBelow)
+		meanAbove := (sum - sumBelow) / float64(countAbove)
+
+		between := float64(countBelow) * float64(countAbove) * (meanBelow - meanAbove) * (meanBelow - meanAbove)
+
+		if between > best {
+			best = between
+			threshold = uint8(i)
+		}
+	}
+
+	return threshold
+}
@@ -0,0 +1,44 @@
+package parsesvg
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBilevelImage(t *testing.T) {
+
+	// grey paper with a dark stroke, and a width that is not a whole number of bytes
+	im := image.NewGray(image.Rect(0, 0, 10, 2))
+	for x := 0; x < 10; x++ {
+		im.SetGray(x, 0, color.Gray{200})
+		im.SetGray(x, 1, color.Gray{200})
+	}
+	im.SetGray(0, 0, color.Gray{30})
+	im.SetGray(9, 1, color.Gray{30})
+
+	assert.True(t, otsuThreshold(im) >= 30)
+	assert.True(t, otsuThreshold(im) < 200)
+
+	bi := bilevelImage(im)
+
+	assert.Equal(t, int64(10), bi.Width)
+	assert.Equal(t, int64(2), bi.Height)
+	assert.Equal(t, int64(1), bi.BitsPerComponent)
+	assert.Equal(t, 1, bi.ColorComponents)
+
+	// white is 1, rows start on a new byte
+	assert.Equal(t, []byte{0x7f, 0xc0, 0xff, 0x80}, bi.Data)
+}
+
+func TestBilevelEncoder(t *testing.T) {
+
+	encoder := bilevelEncoder(image.Rect(0, 0, 1240, 1754))
+
+	assert.Equal(t, -1, encoder.K)
+	assert.Equal(t, 1240, encoder.Columns)
+	assert.Equal(t, 1754, encoder.Rows)
+	assert.False(t, encoder.BlackIs1)
+}
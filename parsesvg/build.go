@@ -0,0 +1,546 @@
+package parsesvg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// BuildLayout makes a layout, and a ladder for each spread, from a short
+// description, rather than by drawing them in Inkscape. Each ladder has a
+// row of boxes for each question, e.g. q1-number and q1-mark, under the
+// actor's label, and can have page-ok and page-bad boxes at the bottom.
+// Pages are dynamic, with the ladder to the right of the previous image.
+// The chrome is drawn from the ladder's svg, so there are no images to
+// export. All sizes are in mm, as in the layouts drawn by hand.
+
+// BuildSpec describes a layout for BuildLayout
+type BuildSpec struct {
+	ID      string       `json:"id"`      // name of the layout, and the start of its filenames
+	Height  float64      `json:"height"`  // of the pages in mm, 312 if not set
+	Spreads []SpreadSpec `json:"spreads"` // one ladder for each
+}
+
+// SpreadSpec describes a spread and its ladder, e.g. {"name":"mark", "actor":"Marker",
+// "questions":["1","2","3"], "boxes":["number","mark"], "colour":"#ccddff"}
+type SpreadSpec struct {
+	Name       string   `json:"name"`       // of the spread, e.g. mark
+	Actor      string   `json:"actor"`      // printed at the top of the ladder, e.g. Marker
+	Questions  []string `json:"questions"`  // one row of boxes for each, as in questions.csv
+	Boxes      []string `json:"boxes"`      // the boxes in each row, just mark if not set
+	PageChecks bool     `json:"pageChecks"` // add page-ok and page-bad boxes
+	Colour     string   `json:"colour"`     // of the ladder, light grey if not set
+	BoxColour  string   `json:"boxColour"`  // inside the boxes, white if not set
+	TextColour string   `json:"textColour"` // of the text and box outlines, black if not set
+	Width      float64  `json:"width"`      // of the ladder in mm, if wider than it needs to be
+}
+
+// BuiltLayout is what BuildLayout makes, read back as it would be when rendering
+type BuiltLayout struct {
+	Layout  *Layout
+	Ladders map[string]*Ladder // by spread name
+	Spreads map[string]Spread  // with the fields in place on the page
+	Files   map[string][]byte  // svg to write, by filename
+}
+
+const (
+	buildHeight     = 312.0 // mm, as for the layouts drawn by hand
+	buildMargin     = 3.0   // mm, around the edge of the ladder
+	buildHeader     = 16.0  // mm, for the actor's label
+	buildLabelWidth = 12.0  // mm, for the question at the start of each row
+	buildBox        = 12.0  // mm, square
+	buildCheck      = 8.0   // mm, square, for page-ok and page-bad
+	buildGap        = 2.0   // mm, between boxes, and between rows
+	buildFontSize   = 5.0   // mm
+	buildSmallFont  = 3.0   // mm, for the names of the boxes
+	buildLine       = 0.3   // mm, around the boxes
+
+	buildColour     = "#e6e6e6"
+	buildBoxColour  = "#ffffff"
+	buildTextColour = "#000000"
+
+	buildPageOK  = "page-ok"
+	buildPageBad = "page-bad"
+)
+
+// the ladder, before it is written out as svg
+type buildLadder struct {
+	dim    geo.Dim // in mm
+	rects  []buildRect
+	texts  []buildText
+	fields []buildRect
+}
+
+type buildRect struct {
+	id    string
+	title string
+	rect  geo.Rect
+	fill  string
+}
+
+type buildText struct {
+	x, y float64
+	size float64
+	text string
+	bold bool
+}
+
+// BuildLayout makes the svg for a layout and its ladders, and reads them back to check them
+func BuildLayout(spec BuildSpec) (*BuiltLayout, error) {
+
+	if spec.ID == "" {
+		return nil, errors.New("layout has no id")
+	}
+
+	if strings.ContainsAny(spec.ID, " /\\") {
+		return nil, fmt.Errorf("layout id %s can't have spaces or slashes in it, because it is used in filenames", spec.ID)
+	}
+
+	if len(spec.Spreads) == 0 {
+		return nil, errors.New("layout has no spreads")
+	}
+
+	height := spec.Height
+	if height == 0 {
+		height = buildHeight
+	}
+
+	if height < 0 {
+		return nil, fmt.Errorf("layout height %f is negative", height)
+	}
+
+	built := &BuiltLayout{
+		Ladders: make(map[string]*Ladder),
+		Spreads: make(map[string]Spread),
+		Files:   make(map[string][]byte),
+	}
+
+	ladderFiles := make(map[string]string)
+	ladderDims := make(map[string]geo.Dim)
+
+	for _, ss := range spec.Spreads {
+
+		if ss.Name == "" {
+			return nil, errors.New("spread has no name")
+		}
+
+		if strings.ContainsAny(ss.Name, " /\\") {
+			return nil, fmt.Errorf("spread name %s can't have spaces or slashes in it", ss.Name)
+		}
+
+		if _, ok := ladderFiles[ss.Name]; ok {
+			return nil, fmt.Errorf("spread %s is given more than once", ss.Name)
+		}
+
+		bl, err := buildLadderFor(ss, height)
+		if err != nil {
+			return nil, fmt.Errorf("spread %s: %v", ss.Name, err)
+		}
+
+		filename := spec.ID + "-" + ss.Name + chromeSVG
+
+		built.Files[filename] = bl.svg(spec.ID+"-"+ss.Name, ss)
+
+		ladderFiles[ss.Name] = filename
+		ladderDims[ss.Name] = bl.dim
+	}
+
+	layoutFile := spec.ID + chromeSVG
+
+	built.Files[layoutFile] = buildLayoutSVG(spec, height, ladderFiles, ladderDims)
+
+	// read it all back, so we know it is what will be rendered
+
+	layout, err := DefineLayoutFromSVG(built.Files[layoutFile])
+	if err != nil {
+		return nil, fmt.Errorf("can't read back layout: %v", err)
+	}
+
+	built.Layout = layout
+
+	for _, ss := range spec.Spreads {
+
+		ladder, err := DefineLadderFromSVG(built.Files[ladderFiles[ss.Name]])
+		if err != nil {
+			return nil, fmt.Errorf("can't read back ladder for spread %s: %v", ss.Name, err)
+		}
+
+		built.Ladders[ss.Name] = ladder
+
+		corner := layout.Anchors[buildLadderAnchor(ss.Name)]
+
+		spread := Spread{
+			Name:    ss.Name,
+			Dim:     layout.PageDims[ss.Name],
+			Ladders: []Ladder{*ladder},
+		}
+
+		for _, tf := range ladder.TextFields {
+			tf.Rect.Corner = TranslatePosition(corner, tf.Rect.Corner)
+			spread.TextFields = append(spread.TextFields, tf)
+		}
+
+		built.Spreads[ss.Name] = spread
+	}
+
+	return built, nil
+}
+
+// Write saves the svg files in dir, and returns their paths. Files that
+// are already there are only replaced if overwrite is true.
+func (b *BuiltLayout) Write(dir string, overwrite bool) ([]string, error) {
+
+	filenames := []string{}
+	for filename := range b.Files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	paths := []string{}
+
+	if !overwrite {
+		for _, filename := range filenames {
+			path := filepath.Join(dir, filename)
+			if _, err := os.Stat(path); err == nil {
+				return paths, fmt.Errorf("%s already exists", path)
+			}
+		}
+	}
+
+	for _, filename := range filenames {
+
+		path := filepath.Join(dir, filename)
+
+		err := ioutil.WriteFile(path, b.Files[filename], 0644)
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// QuestionField is the name of the box for a question, e.g. q1-mark for 1 (or Q1) and mark
+func QuestionField(question, box string) string {
+	return "q" + questionID(question) + "-" + box
+}
+
+func questionID(question string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(question)), "q")
+}
+
+func buildLadderFor(ss SpreadSpec, height float64) (*buildLadder, error) {
+
+	boxes := ss.Boxes
+	if len(boxes) == 0 {
+		boxes = []string{"mark"}
+	}
+
+	if len(ss.Questions) == 0 && !ss.PageChecks {
+		return nil, errors.New("no questions, and no page checks, so nothing to put in the ladder")
+	}
+
+	for _, colour := range []string{ss.Colour, ss.BoxColour, ss.TextColour} {
+		if colour == "" {
+			continue
+		}
+		if _, ok := parseColour(colour); !ok {
+			return nil, fmt.Errorf("can't understand colour %s (use #rrggbb)", colour)
+		}
+	}
+
+	for _, box := range boxes {
+		if box == "" || strings.ContainsAny(box, " \t=,") {
+			return nil, fmt.Errorf("box name %q can't be empty, or have spaces, commas or = in it", box)
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, q := range ss.Questions {
+		id := questionID(q)
+		if id == "" || strings.ContainsAny(id, " \t=,-") {
+			return nil, fmt.Errorf("question %q can't be empty, or have spaces, commas, dashes or = in it", q)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("question %s is given more than once", q)
+		}
+		seen[id] = true
+	}
+
+	bl := &buildLadder{}
+
+	rowWidth := buildLabelWidth + float64(len(boxes))*(buildBox+buildGap) - buildGap
+
+	// a rough guess at the width of the label, so it isn't cut off
+	labelWidth := 0.6 * buildFontSize * float64(len(ss.Actor))
+
+	width := 2*buildMargin + math.Max(rowWidth, labelWidth)
+	width = math.Max(width, ss.Width)
+
+	rows := float64(len(ss.Questions))
+	footer := 0.0
+	if ss.PageChecks {
+		footer = 2 * (buildCheck + buildGap)
+	}
+
+	if need := buildHeader + rows*(buildBox+buildGap) + footer + buildMargin; need > height {
+		return nil, fmt.Errorf("%d questions need %.0fmm, but the page is only %.0fmm high", len(ss.Questions), need, height)
+	}
+
+	bl.dim = geo.Dim{Width: width, Height: height}
+
+	bl.rects = append(bl.rects, buildRect{
+		id:   "background",
+		rect: geo.Rect{Dim: bl.dim},
+		fill: orDefault(ss.Colour, buildColour),
+	})
+
+	bl.texts = append(bl.texts, buildText{
+		x:    buildMargin,
+		y:    buildMargin + buildFontSize,
+		size: buildFontSize,
+		text: ss.Actor,
+		bold: true,
+	})
+
+	if len(ss.Questions) > 0 {
+		for col, box := range boxes {
+			bl.texts = append(bl.texts, buildText{
+				x:    buildMargin + buildLabelWidth + float64(col)*(buildBox+buildGap),
+				y:    buildHeader - buildGap,
+				size: buildSmallFont,
+				text: box,
+			})
+		}
+	}
+
+	tab := 0
+
+	addBox := func(field string, rect geo.Rect) {
+		bl.rects = append(bl.rects, buildRect{
+			id:   "box-" + field,
+			rect: rect,
+			fill: orDefault(ss.BoxColour, buildBoxColour),
+		})
+		bl.fields = append(bl.fields, buildRect{
+			id:    fmt.Sprintf("%s-tab%02d", field, tab),
+			title: field,
+			rect:  rect,
+		})
+		tab++
+	}
+
+	for row, q := range ss.Questions {
+
+		y := buildHeader + float64(row)*(buildBox+buildGap)
+
+		bl.texts = append(bl.texts, buildText{
+			x:    buildMargin,
+			y:    y + (buildBox+buildFontSize)/2,
+			size: buildFontSize,
+			text: "Q" + questionID(q),
+		})
+
+		for col, box := range boxes {
+			x := buildMargin + buildLabelWidth + float64(col)*(buildBox+buildGap)
+			addBox(QuestionField(q, box), geo.Rect{
+				Corner: geo.Point{X: x, Y: y},
+				Dim:    geo.Dim{Width: buildBox, Height: buildBox},
+			})
+		}
+	}
+
+	if ss.PageChecks {
+
+		for idx, check := range []struct{ field, label string }{
+			{buildPageOK, "ok"},
+			{buildPageBad, "bad"},
+		} {
+			y := height - buildMargin - float64(2-idx)*(buildCheck+buildGap) + buildGap
+
+			bl.texts = append(bl.texts, buildText{
+				x:    buildMargin,
+				y:    y + (buildCheck+buildFontSize)/2,
+				size: buildFontSize,
+				text: check.label,
+			})
+
+			addBox(check.field, geo.Rect{
+				Corner: geo.Point{X: buildMargin + buildLabelWidth, Y: y},
+				Dim:    geo.Dim{Width: buildCheck, Height: buildCheck},
+			})
+		}
+	}
+
+	return bl, nil
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// svg writes out the ladder, with its chrome, anchor and textfields layers
+func (bl *buildLadder) svg(id string, ss SpreadSpec) []byte {
+
+	b := &bytes.Buffer{}
+
+	writeBuildHeader(b, id, bl.dim)
+
+	stroke := orDefault(ss.TextColour, buildTextColour)
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-chrome\" inkscape:label=\"%s\">\n", geo.ChromeLayer)
+
+	for idx, r := range bl.rects {
+		line := ""
+		if idx > 0 { //not the background
+			line = fmt.Sprintf(" stroke=\"%s\" stroke-width=\"%s\"", stroke, num(buildLine))
+		}
+		fmt.Fprintf(b, "    <rect id=\"%s\" x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" fill=\"%s\"%s />\n",
+			escape(r.id), num(r.rect.Corner.X), num(r.rect.Corner.Y), num(r.rect.Dim.Width), num(r.rect.Dim.Height), r.fill, line)
+	}
+
+	for idx, t := range bl.texts {
+		if t.text == "" {
+			continue
+		}
+		weight := ""
+		if t.bold {
+			weight = " font-weight=\"bold\""
+		}
+		fmt.Fprintf(b, "    <text id=\"text%d\" x=\"%s\" y=\"%s\" font-size=\"%s\" fill=\"%s\"%s>%s</text>\n",
+			idx, num(t.x), num(t.y), num(t.size), stroke, weight, escape(t.text))
+	}
+
+	fmt.Fprintf(b, "  </g>\n")
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-anchors\" inkscape:label=\"%s\">\n", geo.AnchorsLayer)
+	writeBuildAnchor(b, "anchor-ref", geo.AnchorReference, "", geo.Point{})
+	fmt.Fprintf(b, "  </g>\n")
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-textfields\" inkscape:label=\"%s\">\n", geo.TextFieldsLayer)
+
+	for _, f := range bl.fields {
+		fmt.Fprintf(b, "    <rect id=\"%s\" x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" fill=\"none\"><title>%s</title></rect>\n",
+			escape(f.id), num(f.rect.Corner.X), num(f.rect.Corner.Y), num(f.rect.Dim.Width), num(f.rect.Dim.Height), escape(f.title))
+	}
+
+	fmt.Fprintf(b, "  </g>\n")
+	fmt.Fprintf(b, "</svg>\n")
+
+	return b.Bytes()
+}
+
+func buildLadderAnchor(spread string) string {
+	return geo.SVGElement + spread + "-ladder"
+}
+
+// buildLayoutSVG puts each ladder at the top left of a dynamic page, so that it
+// ends up to the right of the previous image, which is scaled to the page height
+func buildLayoutSVG(spec BuildSpec, height float64, ladderFiles map[string]string, ladderDims map[string]geo.Dim) []byte {
+
+	width := 0.0
+	for _, dim := range ladderDims {
+		width = math.Max(width, dim.Width)
+	}
+
+	// A4 portrait, at the height of the page
+	previous := geo.Dim{Width: height * 210 / 297, Height: height}
+
+	b := &bytes.Buffer{}
+
+	writeBuildHeader(b, spec.ID, geo.Dim{Width: width, Height: height})
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-pages\" inkscape:label=\"%s\">\n", geo.PagesLayer)
+	for _, ss := range spec.Spreads {
+		dim := ladderDims[ss.Name]
+		fmt.Fprintf(b, "    <rect id=\"page-%s\" x=\"0\" y=\"0\" width=\"%s\" height=\"%s\" fill=\"none\"><title>page-dynamic-%s</title></rect>\n",
+			escape(ss.Name), num(dim.Width), num(dim.Height), escape(ss.Name))
+	}
+	fmt.Fprintf(b, "  </g>\n")
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-images\" inkscape:label=\"%s\">\n", geo.ImagesLayer)
+	for _, ss := range spec.Spreads {
+		fmt.Fprintf(b, "    <rect id=\"image-%s\" x=\"0\" y=\"0\" width=\"%s\" height=\"%s\" fill=\"none\"><title>image-dynamic-%s-%s</title></rect>\n",
+			escape(ss.Name), num(previous.Width), num(previous.Height), geo.Previous, escape(ss.Name))
+	}
+	fmt.Fprintf(b, "  </g>\n")
+
+	fmt.Fprintf(b, "  <g inkscape:groupmode=\"layer\" id=\"layer-anchors\" inkscape:label=\"%s\">\n", geo.AnchorsLayer)
+	writeBuildAnchor(b, "anchor-ref", geo.AnchorReference, "", geo.Point{})
+	for _, ss := range spec.Spreads {
+		writeBuildAnchor(b, "anchor-previous-"+ss.Name, "img-"+geo.Previous+"-"+ss.Name, "", geo.Point{})
+		writeBuildAnchor(b, "anchor-ladder-"+ss.Name, buildLadderAnchor(ss.Name), ladderFiles[ss.Name], geo.Point{})
+	}
+	fmt.Fprintf(b, "  </g>\n")
+	fmt.Fprintf(b, "</svg>\n")
+
+	return b.Bytes()
+}
+
+func writeBuildHeader(b *bytes.Buffer, id string, dim geo.Dim) {
+
+	fmt.Fprintf(b, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<svg
+   xmlns:dc="http://purl.org/dc/elements/1.1/"
+   xmlns:cc="http://creativecommons.org/ns#"
+   xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+   xmlns="http://www.w3.org/2000/svg"
+   xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd"
+   xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape"
+   width="%smm"
+   height="%smm"
+   viewBox="0 0 %s %s"
+   version="1.1"
+   id="%s">
+  <sodipodi:namedview id="namedview" inkscape:document-units="mm" />
+  <metadata>
+    <rdf:RDF>
+      <cc:Work rdf:about="">
+        <dc:title>%s</dc:title>
+      </cc:Work>
+    </rdf:RDF>
+  </metadata>
+`, num(dim.Width), num(dim.Height), num(dim.Width), num(dim.Height), escape(id), escape(id))
+}
+
+// writeBuildAnchor draws a small circle, as Inkscape does, which is read by its centre
+func writeBuildAnchor(b *bytes.Buffer, id, title, desc string, p geo.Point) {
+
+	r := 1.0
+
+	descElement := ""
+	if desc != "" {
+		descElement = "<desc>" + escape(desc) + "</desc>"
+	}
+
+	fmt.Fprintf(b, "    <path id=\"%s\" sodipodi:type=\"arc\" sodipodi:cx=\"%s\" sodipodi:cy=\"%s\" sodipodi:rx=\"%s\" sodipodi:ry=\"%s\" d=\"M %s,%s A %s,%s 0 1 1 %s,%s A %s,%s 0 1 1 %s,%s Z\" fill=\"none\"><title>%s</title>%s</path>\n",
+		escape(id), num(p.X), num(p.Y), num(r), num(r),
+		num(p.X+r), num(p.Y), num(r), num(r), num(p.X-r), num(p.Y), num(r), num(r), num(p.X+r), num(p.Y),
+		escape(title), descElement)
+}
+
+func num(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func escape(s string) string {
+	b := &bytes.Buffer{}
+	xml.EscapeText(b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package parsesvg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+func TestBuildLayout(t *testing.T) {
+
+	spec := BuildSpec{
+		ID: "layout-exam",
+		Spreads: []SpreadSpec{
+			{
+				Name:       "mark",
+				Actor:      "Marker",
+				Questions:  []string{"1", "Q2", "3"},
+				Boxes:      []string{"number", "mark"},
+				PageChecks: true,
+				Colour:     "#ccddff",
+			},
+			{
+				Name:      "check",
+				Actor:     "Checker",
+				Questions: []string{"1", "Q2", "3"},
+			},
+		},
+	}
+
+	built, err := BuildLayout(spec)
+	assert.NoError(t, err)
+
+	files := []string{}
+	for filename := range built.Files {
+		files = append(files, filename)
+	}
+	assert.ElementsMatch(t, []string{"layout-exam.svg", "layout-exam-mark.svg", "layout-exam-check.svg"}, files)
+
+	ids := []string{}
+	for _, tf := range built.Ladders["mark"].TextFields {
+		ids = append(ids, tf.ID)
+	}
+
+	assert.Equal(t, []string{
+		"q1-number", "q1-mark",
+		"q2-number", "q2-mark",
+		"q3-number", "q3-mark",
+		"page-ok", "page-bad",
+	}, ids)
+
+	assert.Equal(t, 3, len(built.Ladders["check"].TextFields))
+	assert.Equal(t, "q2-mark", built.Ladders["check"].TextFields[1].ID)
+
+	// boxes are 12mm square
+	box := built.Ladders["mark"].TextFields[0].Rect.Dim
+	assert.InDelta(t, 12*geo.PPMM, box.Width, 1e-6)
+	assert.InDelta(t, 12*geo.PPMM, box.Height, 1e-6)
+
+	mark := built.Layout.PageDims["mark"]
+	assert.True(t, mark.DynamicWidth)
+	assert.InDelta(t, 312*geo.PPMM, mark.Height, 1e-6)
+	assert.Equal(t, built.Ladders["mark"].Dim.Width, mark.Width)
+
+	assert.Equal(t, "layout-exam-mark.svg", built.Layout.Filenames["svg-mark-ladder"])
+	assert.Contains(t, built.Layout.Anchors, "img-previous-mark")
+	assert.Contains(t, built.Layout.ImageDims, "previous-mark")
+
+	assert.Equal(t, 8, len(built.Spreads["mark"].TextFields))
+	assert.Equal(t, mark, built.Spreads["mark"].Dim)
+
+	dir, err := ioutil.TempDir("", "gradex-build")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	paths, err := built.Write(dir, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(paths))
+
+	_, err = built.Write(dir, false)
+	assert.Error(t, err)
+
+	_, err = built.Write(dir, true)
+	assert.NoError(t, err)
+
+	problems, err := LintLayout(filepath.Join(dir, "layout-exam.svg"), []LintSpread{
+		{Name: "mark", Previous: true},
+		{Name: "check", Previous: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []LintProblem{}, problems)
+
+	spread, err := GetTextFieldSpread(filepath.Join(dir, "layout-exam.svg"), "mark")
+	assert.NoError(t, err)
+	assert.Equal(t, built.Spreads["mark"].TextFields, spread.TextFields)
+}
+
+func TestBuildLayoutErrors(t *testing.T) {
+
+	for name, spec := range map[string]BuildSpec{
+		"no id":      {Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1"}}}},
+		"no spreads": {ID: "layout"},
+		"no name":    {ID: "layout", Spreads: []SpreadSpec{{Questions: []string{"1"}}}},
+		"empty":      {ID: "layout", Spreads: []SpreadSpec{{Name: "mark"}}},
+		"twice":      {ID: "layout", Spreads: []SpreadSpec{{Name: "mark", PageChecks: true}, {Name: "mark", PageChecks: true}}},
+		"same q":     {ID: "layout", Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1", "q1"}}}},
+		"bad q":      {ID: "layout", Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1 a"}}}},
+		"bad box":    {ID: "layout", Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1"}, Boxes: []string{"a=b"}}}},
+		"bad colour": {ID: "layout", Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1"}, Colour: "sky"}}},
+		"too tall":   {ID: "layout", Height: 50, Spreads: []SpreadSpec{{Name: "mark", Questions: []string{"1", "2", "3"}}}},
+	} {
+		_, err := BuildLayout(spec)
+		assert.Error(t, err, name)
+	}
+}
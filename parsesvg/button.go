@@ -0,0 +1,155 @@
+package parsesvg
+
+import (
+	"errors"
+
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/unipdf/v3/annotator"
+	"github.com/timdrysdale/unipdf/v3/core"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// Checkboxes and radio groups are button fields in the acroform. There is
+// no radio field in the annotator, so we make one from checkbox widgets,
+// renaming the on state of each to the option it stands for, which is what
+// the group's value is set to when that button is selected.
+
+// appendButtons shifts the buttons of the ladder by its corner, and adds them to the spread
+func appendButtons(spread *Spread, ladder *Ladder, corner geo.Point) {
+
+	for _, cb := range ladder.CheckBoxes {
+		cb.Rect.Corner = TranslatePosition(corner, cb.Rect.Corner)
+		spread.CheckBoxes = append(spread.CheckBoxes, cb)
+	}
+
+	for _, rg := range ladder.RadioGroups {
+		shifted := RadioGroup{ID: rg.ID}
+		for _, rb := range rg.Buttons {
+			rb.Rect.Corner = TranslatePosition(corner, rb.Rect.Corner)
+			shifted.Buttons = append(shifted.Buttons, rb)
+		}
+		spread.RadioGroups = append(spread.RadioGroups, shifted)
+	}
+}
+
+func shiftRadioGroup(rg RadioGroup, dx float64) RadioGroup {
+
+	shifted := RadioGroup{ID: rg.ID}
+
+	for _, rb := range rg.Buttons {
+		rb.Rect.Corner.X = rb.Rect.Corner.X + dx
+		shifted.Buttons = append(shifted.Buttons, rb)
+	}
+
+	return shifted
+}
+
+// selectedOption finds which option of the group the values say is selected, either
+// from the value of each option (as it is kept in the pagedata), or of the group itself
+func selectedOption(rg RadioGroup, values PagePrefills) string {
+
+	for _, rb := range rg.Buttons {
+		if values[extract.RadioOptionKey(rg.ID, rb.Option)] != "" {
+			return rb.Option
+		}
+	}
+
+	for _, rb := range rg.Buttons {
+		if values[rg.ID] == rb.Option {
+			return rb.Option
+		}
+	}
+
+	return ""
+}
+
+// buttonTextFields are the checkboxes, and the options of the radio groups,
+// as textfields, so that they can be read optically like any other box
+func buttonTextFields(spread Spread) []TextField {
+
+	fields := []TextField{}
+
+	for _, cb := range spread.CheckBoxes {
+		fields = append(fields, TextField{ID: cb.ID, Rect: cb.Rect})
+	}
+
+	for _, rg := range spread.RadioGroups {
+		for _, rb := range rg.Buttons {
+			fields = append(fields, TextField{ID: extract.RadioOptionKey(rg.ID, rb.Option), Rect: rb.Rect})
+		}
+	}
+
+	return fields
+}
+
+func newRadioField(page *model.PdfPage, name string, rg RadioGroup, dim geo.Dim, selected string) (*model.PdfFieldButton, error) {
+
+	if len(rg.Buttons) == 0 {
+		return nil, errors.New("no buttons in radio group")
+	}
+
+	field := model.NewPdfField()
+	radiof := &model.PdfFieldButton{}
+	field.SetContext(radiof)
+	radiof.PdfField = field
+
+	radiof.T = core.MakeString(name)
+	radiof.Ff = core.MakeInteger(int64(model.FieldFlagRadio | model.FieldFlagNoToggleToOff))
+
+	radiof.V = core.MakeName(extract.ButtonOff)
+
+	for _, rb := range rg.Buttons {
+
+		if rb.Option == "" {
+			return nil, errors.New("radio button without an option")
+		}
+
+		opt := annotator.CheckboxFieldOptions{Checked: rb.Option == selected}
+
+		checkboxf, err := annotator.NewCheckboxField(page, name, formRect(rb.Rect, dim), opt)
+		if err != nil {
+			return nil, err
+		}
+
+		widget := checkboxf.Annotations[0]
+
+		renameOnState(widget, rb.Option)
+
+		widget.AS = core.MakeName(extract.ButtonOff)
+
+		if rb.Option == selected {
+			widget.AS = core.MakeName(rb.Option)
+			radiof.V = core.MakeName(rb.Option)
+		}
+
+		widget.Parent = radiof.ToPdfObject()
+		radiof.Annotations = append(radiof.Annotations, widget)
+	}
+
+	return radiof, nil
+}
+
+// renameOnState changes the name of the appearances for the on state of the widget
+func renameOnState(widget *model.PdfAnnotationWidget, state string) {
+
+	ap, ok := core.GetDict(widget.AP)
+	if !ok {
+		return
+	}
+
+	for _, key := range []core.PdfObjectName{"N", "D"} {
+
+		appearances, ok := core.GetDict(ap.Get(key))
+		if !ok {
+			continue
+		}
+
+		for _, name := range appearances.Keys() {
+			if string(name) != extract.ButtonOff && string(name) != state {
+				appearances.Set(core.PdfObjectName(state), appearances.Get(name))
+				appearances.Remove(name)
+			}
+		}
+	}
+}
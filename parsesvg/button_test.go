@@ -0,0 +1,75 @@
+package parsesvg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+const buttonTestSvg = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="50pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="checkboxes" transform="translate(5,0)">
+<rect x="0" y="10" width="8" height="8"><title>q1-attempted</title></rect>
+<rect x="0" y="20" width="8" height="8"><title>page-ok</title><desc>X</desc></rect>
+</g>
+<g inkscape:label="radiobuttons">
+<rect x="50" y="10" width="8" height="8"><title>page</title><desc>good</desc></rect>
+<rect x="60" y="10" width="8" height="8"><title>q1</title><desc>yes</desc></rect>
+<rect x="50" y="20" width="8" height="8"><title>page</title><desc>bad</desc></rect>
+</g>
+</svg>`
+
+func TestDefineLadderButtons(t *testing.T) {
+
+	ladder, err := DefineLadderFromSVG([]byte(buttonTestSvg))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []CheckBox{
+		{ID: "q1-attempted", Rect: geo.Rect{Corner: geo.Point{X: 5, Y: 10}, Dim: geo.Dim{Width: 8, Height: 8}}},
+		{ID: "page-ok", Prefill: "X", Rect: geo.Rect{Corner: geo.Point{X: 5, Y: 20}, Dim: geo.Dim{Width: 8, Height: 8}}},
+	}, ladder.CheckBoxes)
+
+	assert.Equal(t, 2, len(ladder.RadioGroups))
+	assert.Equal(t, "page", ladder.RadioGroups[0].ID)
+	assert.Equal(t, "q1", ladder.RadioGroups[1].ID)
+	assert.Equal(t, []RadioButton{
+		{Option: "good", Rect: geo.Rect{Corner: geo.Point{X: 50, Y: 10}, Dim: geo.Dim{Width: 8, Height: 8}}},
+		{Option: "bad", Rect: geo.Rect{Corner: geo.Point{X: 50, Y: 20}, Dim: geo.Dim{Width: 8, Height: 8}}},
+	}, ladder.RadioGroups[0].Buttons)
+}
+
+func TestSelectedOption(t *testing.T) {
+
+	rg := RadioGroup{ID: "page", Buttons: []RadioButton{{Option: "good"}, {Option: "bad"}}}
+
+	assert.Equal(t, "", selectedOption(rg, PagePrefills{}))
+	assert.Equal(t, "bad", selectedOption(rg, PagePrefills{"page=good": "", "page=bad": "bad"}))
+	assert.Equal(t, "good", selectedOption(rg, PagePrefills{"page": "good"}))
+	assert.Equal(t, "", selectedOption(rg, PagePrefills{"page": "ugly"}))
+}
+
+func TestButtonTextFields(t *testing.T) {
+
+	spread := Spread{}
+
+	ladder, err := DefineLadderFromSVG([]byte(buttonTestSvg))
+	assert.NoError(t, err)
+
+	appendButtons(&spread, ladder, geo.Point{X: 100, Y: 200})
+
+	fields := buttonTextFields(spread)
+
+	ids := []string{}
+	for _, tf := range fields {
+		ids = append(ids, tf.ID)
+	}
+
+	assert.Equal(t, []string{"q1-attempted", "page-ok", "page=good", "page=bad", "q1=yes"}, ids)
+	assert.Equal(t, geo.Point{X: 105, Y: 210}, fields[0].Rect.Corner)
+	assert.Equal(t, geo.Point{X: 150, Y: 220}, fields[3].Rect.Corner)
+
+	// the ladder is left where it was
+	assert.Equal(t, geo.Point{X: 50, Y: 20}, ladder.RadioGroups[0].Buttons[1].Rect.Corner)
+}
@@ -0,0 +1,449 @@
+package parsesvg
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/unipdf/v3/creator"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// The chrome of a ladder (or any other image in a layout) is a JPEG unless
+// the filename in the layout says otherwise, e.g. sidebar.png for a PNG
+// (which can be transparent), or sidebar.svg to draw the chrome layer of the
+// ladder's svg directly into the PDF, so it stays sharp at any zoom. Only
+// rects, lines and text are drawn from an svg (in that order, so backgrounds
+// go behind the lines and text), so anything fancier needs exporting as a
+// PNG. The ladder itself is always the .svg of the same name.
+
+const (
+	chromeJPEG = ".jpg"
+	chromePNG  = ".png"
+	chromeSVG  = ".svg"
+)
+
+// chromeFilenames splits the filename given in the layout into
+// the ladder svg, and the file the chrome is drawn from
+func chromeFilenames(filename string) (string, string) {
+
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	switch ext {
+	case chromeJPEG, ".jpeg", chromePNG, chromeSVG:
+		return strings.TrimSuffix(filename, filepath.Ext(filename)) + chromeSVG, filename
+	}
+
+	return filename + chromeSVG, filename + chromeJPEG
+}
+
+func isVectorChrome(filename string) bool {
+	return strings.ToLower(filepath.Ext(filename)) == chromeSVG
+}
+
+type chromeSvg struct {
+	XMLName xml.Name      `xml:"svg"`
+	Width   string        `xml:"width,attr"`
+	Height  string        `xml:"height,attr"`
+	ViewBox string        `xml:"viewBox,attr"`
+	Groups  []chromeGroup `xml:"g"`
+}
+
+type chromeGroup struct {
+	Label     string        `xml:"http://www.inkscape.org/namespaces/inkscape label,attr"`
+	Transform string        `xml:"transform,attr"`
+	Style     string        `xml:"style,attr"`
+	Rects     []chromeRect  `xml:"rect"`
+	Lines     []chromeLine  `xml:"line"`
+	Texts     []chromeText  `xml:"text"`
+	Groups    []chromeGroup `xml:"g"`
+}
+
+type chromeRect struct {
+	X         string `xml:"x,attr"`
+	Y         string `xml:"y,attr"`
+	Width     string `xml:"width,attr"`
+	Height    string `xml:"height,attr"`
+	Transform string `xml:"transform,attr"`
+	chromeStyle
+}
+
+type chromeLine struct {
+	X1        string `xml:"x1,attr"`
+	Y1        string `xml:"y1,attr"`
+	X2        string `xml:"x2,attr"`
+	Y2        string `xml:"y2,attr"`
+	Transform string `xml:"transform,attr"`
+	chromeStyle
+}
+
+type chromeText struct {
+	X         string       `xml:"x,attr"`
+	Y         string       `xml:"y,attr"`
+	Transform string       `xml:"transform,attr"`
+	Text      string       `xml:",chardata"`
+	Spans     []chromeSpan `xml:"tspan"`
+	chromeStyle
+}
+
+type chromeSpan struct {
+	X    string `xml:"x,attr"`
+	Y    string `xml:"y,attr"`
+	Text string `xml:",chardata"`
+	chromeStyle
+}
+
+// presentation attributes, which the style attribute overrides
+type chromeStyle struct {
+	Style       string `xml:"style,attr"`
+	Fill        string `xml:"fill,attr"`
+	Stroke      string `xml:"stroke,attr"`
+	StrokeWidth string `xml:"stroke-width,attr"`
+	FontSize    string `xml:"font-size,attr"`
+	FontWeight  string `xml:"font-weight,attr"`
+}
+
+// properties are inherited from the enclosing element, as in svg
+func (cs chromeStyle) properties(inherited map[string]string) map[string]string {
+
+	props := make(map[string]string)
+
+	for k, v := range inherited {
+		props[k] = v
+	}
+
+	for k, v := range map[string]string{
+		"fill":         cs.Fill,
+		"stroke":       cs.Stroke,
+		"stroke-width": cs.StrokeWidth,
+		"font-size":    cs.FontSize,
+		"font-weight":  cs.FontWeight,
+	} {
+		if v != "" {
+			props[k] = v
+		}
+	}
+
+	for k, v := range parseStyle(cs.Style) {
+		props[k] = v
+	}
+
+	return props
+}
+
+func parseStyle(style string) map[string]string {
+
+	props := make(map[string]string)
+
+	for _, decl := range strings.Split(style, ";") {
+		kv := strings.SplitN(decl, ":", 2)
+		if len(kv) == 2 {
+			props[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+
+	return props
+}
+
+// parseColour understands the colours Inkscape writes, and a few names
+func parseColour(s string) (creator.Color, bool) {
+
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	switch s {
+	case "", "none", "transparent":
+		return nil, false
+	case "black":
+		return creator.ColorBlack, true
+	case "white":
+		return creator.ColorWhite, true
+	case "red":
+		return creator.ColorRed, true
+	case "green":
+		return creator.ColorGreen, true
+	case "blue":
+		return creator.ColorBlue, true
+	}
+
+	if strings.HasPrefix(s, "#") && len(s) == 4 {
+		s = "#" + s[1:2] + s[1:2] + s[2:3] + s[2:3] + s[3:4] + s[3:4]
+	}
+
+	if strings.HasPrefix(s, "#") && len(s) == 7 {
+		return creator.ColorRGBFromHex(s), true
+	}
+
+	return nil, false
+}
+
+// parseLength reads a length in user units, ignoring any px suffix
+func parseLength(s string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "px"), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// chromePainter draws svg user units at a corner of the page, in points
+type chromePainter struct {
+	c      *creator.Creator
+	corner geo.Point
+	scale  float64
+}
+
+func (p chromePainter) point(x, y float64) (float64, float64) {
+	return p.corner.X + x*p.scale, p.corner.Y + y*p.scale
+}
+
+// drawSVGChrome draws the chrome layer of the svg, scaled to the size of the insert
+func drawSVGChrome(c *creator.Creator, filename string, corner geo.Point, dim geo.Dim) error {
+
+	svgBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var svg chromeSvg
+
+	err = xml.Unmarshal(svgBytes, &svg)
+	if err != nil {
+		return err
+	}
+
+	width, err := chromeUserWidth(svg)
+	if err != nil {
+		return fmt.Errorf("can't size chrome %s: %v", filename, err)
+	}
+
+	p := chromePainter{
+		c:      c,
+		corner: corner,
+		scale:  dim.Width / width,
+	}
+
+	for _, g := range svg.Groups {
+		if g.Label == geo.ChromeLayer {
+			err = p.drawGroup(g, identity(), map[string]string{})
+			if err != nil {
+				return fmt.Errorf("can't draw chrome %s: %v", filename, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// chromeUserWidth is the width of the svg in its own (user) units
+func chromeUserWidth(svg chromeSvg) (float64, error) {
+
+	if fields := strings.Fields(strings.Replace(svg.ViewBox, ",", " ", -1)); len(fields) == 4 {
+		if w, err := strconv.ParseFloat(fields[2], 64); err == nil && w > 0 {
+			return w, nil
+		}
+	}
+
+	// without a viewBox, user units are px
+	w, err := scanUnitStringToPP(svg.Width)
+	if err != nil {
+		return 0, err
+	}
+
+	if w <= 0 {
+		return 0, errors.New("no width")
+	}
+
+	return w / geo.PPPX, nil
+}
+
+// drawGroup draws the group, and the groups inside it, with t
+// taking the group's parent's coordinates to user units
+func (p chromePainter) drawGroup(g chromeGroup, t transform, inherited map[string]string) error {
+
+	gt, err := parseTransform(g.Transform)
+	if err != nil {
+		return err
+	}
+	t = t.then(gt)
+
+	props := chromeStyle{Style: g.Style}.properties(inherited)
+
+	for _, r := range g.Rects {
+		rt, err := parseTransform(r.Transform)
+		if err != nil {
+			return err
+		}
+		err = p.drawRect(r, t.then(rt), r.properties(props))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, l := range g.Lines {
+		lt, err := parseTransform(l.Transform)
+		if err != nil {
+			return err
+		}
+		p.drawLine(l, t.then(lt), l.properties(props))
+	}
+
+	for _, text := range g.Texts {
+		tt, err := parseTransform(text.Transform)
+		if err != nil {
+			return err
+		}
+		err = p.drawText(text, t.then(tt), text.properties(props))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, child := range g.Groups {
+		err := p.drawGroup(child, t, props)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p chromePainter) strokeWidth(props map[string]string) float64 {
+	if w, ok := props["stroke-width"]; ok {
+		return parseLength(w) * p.scale
+	}
+	return p.scale //svg default is one user unit
+}
+
+func (p chromePainter) drawRect(r chromeRect, t transform, props map[string]string) error {
+
+	placed, err := t.rect(parseLength(r.X), parseLength(r.Y), parseLength(r.Width), parseLength(r.Height))
+	if err != nil {
+		return fmt.Errorf("rect %v", err)
+	}
+
+	x, y := p.point(placed.Corner.X, placed.Corner.Y)
+
+	rect := p.c.NewRectangle(x, y, placed.Dim.Width*p.scale, placed.Dim.Height*p.scale)
+
+	fill, hasFill := parseColour(props["fill"])
+	if _, ok := props["fill"]; !ok {
+		fill, hasFill = creator.ColorBlack, true //svg default
+	}
+
+	if hasFill {
+		rect.SetFillColor(fill)
+	}
+
+	stroke, hasStroke := parseColour(props["stroke"])
+
+	if hasStroke {
+		rect.SetBorderColor(stroke)
+		rect.SetBorderWidth(p.strokeWidth(props))
+	} else {
+		rect.SetBorderWidth(0)
+	}
+
+	if !hasFill && !hasStroke {
+		return nil
+	}
+
+	return p.c.Draw(rect)
+}
+
+func (p chromePainter) drawLine(l chromeLine, t transform, props map[string]string) {
+
+	stroke, ok := parseColour(props["stroke"])
+	if !ok {
+		return //lines are only stroked
+	}
+
+	x1, y1 := p.point(t.apply(parseLength(l.X1), parseLength(l.Y1)))
+	x2, y2 := p.point(t.apply(parseLength(l.X2), parseLength(l.Y2)))
+
+	line := p.c.NewLine(x1, y1, x2, y2)
+	line.SetColor(stroke)
+	line.SetLineWidth(p.strokeWidth(props))
+
+	p.c.Draw(line)
+}
+
+func (p chromePainter) drawText(t chromeText, tr transform, props map[string]string) error {
+
+	// paragraphs are only ever drawn level
+	if math.Abs(tr.b) > transformTolerance || math.Abs(tr.c) > transformTolerance {
+		return fmt.Errorf("text %q is rotated or skewed, which can't be drawn as chrome", strings.TrimSpace(t.Text))
+	}
+
+	spans := t.Spans
+
+	if len(spans) == 0 {
+		spans = []chromeSpan{{X: t.X, Y: t.Y, Text: t.Text}}
+	}
+
+	for _, span := range spans {
+
+		text := strings.TrimSpace(span.Text)
+		if text == "" {
+			continue
+		}
+
+		spanProps := span.properties(props)
+
+		x, y := parseLength(t.X), parseLength(t.Y)
+		if span.X != "" {
+			x = parseLength(span.X)
+		}
+		if span.Y != "" {
+			y = parseLength(span.Y)
+		}
+
+		size := 12.0 //svg default, in user units
+		if s, ok := spanProps["font-size"]; ok {
+			size = parseLength(s)
+		}
+
+		fontName := model.HelveticaName
+		if weight := spanProps["font-weight"]; weight == "bold" || weight == "700" || weight == "800" || weight == "900" {
+			fontName = model.HelveticaBoldName
+		}
+
+		font, err := model.NewStandard14Font(fontName)
+		if err != nil {
+			return err
+		}
+
+		colour, ok := parseColour(spanProps["fill"])
+		if _, set := spanProps["fill"]; !set {
+			colour, ok = creator.ColorBlack, true
+		}
+		if !ok {
+			continue
+		}
+
+		// svg places text by its baseline, and the creator by its top
+		bx, by := tr.apply(x, y)
+		size = size * tr.scale()
+		px, py := p.point(bx, by-0.8*size)
+
+		para := p.c.NewParagraph(text)
+		para.SetFont(font)
+		para.SetFontSize(size * p.scale)
+		para.SetColor(colour)
+		para.SetPos(px, py)
+		para.SetEnableWrap(false)
+
+		err = p.c.Draw(para)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
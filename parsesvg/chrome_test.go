@@ -0,0 +1,100 @@
+package parsesvg
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+const chromeTestSvg = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" width="100mm" height="50mm" viewBox="0 0 100 50">
+<g inkscape:label="chrome" transform="translate(5,0)" style="stroke:#000">
+<rect x="0" y="0" width="90" height="50" style="fill:#eeeeee;stroke:none"/>
+<line x1="0" y1="25" x2="90" y2="25" stroke-width="0.5"/>
+<text x="2" y="10" style="font-size:6px;font-weight:bold;stroke:none"><tspan x="2" y="10">Mark</tspan></text>
+</g>
+<g inkscape:label="textfields">
+<rect x="0" y="0" width="10" height="10"><title>q1</title></rect>
+</g>
+</svg>`
+
+func TestChromeFilenames(t *testing.T) {
+
+	for filename, want := range map[string][2]string{
+		"sidebar":        {"sidebar.svg", "sidebar.jpg"},
+		"sidebar.jpg":    {"sidebar.svg", "sidebar.jpg"},
+		"sidebar.png":    {"sidebar.svg", "sidebar.png"},
+		"sidebar.svg":    {"sidebar.svg", "sidebar.svg"},
+		"sidebar-312pt":  {"sidebar-312pt.svg", "sidebar-312pt.jpg"},
+		"sidebar.v2":     {"sidebar.v2.svg", "sidebar.v2.jpg"},
+		"dir/header.PNG": {"dir/header.svg", "dir/header.PNG"},
+	} {
+		ladder, chrome := chromeFilenames(filename)
+		assert.Equal(t, want[0], ladder, filename)
+		assert.Equal(t, want[1], chrome, filename)
+	}
+
+	assert.True(t, isVectorChrome("sidebar.svg"))
+	assert.False(t, isVectorChrome("sidebar.png"))
+}
+
+func TestChromeStyle(t *testing.T) {
+
+	inherited := map[string]string{"stroke": "#000000", "fill": "red"}
+
+	props := chromeStyle{Fill: "#ffffff", Style: "fill:none; stroke-width:2"}.properties(inherited)
+
+	assert.Equal(t, map[string]string{"stroke": "#000000", "fill": "none", "stroke-width": "2"}, props)
+
+	_, ok := parseColour("none")
+	assert.False(t, ok)
+	_, ok = parseColour("#abc")
+	assert.True(t, ok)
+	_, ok = parseColour("url(#gradient)")
+	assert.False(t, ok)
+
+	assert.Equal(t, 10.5, parseLength("10.5px"))
+}
+
+func TestChromeUserWidth(t *testing.T) {
+
+	var svg chromeSvg
+	assert.NoError(t, xml.Unmarshal([]byte(chromeTestSvg), &svg))
+
+	w, err := chromeUserWidth(svg)
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, w)
+
+	assert.Equal(t, 1, len(svg.Groups[0].Rects))
+	assert.Equal(t, 1, len(svg.Groups[0].Lines))
+	assert.Equal(t, "Mark", svg.Groups[0].Texts[0].Spans[0].Text)
+
+	w, err = chromeUserWidth(chromeSvg{Width: "96px"})
+	assert.NoError(t, err)
+	assert.Equal(t, 96.0, w)
+}
+
+func TestDrawSVGChrome(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-chrome")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	svgPath := filepath.Join(dir, "sidebar.svg")
+	assert.NoError(t, ioutil.WriteFile(svgPath, []byte(chromeTestSvg), 0644))
+
+	c := creator.New()
+	c.SetPageSize(creator.PageSize{300, 200})
+	c.NewPage()
+
+	assert.NoError(t, drawSVGChrome(c, svgPath, geo.Point{X: 10, Y: 10}, geo.Dim{Width: 283.46, Height: 141.73}))
+
+	assert.NoError(t, c.WriteToFile(filepath.Join(dir, "chrome.pdf")))
+
+	assert.Error(t, drawSVGChrome(c, filepath.Join(dir, "missing.svg"), geo.Point{}, geo.Dim{Width: 1, Height: 1}))
+}
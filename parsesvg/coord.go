@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"path/filepath"
 	"strings"
 
 	"github.com/timdrysdale/gradex-cli/extract"
@@ -17,7 +16,9 @@ import (
 // this is a cut-down version of render i.e. same logic
 // textfield coordinates look obfuscated in the unipdf extraction tool
 // so we use our layout to find them, rather than read them from the file
-func GetTextFieldSpread(svgLayoutPath, spreadName string) (Spread, error) {
+// Ladders are looked for in the template dirs, if any, before the folder the
+// layout is in, as for RenderSpreadExtra
+func GetTextFieldSpread(svgLayoutPath, spreadName string, templateDirs ...string) (Spread, error) {
 
 	spread := Spread{}
 
@@ -36,7 +37,7 @@ func GetTextFieldSpread(svgLayoutPath, spreadName string) (Spread, error) {
 
 	foundPage := false
 	for k, v := range layout.PageDims {
-		if strings.Contains(k, spread.Name) {
+		if inSpread(k, spread.Name) {
 			spread.Dim = v
 			foundPage = true
 		}
@@ -50,7 +51,7 @@ func GetTextFieldSpread(svgLayoutPath, spreadName string) (Spread, error) {
 	var svgFilenames []string
 
 	for k, _ := range layout.Filenames {
-		if strings.Contains(k, spread.Name) {
+		if inSpread(k, spread.Name) {
 
 			// assume jpg- or no prefix is image; svg- is ladder (image plus acroforms)
 			if strings.HasPrefix(k, geo.SVGElement) {
@@ -73,10 +74,10 @@ func GetTextFieldSpread(svgLayoutPath, spreadName string) (Spread, error) {
 			corner = thisAnchor
 		}
 
-		svgfilename := fmt.Sprintf("%s.svg", layout.Filenames[svgname])
+		svgfilename, _ := chromeFilenames(layout.Filenames[svgname])
 
 		// assume relative paths (absolute paths are not practial for production anyway)
-		svgfilename = filepath.Join(filepath.Dir(svgLayoutPath), svgfilename)
+		svgfilename = findTemplateFile(searchDirs(svgLayoutPath, templateDirs), svgfilename)
 
 		svgBytes, err := ioutil.ReadFile(svgfilename)
 		if err != nil {
@@ -101,6 +102,8 @@ func GetTextFieldSpread(svgLayoutPath, spreadName string) (Spread, error) {
 			tf.Rect.Corner = TranslatePosition(corner, tf.Rect.Corner)
 			spread.TextFields = append(spread.TextFields, tf)
 		}
+
+		appendButtons(&spread, ladder, corner)
 	}
 	return spread, nil
 }
@@ -207,6 +210,12 @@ func ScaleTextFieldGeometry(textfields *(map[string]extract.TextField), heightPx
 
 		tf.Rect = scaleArray(tf.Rect, scaleFactor)
 
+		for option, rect := range tf.Options {
+			rect[1] = tf.PageDim.Height - rect[1]
+			rect[3] = tf.PageDim.Height - rect[3]
+			tf.Options[option] = scaleArray(rect, scaleFactor)
+		}
+
 		(*textfields)[key] = tf
 	}
 
@@ -214,16 +223,19 @@ func ScaleTextFieldGeometry(textfields *(map[string]extract.TextField), heightPx
 
 }
 
-func GetImageBoxesForTextFieldsFromTemplate(svgLayoutPath, spreadName string, widthPx, heightPx int, vanilla bool, expand int) ([]optical.Box, error) {
+func GetImageBoxesForTextFieldsFromTemplate(svgLayoutPath, spreadName string, widthPx, heightPx int, vanilla bool, expand int, templateDirs ...string) ([]optical.Box, error) {
 
 	boxes := []optical.Box{}
 
-	spread, err := GetTextFieldSpread(svgLayoutPath, spreadName)
+	spread, err := GetTextFieldSpread(svgLayoutPath, spreadName, templateDirs...)
 
 	if err != nil {
 		return boxes, err
 	}
 
+	// checkboxes and radio buttons can be ticked on paper too
+	spread.TextFields = append(spread.TextFields, buttonTextFields(spread)...)
+
 	// coords from top-right corner (known point)
 	err = SwapTextFieldXCoordsInSpread(&spread)
 
@@ -276,8 +288,26 @@ func GetImageBoxesForTextFields(textfields map[string]extract.TextField, heightP
 
 	ScaleTextFieldGeometry(&textfields, heightPx)
 
+	// each option of a radio group gets its own box
+	fields := []extract.TextField{}
+
 	for _, tf := range textfields {
 
+		if tf.Kind != extract.FieldRadio {
+			fields = append(fields, tf)
+			continue
+		}
+
+		for option, rect := range tf.Options {
+			fields = append(fields, extract.TextField{
+				Key:  extract.RadioOptionKey(tf.Key, option),
+				Rect: rect,
+			})
+		}
+	}
+
+	for _, tf := range fields {
+
 		bounds, err := geo.ConvertPDFRectToImageRectangle(tf.Rect)
 
 		if err != nil {
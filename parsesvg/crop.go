@@ -0,0 +1,140 @@
+package parsesvg
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// Crop regions are parts of the previous image that we want to look at on
+// their own, e.g. the question number that the student wrote at the top of the
+// page, so we can check the labels without opening every script. They go on
+// the images layer, as a box crop-<name>-<spread>, with an anchor
+// img-crop-<name>-<spread>, placed over the previous image as it appears
+// on the spread.
+
+type CropRegions struct {
+	Regions  map[string]geo.Rect // on the spread, in points
+	Previous geo.Rect            // where the previous image goes, for the scale
+	Dynamic  bool                // previous image is scaled to the height of the page
+}
+
+func GetCropRegions(svgLayoutPath, spreadName string) (CropRegions, error) {
+
+	cr := CropRegions{
+		Regions: make(map[string]geo.Rect),
+	}
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return cr, fmt.Errorf("Error opening layout file %s: %v", svgLayoutPath, err)
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return cr, fmt.Errorf("Error defining layout %s: %v", svgLayoutPath, err)
+	}
+
+	return getCropRegions(layout, spreadName)
+}
+
+func getCropRegions(layout *Layout, spreadName string) (CropRegions, error) {
+
+	cr := CropRegions{
+		Regions: make(map[string]geo.Rect),
+	}
+
+	var pageDim geo.Dim
+	foundPage := false
+
+	for k, v := range layout.PageDims {
+		if inSpread(k, spreadName) {
+			pageDim = v
+			foundPage = true
+		}
+	}
+
+	if !foundPage {
+		return cr, fmt.Errorf("No page size info for spread %s", spreadName)
+	}
+
+	cr.Previous = geo.Rect{
+		Corner: layout.Anchors[fmt.Sprintf("img-previous-%s", spreadName)],
+		Dim:    layout.ImageDims[fmt.Sprintf("previous-%s", spreadName)],
+	}
+
+	if pageDim.DynamicWidth {
+		cr.Dynamic = true
+		cr.Previous.Dim.Height = pageDim.Height
+	}
+
+	suffix := "-" + spreadName
+
+	for k, dim := range layout.ImageDims {
+
+		if !strings.HasPrefix(k, "crop-") || !strings.HasSuffix(k, suffix) {
+			continue
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(k, "crop-"), suffix)
+
+		corner, ok := layout.Anchors["img-"+k]
+		if !ok || name == "" {
+			continue
+		}
+
+		cr.Regions[name] = geo.Rect{Corner: corner, Dim: dim}
+	}
+
+	return cr, nil
+}
+
+// ImageRectangles returns the crop regions in pixels of a previous image
+// of the given size, from its top left corner, in the same way as the image
+// is scaled to fit the spread when rendered
+func (cr CropRegions) ImageRectangles(widthPx, heightPx int) (map[string]image.Rectangle, error) {
+
+	rects := make(map[string]image.Rectangle)
+
+	if widthPx <= 0 || heightPx <= 0 {
+		return rects, errors.New("image has no size")
+	}
+
+	if cr.Previous.Dim.Height <= 0 {
+		return rects, errors.New("layout has no previous image for the spread")
+	}
+
+	// points per pixel
+	scale := cr.Previous.Dim.Height / float64(heightPx)
+
+	if !cr.Dynamic && float64(widthPx)*scale > cr.Previous.Dim.Width {
+		scale = cr.Previous.Dim.Width / float64(widthPx)
+	}
+
+	bounds := image.Rect(0, 0, widthPx, heightPx)
+
+	for name, r := range cr.Regions {
+
+		x0 := (r.Corner.X - cr.Previous.Corner.X) / scale
+		y0 := (r.Corner.Y - cr.Previous.Corner.Y) / scale
+		x1 := x0 + r.Dim.Width/scale
+		y1 := y0 + r.Dim.Height/scale
+
+		rect := image.Rect(
+			int(math.Round(x0)),
+			int(math.Round(y0)),
+			int(math.Round(x1)),
+			int(math.Round(y1))).Intersect(bounds)
+
+		if !rect.Empty() {
+			rects[name] = rect
+		}
+	}
+
+	return rects, nil
+}
@@ -0,0 +1,44 @@
+package parsesvg
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+func TestCropRegions(t *testing.T) {
+
+	layout := &Layout{
+		Anchors: map[string]geo.Point{
+			"img-previous-label":      {X: 100, Y: 0},
+			"img-crop-question-label": {X: 100, Y: 0},
+			"img-crop-name-label":     {X: 300, Y: 50},
+			"img-crop-question-mark":  {X: 0, Y: 0},
+		},
+		PageDims: map[string]geo.Dim{
+			"label": {Width: 700, Height: 842},
+		},
+		ImageDims: map[string]geo.Dim{
+			"previous-label":      {Width: 595, Height: 842},
+			"crop-question-label": {Width: 200, Height: 100},
+			"crop-name-label":     {Width: 500, Height: 100},
+			"crop-question-mark":  {Width: 200, Height: 100},
+		},
+	}
+
+	cr, err := getCropRegions(layout, "label")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(cr.Regions))
+
+	// image at two pixels per point
+	rects, err := cr.ImageRectangles(1190, 1684)
+	assert.NoError(t, err)
+
+	assert.Equal(t, image.Rect(0, 0, 400, 200), rects["question"])
+	assert.Equal(t, image.Rect(400, 100, 1190, 300), rects["name"]) //trimmed to the image
+
+	_, err = getCropRegions(layout, "check")
+	assert.Error(t, err)
+}
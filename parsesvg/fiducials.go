@@ -0,0 +1,168 @@
+package parsesvg
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/gradex-cli/optical"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+// The fiducials go in the corners of a box that a layout places with an
+// anchor called img-fiducials-<spread> and a box on the images layer called
+// fiducials-<spread>, in the same way as the identity code. The box has to be
+// on the bar, clear of the previous image. A spread that does not place its
+// own box gets one just inside the edges of its bar instead, unless that would
+// put a fiducial on a textfield, button or the identity code, where it would
+// be read as a mark, in which case the spread does not get fiducials, and a
+// scan of it can't be registered (see optical.Register).
+
+const defaultFiducialMargin = 2.0 // points between the default fiducials and the edges of the bar
+
+func fiducialBox(layout *Layout, spreadName string, page geo.Dim) (geo.Rect, bool) {
+
+	corner, hasAnchor := layout.Anchors[fmt.Sprintf("img-fiducials-%s", spreadName)]
+	dim, hasDim := layout.ImageDims[fmt.Sprintf("fiducials-%s", spreadName)]
+
+	if !hasAnchor || !hasDim {
+		return defaultFiducialBox(layout, spreadName, page)
+	}
+
+	return geo.Rect{Corner: corner, Dim: geo.Dim{Width: dim.Width, Height: dim.Height}}, true
+}
+
+// defaultFiducialBox is the bar, less a margin. On a dynamic page the bar is
+// the whole of the layout's width, because the previous image is added to its
+// left; on a static page, it is the wider of the strips either side of the
+// previous image.
+func defaultFiducialBox(layout *Layout, spreadName string, page geo.Dim) (geo.Rect, bool) {
+
+	left, right := 0.0, page.Width
+
+	if !page.DynamicWidth {
+
+		corner, hasAnchor := layout.Anchors[fmt.Sprintf("img-previous-%s", spreadName)]
+		dim, hasDim := layout.ImageDims[fmt.Sprintf("previous-%s", spreadName)]
+
+		if !hasAnchor || !hasDim {
+			return geo.Rect{}, false
+		}
+
+		if corner.X > page.Width-(corner.X+dim.Width) {
+			right = corner.X
+		} else {
+			left = corner.X + dim.Width
+		}
+	}
+
+	box := geo.Rect{
+		Corner: geo.Point{X: left + defaultFiducialMargin, Y: defaultFiducialMargin},
+		Dim:    geo.Dim{Width: right - left - 2*defaultFiducialMargin, Height: page.Height - 2*defaultFiducialMargin},
+	}
+
+	if box.Dim.Width < 2*optical.FiducialSize || box.Dim.Height < 2*optical.FiducialSize {
+		return geo.Rect{}, false
+	}
+
+	return box, true
+}
+
+// fiducialsClear checks that no fiducial would land on anything that is
+// read, before the spread is shifted by its dynamic width
+func fiducialsClear(layout *Layout, spread Spread, box geo.Rect) bool {
+
+	covered := []geo.Rect{}
+
+	for _, tf := range spread.TextFields {
+		covered = append(covered, tf.Rect)
+	}
+
+	for _, tf := range buttonTextFields(spread) {
+		covered = append(covered, tf.Rect)
+	}
+
+	for _, cb := range spread.ComboBoxes {
+		covered = append(covered, cb.Rect)
+	}
+
+	if qr, ok := identityCodeRect(layout, Spread{Name: spread.Name, Dim: spread.Dim}); ok {
+		covered = append(covered, qr)
+	}
+
+	size := optical.FiducialSize
+
+	for _, c := range optical.FiducialCentres(box) {
+		for _, r := range covered {
+			if c.X+size/2 > r.Corner.X && c.X-size/2 < r.Corner.X+r.Dim.Width &&
+				c.Y+size/2 > r.Corner.Y && c.Y-size/2 < r.Corner.Y+r.Dim.Height {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func drawFiducials(c *creator.Creator, layout *Layout, spread Spread) {
+
+	box, ok := fiducialBox(layout, spread.Name, spread.Dim)
+	if !ok {
+		return
+	}
+
+	// a box placed by the layout is clear by design, but the default may not be
+	if _, placed := layout.Anchors[fmt.Sprintf("img-fiducials-%s", spread.Name)]; !placed && !fiducialsClear(layout, spread, box) {
+		return
+	}
+
+	if spread.Dim.DynamicWidth {
+		box.Corner.X = box.Corner.X + spread.ExtraWidth
+	}
+
+	size := optical.FiducialSize
+
+	for _, centre := range optical.FiducialCentres(box) {
+		r := c.NewRectangle(centre.X-size/2, centre.Y-size/2, size, size)
+		r.SetBorderWidth(0)
+		r.SetFillColor(creator.ColorBlack)
+		c.Draw(r)
+	}
+}
+
+// GetFiducialBox returns the box for the fiducials on a spread, and the size
+// of the spread's page, both in points, as needed to register a scan of it
+func GetFiducialBox(svgLayoutPath, spreadName string) (geo.Rect, geo.Dim, error) {
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return geo.Rect{}, geo.Dim{}, errors.New(fmt.Sprintf("Error opening layout file %s: %v\n", svgLayoutPath, err))
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return geo.Rect{}, geo.Dim{}, errors.New(fmt.Sprintf("Error obtaining layout from svg %s\n", svgLayoutPath))
+	}
+
+	page := geo.Dim{}
+	foundPage := false
+
+	for k, v := range layout.PageDims {
+		if inSpread(k, spreadName) {
+			page = v
+			foundPage = true
+		}
+	}
+
+	if !foundPage {
+		return geo.Rect{}, geo.Dim{}, errors.New(fmt.Sprintf("No page size info for spread %s\n", spreadName))
+	}
+
+	box, ok := fiducialBox(layout, spreadName, page)
+	if !ok {
+		return geo.Rect{}, page, errors.New(fmt.Sprintf("No fiducials for spread %s (needs img-fiducials-%s and fiducials-%s in the layout, or room on its bar)\n", spreadName, spreadName, spreadName))
+	}
+
+	return box, page, nil
+}
@@ -0,0 +1,113 @@
+package parsesvg
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/gradex-cli/optical"
+)
+
+func TestFiducialBoxShippedLayout(t *testing.T) {
+
+	for _, name := range []string{"layout.svg", "layout-q5.svg"} {
+
+		layoutPath := "../ingester/test-fs/etc/overlay/template/" + name
+
+		box, page, err := GetFiducialBox(layoutPath, "mark")
+		assert.NoError(t, err)
+		assert.True(t, page.DynamicWidth)
+
+		// on the bar
+		assert.True(t, box.Corner.X >= 0, name)
+		assert.True(t, box.Corner.Y >= 0, name)
+		assert.True(t, box.Corner.X+box.Dim.Width <= page.Width, name)
+		assert.True(t, box.Corner.Y+box.Dim.Height <= page.Height, name)
+
+		svgBytes, err := ioutil.ReadFile(layoutPath)
+		assert.NoError(t, err)
+
+		layout, err := DefineLayoutFromSVG(svgBytes)
+		assert.NoError(t, err)
+
+		qr, ok := identityCodeRect(layout, Spread{Name: "mark", Dim: page})
+		assert.True(t, ok)
+
+		// and clear of the identity code
+		size := optical.FiducialSize
+		for _, c := range optical.FiducialCentres(box) {
+			clear := c.X+size/2 < qr.Corner.X || c.X-size/2 > qr.Corner.X+qr.Dim.Width ||
+				c.Y+size/2 < qr.Corner.Y || c.Y-size/2 > qr.Corner.Y+qr.Dim.Height
+			assert.True(t, clear, name)
+		}
+	}
+
+	// the check spread places no box, so it gets the default one, on its bar
+	box, page, err := GetFiducialBox("../ingester/test-fs/etc/overlay/template/layout.svg", "check")
+	assert.NoError(t, err)
+	assert.True(t, page.DynamicWidth)
+	assert.Equal(t, defaultFiducialMargin, box.Corner.X)
+	assert.Equal(t, page.Width-2*defaultFiducialMargin, box.Dim.Width)
+	assert.Equal(t, page.Height-2*defaultFiducialMargin, box.Dim.Height)
+}
+
+func TestFiducialBox(t *testing.T) {
+
+	layout := &Layout{
+		Anchors: map[string]geo.Point{
+			"img-fiducials-mark": {X: 60, Y: 4},
+		},
+		ImageDims: map[string]geo.Dim{
+			"fiducials-mark": {Width: 114, Height: 876},
+		},
+	}
+
+	page := geo.Dim{Width: 180, Height: 880, DynamicWidth: true}
+
+	box, ok := fiducialBox(layout, "mark", page)
+	assert.True(t, ok)
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 60, Y: 4}, Dim: geo.Dim{Width: 114, Height: 876}}, box)
+
+	// the whole bar, less the margin, when the layout places no box
+	box, ok = fiducialBox(layout, "moderate-active", page)
+	assert.True(t, ok)
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 2, Y: 2}, Dim: geo.Dim{Width: 176, Height: 876}}, box)
+
+	// a static page has its bar beside the previous image, here on the left
+	layout.Anchors["img-previous-label"] = geo.Point{X: 40, Y: 0}
+	layout.ImageDims["previous-label"] = geo.Dim{Width: 555, Height: 842}
+
+	box, ok = fiducialBox(layout, "label", geo.Dim{Width: 595, Height: 842})
+	assert.True(t, ok)
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 2, Y: 2}, Dim: geo.Dim{Width: 36, Height: 838}}, box)
+
+	// but not if there is no room for the fiducials
+	layout.Anchors["img-previous-label"] = geo.Point{X: 10, Y: 0}
+	layout.ImageDims["previous-label"] = geo.Dim{Width: 580, Height: 842}
+
+	_, ok = fiducialBox(layout, "label", geo.Dim{Width: 595, Height: 842})
+	assert.False(t, ok)
+
+	// a static page with no previous image has no bar
+	_, ok = fiducialBox(layout, "cover", geo.Dim{Width: 595, Height: 842})
+	assert.False(t, ok)
+}
+
+func TestFiducialsClear(t *testing.T) {
+
+	layout := &Layout{}
+	box := geo.Rect{Corner: geo.Point{X: 2, Y: 2}, Dim: geo.Dim{Width: 80, Height: 800}}
+
+	spread := Spread{
+		Name:       "check",
+		TextFields: []TextField{{ID: "mark", Rect: geo.Rect{Corner: geo.Point{X: 20, Y: 100}, Dim: geo.Dim{Width: 40, Height: 20}}}},
+	}
+
+	assert.True(t, fiducialsClear(layout, spread, box))
+
+	// a checkbox in the bottom right corner
+	spread.CheckBoxes = []CheckBox{{ID: "ok", Rect: geo.Rect{Corner: geo.Point{X: 70, Y: 790}, Dim: geo.Dim{Width: 10, Height: 10}}}}
+
+	assert.False(t, fiducialsClear(layout, spread, box))
+}
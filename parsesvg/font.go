@@ -0,0 +1,213 @@
+package parsesvg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/timdrysdale/unipdf/v3/core"
+	"github.com/timdrysdale/unipdf/v3/creator"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// Text is drawn in Helvetica unless the layout says otherwise, which only
+// covers the Latin-1 characters, so accented names from further afield, and
+// CJK or emoji in comments, can't be drawn (they are shown as ?, see
+// drawable). A textprefill can name one of the standard PDF fonts in its
+// description, e.g. {"textFont":"Courier"}, or a TrueType font file kept
+// with the layout, e.g. {"textFont":"NotoSans-Regular.ttf"}. OpenType (.otf)
+// files are fine if they have TrueType outlines, but not CFF ones.
+//
+// The description of a page box on the pages layer can name a font for the
+// whole spread in the same way, e.g. NotoSans-Regular.ttf for page-mark. It is
+// used for any textprefill that doesn't name its own, the comments, and the
+// form fields, so that text stays correct when it is typed in, and when it
+// is flattened at the next stage.
+//
+// Fonts that are drawn on the page are embedded as a subset, of just the
+// characters used. The font for the form fields is embedded in full, because
+// we can't know what will be typed into them.
+
+var standardFonts = map[string]bool{
+	"Courier":               true,
+	"Courier-Bold":          true,
+	"Courier-Oblique":       true,
+	"Courier-BoldOblique":   true,
+	"Helvetica":             true,
+	"Helvetica-Bold":        true,
+	"Helvetica-Oblique":     true,
+	"Helvetica-BoldOblique": true,
+	"Symbol":                true,
+	"ZapfDingbats":          true,
+	"Times-Roman":           true,
+	"Times-Bold":            true,
+	"Times-Italic":          true,
+	"Times-BoldItalic":      true,
+}
+
+// the name of the form field font in the acroform's resources
+const formFontName = "GxF"
+
+func isStandardFont(name string) bool {
+	return standardFonts[name]
+}
+
+func isFontFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".ttf", ".otf":
+		return true
+	}
+	return false
+}
+
+// loadFont gets a standard font by name, or a font file in the first of the
+// dirs that has it (see findTemplateFile)
+func loadFont(dirs []string, name string) (*model.PdfFont, error) {
+
+	if isStandardFont(name) {
+		return model.NewStandard14Font(model.StdFontName(name))
+	}
+
+	if !isFontFile(name) {
+		return nil, fmt.Errorf("%s is not a standard font, or a .ttf or .otf file", name)
+	}
+
+	path := findTemplateFile(dirs, name)
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("font file %s not found", path)
+	}
+
+	// a composite font has room for every glyph in the file, not just 256
+	font, err := model.NewCompositePdfFontFromTTFFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read font file %s: %v", path, err)
+	}
+
+	return font, nil
+}
+
+// checkFont is for linting, so we load the font and throw it away
+func checkFont(dirs []string, name string) error {
+	_, err := loadFont(dirs, name)
+	return err
+}
+
+// spreadFonts loads each font once for a spread, so that text drawn in the
+// same font goes in the same subset
+type spreadFonts struct {
+	c      *creator.Creator
+	dirs   []string
+	spread string // font for the spread, if any
+	drawn  map[string]*model.PdfFont
+}
+
+func newSpreadFonts(c *creator.Creator, dirs []string, spread string) *spreadFonts {
+	return &spreadFonts{
+		c:      c,
+		dirs:   dirs,
+		spread: spread,
+		drawn:  make(map[string]*model.PdfFont),
+	}
+}
+
+// draw gets the font to draw text in, which is nil for the default font,
+// falling back to the font for the spread if no name is given
+func (f *spreadFonts) draw(name string) (*model.PdfFont, error) {
+
+	if name == "" {
+		name = f.spread
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	if font, ok := f.drawn[name]; ok {
+		return font, nil
+	}
+
+	font, err := loadFont(f.dirs, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isStandardFont(name) {
+		f.c.EnableFontSubsetting(font)
+	}
+
+	f.drawn[name] = font
+
+	return font, nil
+}
+
+// setFormFont makes the font for the spread the default for the fields in
+// the form, so viewers use it to show what is typed, and so does
+// FlattenFields when the appearances are made at the next stage
+func (f *spreadFonts) setFormFont(form *model.PdfAcroForm) error {
+
+	if f.spread == "" {
+		return nil
+	}
+
+	// loaded again, so it isn't subset with the fonts that are drawn
+	font, err := loadFont(f.dirs, f.spread)
+	if err != nil {
+		return err
+	}
+
+	form.DR = model.NewPdfPageResources()
+
+	err = form.DR.SetFontByName(core.PdfObjectName(formFontName), font.ToPdfObject())
+	if err != nil {
+		return err
+	}
+
+	form.DA = formFontAppearance()
+
+	return nil
+}
+
+// drawable is the text as a font can draw it. The standard fonts (and the
+// default, Helvetica) only have the Latin-1 characters, and a few more, so
+// anything else, e.g. CJK or emoji, would come out garbled, and is shown as ?
+// instead. Only the drawn text changes; pagedata keeps what was written, so
+// naming a TrueType font for the spread later shows it as it should be.
+func (f *spreadFonts) drawable(name, text string) string {
+
+	if name == "" {
+		name = f.spread
+	}
+
+	if isFontFile(name) {
+		return text
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r <= unicode.MaxLatin1 || strings.ContainsRune(winAnsiExtras, r) {
+			return r
+		}
+		return '?'
+	}, text)
+}
+
+// winAnsiExtras are the characters that the standard fonts have
+// beyond Latin-1, such as curly quotes, dashes and the euro
+const winAnsiExtras = "€‚ƒ„…†‡ˆ‰Š‹ŒŽ‘’“”•–—˜™š›œžŸ"
+
+func (f *spreadFonts) hasFormFont() bool {
+	return f.spread != ""
+}
+
+// formFontAppearance sets the form font, at auto size, in black
+func formFontAppearance() *core.PdfObjectString {
+	return core.MakeString(fmt.Sprintf("/%s 0 Tf 0 g", formFontName))
+}
+
+// formText is a field value as a PDF text string, which has to be UTF-16
+// for anything outside PDFDocEncoding, e.g. accented names
+func formText(text string) *core.PdfObjectString {
+	return core.MakeEncodedString(text, true)
+}
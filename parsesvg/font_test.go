@@ -0,0 +1,204 @@
+package parsesvg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/util"
+	"github.com/timdrysdale/unipdf/v3/core"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+func TestLoadFont(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-font")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.ttf"), []byte("not a font"), 0644))
+
+	font, err := loadFont([]string{dir}, "Courier-Bold")
+	assert.NoError(t, err)
+	assert.True(t, font != nil)
+
+	assert.NoError(t, checkFont([]string{dir}, "Times-Roman"))
+
+	err = checkFont([]string{dir}, "Comic Sans")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not a standard font")
+
+	err = checkFont([]string{dir}, "missing.ttf")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	err = checkFont([]string{dir}, "broken.ttf")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't read font file")
+
+	assert.True(t, isFontFile("NotoSans-Regular.OTF"))
+	assert.False(t, isFontFile("NotoSans-Regular.woff"))
+}
+
+func TestDrawableText(t *testing.T) {
+
+	text := "Zoë “said” 你好 🙂"
+
+	// Helvetica, by default, has the accents and curly quotes, but not the rest
+	assert.Equal(t, "Zoë “said” ?? ?", newSpreadFonts(nil, nil, "").drawable("", text))
+	assert.Equal(t, "Zoë “said” ?? ?", newSpreadFonts(nil, nil, "NotoSans-Regular.ttf").drawable("Courier", text))
+
+	// a TrueType font draws it all
+	assert.Equal(t, text, newSpreadFonts(nil, nil, "NotoSans-Regular.ttf").drawable("", text))
+	assert.Equal(t, text, newSpreadFonts(nil, nil, "").drawable("NotoSans-Regular.ttf", text))
+}
+
+const fontTestLayout = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="200pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="pages">
+<rect width="200" height="100" x="0" y="0"><title>page-static-mark</title><desc> NotoSans-Regular.ttf </desc></rect>
+<rect width="200" height="100" x="0" y="0"><title>page-static-check</title></rect>
+</g>
+<g inkscape:label="anchors">
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-check-ladder</title><desc>ladder.svg</desc></path>
+</g>
+</svg>`
+
+const fontTestLadder = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="textprefills">
+<rect width="10" height="10" x="0" y="0"><title>name</title><desc>{"text":"Zoë","textSize":6,"textFont":"Wingdings"}</desc></rect>
+<rect width="10" height="10" x="0" y="20"><title>date</title><desc>{"text":"today","textSize":6,"textFont":"Courier"}</desc></rect>
+</g>
+</svg>`
+
+func TestLintFonts(t *testing.T) {
+
+	layout, err := DefineLayoutFromSVG([]byte(fontTestLayout))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"mark": "NotoSans-Regular.ttf"}, layout.Fonts)
+
+	dir, err := ioutil.TempDir("", "gradex-font")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	layoutPath := filepath.Join(dir, "layout.svg")
+
+	assert.NoError(t, ioutil.WriteFile(layoutPath, []byte(fontTestLayout), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ladder.svg"), []byte(fontTestLadder), 0644))
+
+	problems, err := LintLayout(layoutPath, []LintSpread{{Name: "mark"}, {Name: "check"}})
+	assert.NoError(t, err)
+
+	found := []string{}
+	for _, p := range problems {
+		found = append(found, p.String())
+	}
+	all := strings.Join(found, "\n")
+
+	assert.Contains(t, all, "ERROR   mark/page-mark: font: font file "+filepath.Join(dir, "NotoSans-Regular.ttf")+" not found")
+	assert.Contains(t, all, "ERROR   check/svg-check-ladder: textprefill name font: Wingdings is not a standard font, or a .ttf or .otf file")
+	assert.NotContains(t, all, "textprefill date")
+}
+
+// usedFonts is the base font name of each font on the first page of a pdf
+func usedFonts(t *testing.T, path string) []string {
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	pdfReader, err := model.NewPdfReader(f)
+	assert.NoError(t, err)
+
+	page, err := pdfReader.GetPage(1)
+	assert.NoError(t, err)
+
+	names := []string{}
+
+	fontDict, ok := core.GetDict(page.Resources.Font)
+	if !ok {
+		return names
+	}
+
+	for _, key := range fontDict.Keys() {
+		font, err := model.NewPdfFontFromPdfObject(fontDict.Get(key))
+		if assert.NoError(t, err) {
+			names = append(names, font.BaseFont())
+		}
+	}
+
+	return names
+}
+
+// TestRenderFontNonASCII embeds a TrueType font for the mark spread, and
+// checks that an accented name is drawn in it, and that it is still there
+// after the next stage has read it back from the pagedata and textfields
+func TestRenderFontNonASCII(t *testing.T) {
+
+	svgBytes, err := ioutil.ReadFile("./test/layout-a4-prefill.svg")
+	assert.NoError(t, err)
+
+	// the font is found in the same way as the ladders, from where we are
+	page := `id="title3915">page-static-mark</title>`
+	svg := strings.Replace(string(svgBytes), page, page+`<desc>./test/DejaVuSansMono.ttf</desc>`, 1)
+
+	dir, err := ioutil.TempDir("", "gradex-font")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	svgLayoutPath := filepath.Join(dir, "layout.svg")
+	assert.NoError(t, ioutil.WriteFile(svgLayoutPath, []byte(svg), 0644))
+
+	name := "Zoë Łódź"
+
+	pd := pagedata.PageData{
+		Current: pagedata.PageDetail{
+			Is:   pagedata.IsPage,
+			UUID: "69197384-fd15-42ac-ac16-82dbe4d52dd0",
+			Item: pagedata.ItemDetail{Who: name},
+		},
+	}
+
+	stages := []string{filepath.Join(dir, "first.pdf"), filepath.Join(dir, "second.pdf")}
+
+	textfieldValues := DocPrefills{0: {"bottom-box": name}}
+
+	for _, pdfOutputPath := range stages {
+
+		contents := SpreadContents{
+			SvgLayoutPath:     svgLayoutPath,
+			SpreadName:        "mark",
+			PreviousImagePath: "./test/a4-three-square.jpg",
+			PageNumber:        0,
+			PdfOutputPath:     pdfOutputPath,
+			PageData:          pd,
+			Prefills:          DocPrefills{0: {"top-box": pd.Current.Item.Who}},
+			TextFieldValues:   textfieldValues,
+		}
+
+		assert.NoError(t, RenderSpreadExtra(contents))
+
+		fonts := strings.Join(usedFonts(t, pdfOutputPath), " ")
+		assert.Contains(t, fonts, "DejaVuSansMono")
+
+		pds, err := pagedata.UnMarshalAllFromFile(pdfOutputPath)
+		assert.NoError(t, err)
+		assert.Equal(t, name, pds[1].Current.Item.Who)
+
+		fields, err := extract.ExtractTextFieldsFromPDF(pdfOutputPath)
+		assert.NoError(t, err)
+		assert.Equal(t, name, fields[0]["bottom-box"])
+
+		// what the next stage starts from, as in the overlay
+		pd = pds[1]
+		textfieldValues = DocPrefills{0: {"bottom-box": util.SafeText(fields[0]["bottom-box"])}}
+	}
+}
@@ -0,0 +1,89 @@
+package parsesvg
+
+import (
+	"fmt"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/gradex-cli/qr"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+// The identity code is a QR code with the page UUID, page number, anonymous
+// identity and exam (see pagedata.PageIdentity). A layout can place it with an
+// anchor called img-qr-<spread> and a box on the images layer called
+// qr-<spread>, in the same way as the previous-image. There is no default
+// position, because anywhere else on the spread could be over the previous
+// image, so a spread without the anchor and box does not get a code.
+
+const identityCodeQuiet = 2 // modules of white around the code
+
+func identityCodeRect(layout *Layout, spread Spread) (geo.Rect, bool) {
+
+	anchorName := fmt.Sprintf("img-qr-%s", spread.Name)
+	dimName := fmt.Sprintf("qr-%s", spread.Name)
+
+	corner, hasAnchor := layout.Anchors[anchorName]
+	dim, hasDim := layout.ImageDims[dimName]
+
+	if !hasAnchor || !hasDim {
+		return geo.Rect{}, false
+	}
+
+	if spread.Dim.DynamicWidth {
+		corner.X = corner.X + spread.ExtraWidth
+	}
+
+	// keep it square, so the modules are too
+	side := dim.Width
+	if dim.Height < side {
+		side = dim.Height
+	}
+
+	return geo.Rect{
+		Corner: corner,
+		Dim:    geo.Dim{Width: side, Height: side},
+	}, true
+}
+
+// drawIdentityCode draws the code as rectangles, rather than an image,
+// so that it stays sharp however the page is compressed or rasterised
+func drawIdentityCode(c *creator.Creator, rect geo.Rect, id pagedata.PageIdentity) error {
+
+	code, err := qr.Encode([]byte(id.String()), qr.M)
+	if err != nil {
+		return err
+	}
+
+	module := rect.Dim.Width / float64(code.Size+2*identityCodeQuiet)
+
+	background := c.NewRectangle(rect.Corner.X, rect.Corner.Y, rect.Dim.Width, rect.Dim.Width)
+	background.SetBorderWidth(0)
+	background.SetFillColor(creator.ColorWhite)
+	c.Draw(background)
+
+	x0 := rect.Corner.X + identityCodeQuiet*module
+	y0 := rect.Corner.Y + identityCodeQuiet*module
+
+	// one rectangle for each run of dark modules along a row
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+
+			if !code.Dark(x, y) {
+				continue
+			}
+
+			start := x
+			for x < code.Size && code.Dark(x, y) {
+				x++
+			}
+
+			r := c.NewRectangle(x0+float64(start)*module, y0+float64(y)*module, float64(x-start)*module, module)
+			r.SetBorderWidth(0)
+			r.SetFillColor(creator.ColorBlack)
+			c.Draw(r)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package parsesvg
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+func TestIdentityCodeRect(t *testing.T) {
+
+	layout := &Layout{
+		Anchors: map[string]geo.Point{
+			"img-qr-mark": {X: 70, Y: 790},
+		},
+		ImageDims: map[string]geo.Dim{
+			"qr-mark": {Width: 90, Height: 92},
+		},
+	}
+
+	mark := Spread{
+		Name:       "mark",
+		Dim:        geo.Dim{Width: 178, Height: 884, DynamicWidth: true},
+		ExtraWidth: 595,
+	}
+
+	// shifted past the previous image, and square
+	rect, ok := identityCodeRect(layout, mark)
+	assert.True(t, ok)
+	assert.Equal(t, geo.Rect{
+		Corner: geo.Point{X: 665, Y: 790},
+		Dim:    geo.Dim{Width: 90, Height: 90},
+	}, rect)
+
+	// no anchor, so no code, rather than one over the previous image
+	_, ok = identityCodeRect(layout, Spread{Name: "check", ExtraWidth: 595})
+	assert.False(t, ok)
+}
+
+func TestIdentityCodeShippedLayout(t *testing.T) {
+
+	for _, name := range []string{"layout.svg", "layout-q5.svg"} {
+
+		svgBytes, err := ioutil.ReadFile("../ingester/test-fs/etc/overlay/template/" + name)
+		assert.NoError(t, err)
+
+		layout, err := DefineLayoutFromSVG(svgBytes)
+		assert.NoError(t, err)
+
+		rect, ok := identityCodeRect(layout, Spread{Name: "mark", Dim: layout.PageDims["mark"]})
+		assert.True(t, ok, name)
+
+		// on the marking bar, not off the bottom of it
+		assert.True(t, rect.Corner.Y+rect.Dim.Height < layout.PageDims["mark"].Height, name)
+		assert.True(t, rect.Corner.X+rect.Dim.Width < layout.PageDims["mark"].Width, name)
+	}
+}
@@ -0,0 +1,480 @@
+package parsesvg
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/extract"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// Linting checks a layout, and the ladders it uses, for the mistakes that
+// would otherwise only show up when a spread is rendered (or worse, as boxes
+// in the wrong place). It reads the layout the same way RenderSpreadExtra
+// does, so each spread is matched by name in the same (loose) way, and files
+// are found relative to the layout, as they are for the stages.
+
+type LintProblem struct {
+	Spread  string
+	Element string
+	Problem string
+	Warning bool // the spread still renders, but maybe not as intended
+}
+
+func (p LintProblem) String() string {
+
+	level := "ERROR"
+	if p.Warning {
+		level = "WARNING"
+	}
+
+	where := p.Spread
+	if p.Element != "" {
+		where = where + "/" + p.Element
+	}
+
+	return fmt.Sprintf("%-7s %s: %s", level, where, p.Problem)
+}
+
+// LintSpread is a spread to check, and whether it shows the previous
+// image (as the bars do), or not (as the covers don't)
+type LintSpread struct {
+	Name     string
+	Previous bool
+}
+
+func CountLintErrors(problems []LintProblem) int {
+	count := 0
+	for _, p := range problems {
+		if !p.Warning {
+			count++
+		}
+	}
+	return count
+}
+
+// LintLayout checks each of the spreads in the layout, and returns
+// an error only if the layout itself can't be read. Files are looked for
+// in the template dirs, if any, before the folder the layout is in.
+func LintLayout(svgLayoutPath string, spreads []LintSpread, templateDirs ...string) ([]LintProblem, error) {
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return []LintProblem{}, err
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return []LintProblem{}, fmt.Errorf("can't get layout from %s: %v", svgLayoutPath, err)
+	}
+
+	problems := []LintProblem{}
+
+	dirs := searchDirs(svgLayoutPath, templateDirs)
+
+	for _, spread := range spreads {
+
+		problems = append(problems, lintSpread(layout, dirs, spread)...)
+
+		// variants for landscape and A3 pages are used in the same way
+		for _, variant := range spreadVariantsIn(layout, spread.Name) {
+			problems = append(problems, lintSpread(layout, dirs, LintSpread{Name: variant, Previous: spread.Previous})...)
+		}
+	}
+
+	return problems, nil
+}
+
+// spreadKeys finds the keys for the spread, the same way RenderSpreadExtra does
+func spreadKeys(keys []string, spreadName string) []string {
+	found := []string{}
+	for _, k := range keys {
+		if inSpread(k, spreadName) {
+			found = append(found, k)
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+func lintSpread(layout *Layout, dirs []string, spread LintSpread) []LintProblem {
+
+	spreadName := spread.Name
+
+	problems := []LintProblem{}
+
+	add := func(element string, warning bool, format string, a ...interface{}) {
+		problems = append(problems, LintProblem{
+			Spread:  spreadName,
+			Element: element,
+			Problem: fmt.Sprintf(format, a...),
+			Warning: warning,
+		})
+	}
+
+	pageNames := []string{}
+	for k := range layout.PageDims {
+		pageNames = append(pageNames, k)
+	}
+
+	pages := spreadKeys(pageNames, spreadName)
+
+	switch len(pages) {
+	case 0:
+		add("", false, "no page size (add a box called page-%s on the %s layer)", spreadName, geo.PagesLayer)
+		return problems
+	case 1:
+	default:
+		add("", true, "page size is ambiguous, because pages %s all match, so any of them might be used", strings.Join(pages, ", "))
+	}
+
+	if font, ok := layout.Fonts[pages[0]]; ok {
+		if err := checkFont(dirs, font); err != nil {
+			add("page-"+pages[0], false, "font: %v", err)
+		}
+	}
+
+	if spread.Previous {
+
+		// a static page needs the size to scale the previous image to, else it is drawn with no height
+		if _, ok := layout.ImageDims["previous-"+spreadName]; !ok && !layout.PageDims[pages[0]].DynamicWidth {
+			add("previous-"+spreadName, false, "no size for the previous image on a static page (add a box called image-previous-%s on the %s layer)", spreadName, geo.ImagesLayer)
+		}
+
+		if _, ok := layout.Anchors["img-previous-"+spreadName]; !ok {
+			add("img-previous-"+spreadName, true, "no anchor for the previous image, so it goes in the top left corner")
+		}
+	}
+
+	elementNames := []string{}
+	for k := range layout.Filenames {
+		elementNames = append(elementNames, k)
+	}
+
+	fields := []string{}
+	prefills := []string{}
+
+	for _, element := range spreadKeys(elementNames, spreadName) {
+
+		ladderFile, chromeFile := chromeFilenames(layout.Filenames[element])
+		ladderFile = findTemplateFile(dirs, ladderFile)
+		chromeFile = findTemplateFile(dirs, chromeFile)
+
+		if !strings.HasPrefix(element, geo.SVGElement) {
+
+			if _, ok := layout.ImageDims[element]; !ok {
+				add(element, false, "no size for image (add a box called image-%s on the %s layer)", element, geo.ImagesLayer)
+			}
+
+			if !fileExists(chromeFile) {
+				add(element, false, "image file %s not found", chromeFile)
+			}
+
+			continue
+		}
+
+		if !fileExists(chromeFile) {
+			add(element, false, "chrome file %s not found", chromeFile)
+		}
+
+		svgBytes, err := ioutil.ReadFile(ladderFile)
+		if err != nil {
+			add(element, false, "ladder file %s not found", ladderFile)
+			continue
+		}
+
+		ladderProblems := lintLadder(svgBytes)
+
+		for _, p := range ladderProblems {
+			add(element, p.Warning, "%s", p.Problem)
+		}
+
+		ladder, err := DefineLadderFromSVG(svgBytes)
+		if err != nil {
+			if CountLintErrors(ladderProblems) == 0 {
+				add(element, false, "can't get ladder from %s: %v", ladderFile, err)
+			}
+			continue
+		}
+
+		for _, tf := range ladder.TextFields {
+			fields = append(fields, tf.ID)
+		}
+		for _, cb := range ladder.ComboBoxes {
+			fields = append(fields, cb.ID)
+		}
+		for _, cb := range ladder.CheckBoxes {
+			fields = append(fields, cb.ID)
+		}
+		for _, rg := range ladder.RadioGroups {
+			fields = append(fields, rg.ID)
+		}
+		for _, tp := range ladder.TextPrefills {
+			prefills = append(prefills, tp.ID)
+			if tp.Text.TextFont != "" {
+				if err := checkFont(dirs, tp.Text.TextFont); err != nil {
+					add(element, false, "textprefill %s font: %v", tp.ID, err)
+				}
+			}
+		}
+	}
+
+	// textfields, comboboxes and buttons share names in the form, prefills don't go in it
+	for _, p := range lintIDs(fields) {
+		add("", p.Warning, "textfield %s", p.Problem)
+	}
+	for _, p := range lintIDs(prefills) {
+		add("", p.Warning, "textprefill %s", p.Problem)
+	}
+
+	return problems
+}
+
+// lintLadder checks each element of a ladder, which DefineLadderFromSVG
+// would stop at the first problem with, or not notice
+func lintLadder(svgBytes []byte) []LintProblem {
+
+	problems := []LintProblem{}
+
+	var svg Csvg__svg
+
+	err := xml.Unmarshal(svgBytes, &svg)
+	if err != nil {
+		return []LintProblem{{Problem: fmt.Sprintf("can't read svg: %v", err)}}
+	}
+
+	for _, g := range svg.Cg__svg {
+
+		label := g.AttrInkscapeSpacelabel
+
+		switch label {
+		case geo.TextFieldsLayer, geo.TextPrefillsLayer, geo.ComboBoxesLayer, geo.CheckBoxesLayer, geo.RadioButtonsLayer:
+		default:
+			continue
+		}
+
+		// boxes can be in groups inside the layer, and each must be placed without rotating it
+		rects := []*Crect__svg{}
+
+		err := walkGroup(g, identity(), func(sub *Cg__svg, t transform) error {
+			for _, r := range sub.Crect__svg {
+				rects = append(rects, r)
+				_, err := placeRect(r, t)
+				if err != nil {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("%s layer: %v", label, err),
+					})
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			problems = append(problems, LintProblem{Problem: err.Error()})
+		}
+
+		switch label {
+
+		case geo.TextFieldsLayer:
+
+			tabs := make(map[int64][]string)
+
+			for _, r := range rects {
+
+				id := rectTitle(r)
+				tab := getTabSequence(r)
+
+				if tab == 0 && !strings.Contains(strings.ToLower(r.Id), "tab") {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("textfield %s has no tab sequence (give the box an id ending in -tabNN)", id),
+						Warning: true,
+					})
+					continue
+				}
+
+				tabs[tab] = append(tabs[tab], id)
+			}
+
+			for tab, ids := range tabs {
+				if len(ids) > 1 {
+					sort.Strings(ids)
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("textfields %s have the same tab sequence %d", strings.Join(ids, ", "), tab),
+						Warning: true,
+					})
+				}
+			}
+
+		case geo.TextPrefillsLayer:
+
+			for _, r := range rects {
+
+				tp := TextPrefill{ID: rectTitle(r)}
+				if r.Desc != nil {
+					tp.Properties = r.Desc.String
+				}
+
+				err := UnmarshalTextPrefill(&tp)
+				if err != nil {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("textprefill %s description is not valid JSON: %v", tp.ID, err),
+					})
+					continue
+				}
+
+				err = checkPrefillText(tp.ID, tp.Text.Text)
+				if err != nil {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("textprefill %s has a bad template expression: %v", tp.ID, err),
+					})
+				}
+			}
+
+		case geo.ComboBoxesLayer:
+
+			for _, r := range rects {
+
+				cb := ComboBox{ID: rectTitle(r)}
+				if r.Desc != nil {
+					cb.Properties = r.Desc.String
+				}
+
+				err := UnmarshalComboBox(&cb)
+				if err != nil {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("combobox %s description is not valid JSON: %v", cb.ID, err),
+					})
+					continue
+				}
+
+				if len(cb.Options.Options) == 0 {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("combobox %s has no options", cb.ID),
+						Warning: true,
+					})
+				}
+			}
+
+		case geo.RadioButtonsLayer:
+
+			options := make(map[string][]string)
+
+			for _, r := range rects {
+
+				id := rectTitle(r)
+				option := ""
+				if r.Desc != nil {
+					option = r.Desc.String
+				}
+
+				if option == "" {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("radio button in group %s has no option (put it in the description)", id),
+					})
+					continue
+				}
+
+				if strings.Contains(option, extract.RadioOptionSeparator) {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("radio button %s in group %s has %s in its option", option, id, extract.RadioOptionSeparator),
+					})
+				}
+
+				options[id] = append(options[id], option)
+			}
+
+			for id, group := range options {
+
+				count := make(map[string]int)
+				for _, option := range group {
+					count[option]++
+				}
+
+				for option, n := range count {
+					if n > 1 {
+						problems = append(problems, LintProblem{
+							Problem: fmt.Sprintf("radio group %s has %d buttons for option %s", id, n, option),
+						})
+					}
+				}
+
+				if len(group) < 2 {
+					problems = append(problems, LintProblem{
+						Problem: fmt.Sprintf("radio group %s has only one button (use a checkbox instead)", id),
+						Warning: true,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Problem < problems[j].Problem
+	})
+
+	return problems
+}
+
+// lintIDs finds missing and duplicate IDs, and IDs that would be matched
+// by another, shorter, one, e.g. q1 and q10 (but not q1 and q1-mark)
+func lintIDs(ids []string) []LintProblem {
+
+	problems := []LintProblem{}
+
+	count := make(map[string]int)
+	for _, id := range ids {
+		count[id]++
+	}
+
+	unique := []string{}
+	for id := range count {
+		unique = append(unique, id)
+	}
+	sort.Strings(unique)
+
+	for _, id := range unique {
+
+		if id == "" {
+			problems = append(problems, LintProblem{
+				Problem: fmt.Sprintf("without a title (%d of them)", count[id]),
+			})
+			continue
+		}
+
+		if count[id] > 1 {
+			problems = append(problems, LintProblem{
+				Problem: fmt.Sprintf("%s is used %d times", id, count[id]),
+			})
+		}
+
+		for _, other := range unique {
+			if other == id || other == "" || !strings.HasPrefix(other, id) {
+				continue
+			}
+			if next := other[len(id)]; next == '-' || next == '_' {
+				continue
+			}
+			problems = append(problems, LintProblem{
+				Problem: fmt.Sprintf("%s is a prefix of %s", id, other),
+				Warning: true,
+			})
+		}
+	}
+
+	return problems
+}
+
+func rectTitle(r *Crect__svg) string {
+	if r.Title != nil {
+		return r.Title.String
+	}
+	return r.Id
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
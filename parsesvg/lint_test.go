@@ -0,0 +1,173 @@
+package parsesvg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lintTestLayout = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="200pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="pages">
+<rect width="200" height="100" x="0" y="0"><title>page-static-mark</title></rect>
+<rect width="200" height="100" x="0" y="0"><title>page-dynamic-check</title></rect>
+<rect width="200" height="100" x="0" y="0"><title>page-dynamic-check-extra</title></rect>
+</g>
+<g inkscape:label="images">
+<rect width="100" height="100" x="0" y="0"><title>image-static-previous-mark</title></rect>
+</g>
+<g inkscape:label="anchors">
+<path sodipodi:cx="0" sodipodi:cy="0"><title>img-previous-mark</title></path>
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-mark-ladder</title><desc>ladder</desc></path>
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-check-ladder</title><desc>missing</desc></path>
+</g>
+</svg>`
+
+const lintTestLadder = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="textfields">
+<rect id="r1-tab01" width="10" height="10" x="0" y="0"><title>q1</title></rect>
+<rect id="r2-tab02" width="10" height="10" x="0" y="0"><title>q10</title></rect>
+<rect id="r3-tab02" width="10" height="10" x="0" y="0"><title>q1-mark</title></rect>
+<rect id="r4" width="10" height="10" x="0" y="0"><title>q1</title></rect>
+</g>
+<g inkscape:label="comboboxes">
+<rect id="r5" width="10" height="10" x="0" y="0"><title>choice</title><desc>{"options":["A",</desc></rect>
+</g>
+</svg>`
+
+func TestLintLayout(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-lint")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	layoutPath := filepath.Join(dir, "layout.svg")
+
+	assert.NoError(t, ioutil.WriteFile(layoutPath, []byte(lintTestLayout), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ladder.svg"), []byte(lintTestLadder), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ladder.jpg"), []byte{}, 0644))
+
+	problems, err := LintLayout(layoutPath, []LintSpread{
+		{Name: "mark", Previous: true},
+		{Name: "check", Previous: true},
+		{Name: "label", Previous: true},
+	})
+	assert.NoError(t, err)
+
+	found := []string{}
+	for _, p := range problems {
+		found = append(found, p.String())
+	}
+	all := strings.Join(found, "\n")
+
+	for _, want := range []string{
+		"WARNING mark/svg-mark-ladder: textfield q1 has no tab sequence",
+		"WARNING mark/svg-mark-ladder: textfields q1-mark, q10 have the same tab sequence 2",
+		"ERROR   mark/svg-mark-ladder: combobox choice description is not valid JSON",
+		"WARNING check: page size is ambiguous, because pages check, check-extra all match",
+		"WARNING check/img-previous-check: no anchor for the previous image",
+		"ERROR   check/svg-check-ladder: ladder file " + filepath.Join(dir, "missing") + ".svg not found",
+		"ERROR   check/svg-check-ladder: chrome file " + filepath.Join(dir, "missing") + ".jpg not found",
+		"ERROR   label: no page size",
+	} {
+		assert.Contains(t, all, want)
+	}
+
+	// the bad combobox stops the ladder being read, so we don't get this far
+	assert.NotContains(t, all, "can't get ladder")
+	assert.NotContains(t, all, "mark/img-previous-mark")
+	assert.NotContains(t, all, "mark/previous-mark")
+	assert.NotContains(t, all, "check/previous-check") //dynamic page
+
+	assert.Equal(t, 4, CountLintErrors(problems))
+
+	// covers have no previous image
+	problems, err = LintLayout(layoutPath, []LintSpread{{Name: "check"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, CountLintErrors(problems))
+	assert.Equal(t, 3, len(problems))
+
+	_, err = LintLayout(filepath.Join(dir, "nothere.svg"), []LintSpread{{Name: "mark"}})
+	assert.Error(t, err)
+}
+
+func TestLintIDs(t *testing.T) {
+
+	problems := lintIDs([]string{"q1", "q10", "q1-mark", "q1_number", "q2", "q2", ""})
+
+	found := []string{}
+	for _, p := range problems {
+		found = append(found, p.Problem)
+	}
+
+	assert.Equal(t, []string{
+		"without a title (1 of them)",
+		"q1 is a prefix of q10",
+		"q2 is used 2 times",
+	}, found)
+
+	assert.True(t, problems[1].Warning)
+	assert.False(t, problems[2].Warning)
+}
+
+func TestLintRadioButtons(t *testing.T) {
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape">
+<g inkscape:label="radiobuttons">
+<rect x="0" y="0" width="8" height="8"><title>page</title><desc>ok</desc></rect>
+<rect x="0" y="10" width="8" height="8"><title>page</title><desc>ok</desc></rect>
+<rect x="0" y="20" width="8" height="8"><title>page</title></rect>
+<rect x="0" y="30" width="8" height="8"><title>q1</title><desc>a=b</desc></rect>
+</g>
+</svg>`
+
+	problems := lintLadder([]byte(svg))
+
+	found := []string{}
+	for _, p := range problems {
+		found = append(found, p.Problem)
+	}
+
+	assert.Equal(t, []string{
+		"radio button a=b in group q1 has = in its option",
+		"radio button in group page has no option (put it in the description)",
+		"radio group page has 2 buttons for option ok",
+		"radio group q1 has only one button (use a checkbox instead)",
+	}, found)
+
+	assert.Equal(t, 3, CountLintErrors(problems))
+}
+
+func TestLintRotatedFields(t *testing.T) {
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape">
+<g inkscape:label="checkboxes">
+<g transform="rotate(45)">
+<rect x="0" y="0" width="8" height="8"><title>q1-attempted</title></rect>
+</g>
+<g transform="rotate(90)">
+<rect x="0" y="10" width="8" height="8"><title>q2-attempted</title></rect>
+</g>
+<rect x="0" y="20" width="8" height="8" transform="skewX(20)"><title>q3-attempted</title></rect>
+</g>
+</svg>`
+
+	problems := lintLadder([]byte(svg))
+
+	found := []string{}
+	for _, p := range problems {
+		found = append(found, p.Problem)
+	}
+
+	assert.Equal(t, []string{
+		"checkboxes layer: rect q1-attempted is rotated by 45.0 degrees, which can't be represented by an axis-aligned form field (rotate it back, or by a multiple of 90 degrees)",
+		"checkboxes layer: rect q3-attempted is skewed, which can't be represented by an axis-aligned form field",
+	}, found)
+}
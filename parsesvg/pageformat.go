@@ -0,0 +1,210 @@
+package parsesvg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// Most pages are A4 portrait, but scripts also have landscape pages, and A3
+// pages (often two A4 pages scanned side by side). A dynamic width spread
+// scales every page to the height of the spread, so a landscape page comes
+// out small, and an A3 page makes the sidebar look tiny next to it.
+//
+// A layout can have a variant of a spread for each kind of page, named by
+// adding the paper and orientation to the spread name, e.g. flatten-landscape,
+// flatten-a3, or flatten-a3-landscape, with page-flatten-landscape,
+// svg-flatten-landscape-header and so on. Pages that have no variant use the
+// plain spread, scaled to fit, as before. An A3 landscape page uses the
+// landscape variant if there is no A3 landscape one.
+
+const (
+	PaperA4   = "a4"
+	PaperA3   = "a3"
+	Portrait  = "portrait"
+	Landscape = "landscape"
+)
+
+// the variants a spread name can end in, longest first, so that
+// flatten-a3-landscape isn't mistaken for flatten-a3
+var spreadVariants = []string{PaperA3 + "-" + Landscape, PaperA3, Landscape}
+
+// paper sizes in points, short side first
+var paperSizes = map[string][2]float64{
+	PaperA4: {595.28, 841.89},
+	PaperA3: {841.89, 1190.55},
+}
+
+// how far off a page can be, and still count as the paper size,
+// because scanners don't always crop exactly
+const paperTolerance = 0.03
+
+// PageFormat is the paper size and orientation of a page
+type PageFormat struct {
+	Paper     string // PaperA4, PaperA3, or empty if neither
+	Landscape bool
+}
+
+// GetPageFormat gets the format of the page as it is shown, i.e. after
+// any rotation
+func GetPageFormat(page *model.PdfPage) (PageFormat, error) {
+
+	width, height, err := previousPageSize(page)
+	if err != nil {
+		return PageFormat{}, err
+	}
+
+	return PageFormatFromSize(width, height), nil
+}
+
+// PageFormatFromSize gets the format of a page of the given size, in points
+func PageFormatFromSize(width, height float64) PageFormat {
+
+	format := PageFormat{Landscape: width > height}
+
+	short, long := math.Min(width, height), math.Max(width, height)
+
+	for paper, size := range paperSizes {
+		if math.Abs(short-size[0]) <= paperTolerance*size[0] &&
+			math.Abs(long-size[1]) <= paperTolerance*size[1] {
+			format.Paper = paper
+		}
+	}
+
+	return format
+}
+
+// String is for pagedata and logs, e.g. a4-portrait, or landscape if the
+// paper size is not one we know
+func (f PageFormat) String() string {
+
+	orientation := Portrait
+	if f.Landscape {
+		orientation = Landscape
+	}
+
+	if f.Paper == "" {
+		return orientation
+	}
+
+	return f.Paper + "-" + orientation
+}
+
+// ParsePageFormat reads a format written by String, e.g. from pagedata
+func ParsePageFormat(text string) (PageFormat, error) {
+
+	format := PageFormat{}
+
+	orientation := text
+
+	for _, paper := range []string{PaperA4, PaperA3} {
+		if strings.HasPrefix(text, paper+"-") {
+			format.Paper = paper
+			orientation = strings.TrimPrefix(text, paper+"-")
+		}
+	}
+
+	switch orientation {
+	case Portrait:
+	case Landscape:
+		format.Landscape = true
+	default:
+		return PageFormat{}, fmt.Errorf("unknown page format %s", text)
+	}
+
+	return format, nil
+}
+
+// IsA3Landscape is true for a page that could be two A4 pages side by side
+func (f PageFormat) IsA3Landscape() bool {
+	return f.Paper == PaperA3 && f.Landscape
+}
+
+// variants are the spread variants that suit the page, best first
+func (f PageFormat) variants() []string {
+
+	switch {
+	case f.IsA3Landscape():
+		return []string{PaperA3 + "-" + Landscape, Landscape}
+	case f.Paper == PaperA3:
+		return []string{PaperA3}
+	case f.Landscape:
+		return []string{Landscape}
+	}
+
+	return []string{}
+}
+
+// inSpread is true if the layout element belongs to the spread, and not to
+// one of its variants, e.g. page-mark-landscape is not in the mark spread
+func inSpread(key, spreadName string) bool {
+
+	if !strings.Contains(key, spreadName) {
+		return false
+	}
+
+	for _, v := range spreadVariants {
+		if strings.Contains(key, spreadName+"-"+v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasSpread is true if the layout has a page size for the spread
+func hasSpread(layout *Layout, spreadName string) bool {
+	for k := range layout.PageDims {
+		if inSpread(k, spreadName) {
+			return true
+		}
+	}
+	return false
+}
+
+// chooseSpread returns the best variant of the spread for the page,
+// or the spread itself if the layout has none that suit
+func chooseSpread(layout *Layout, spreadName string, format PageFormat) string {
+
+	for _, v := range format.variants() {
+		if variant := spreadName + "-" + v; hasSpread(layout, variant) {
+			return variant
+		}
+	}
+
+	return spreadName
+}
+
+// spreadVariantsIn returns the variants of the spread the layout has
+func spreadVariantsIn(layout *Layout, spreadName string) []string {
+
+	found := []string{}
+
+	for _, v := range spreadVariants {
+		if variant := spreadName + "-" + v; hasSpread(layout, variant) {
+			found = append(found, variant)
+		}
+	}
+
+	return found
+}
+
+// GetSpreadForPage returns the variant of the spread in the layout that
+// suits a page of the given format, or the spread itself if there isn't one
+func GetSpreadForPage(svgLayoutPath, spreadName string, format PageFormat) (string, error) {
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return spreadName, err
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return spreadName, fmt.Errorf("can't get layout from %s: %v", svgLayoutPath, err)
+	}
+
+	return chooseSpread(layout, spreadName, format), nil
+}
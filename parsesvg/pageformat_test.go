@@ -0,0 +1,136 @@
+package parsesvg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+func TestPageFormat(t *testing.T) {
+
+	for _, tc := range []struct {
+		width, height float64
+		want          string
+	}{
+		{595, 842, "a4-portrait"},
+		{842, 595, "a4-landscape"},
+		{590, 850, "a4-portrait"}, // a scan that isn't quite A4
+		{842, 1191, "a3-portrait"},
+		{1191, 842, "a3-landscape"},
+		{612, 792, "portrait"}, // US letter
+		{1000, 600, "landscape"},
+	} {
+		assert.Equal(t, tc.want, PageFormatFromSize(tc.width, tc.height).String(), fmt.Sprintf("%v x %v", tc.width, tc.height))
+
+		format, err := ParsePageFormat(tc.want)
+		assert.NoError(t, err)
+		assert.Equal(t, PageFormatFromSize(tc.width, tc.height), format, tc.want)
+	}
+
+	for _, bad := range []string{"", "a4", "a5-portrait", "a4-upside-down"} {
+		_, err := ParsePageFormat(bad)
+		assert.Error(t, err, bad)
+	}
+
+	assert.True(t, PageFormatFromSize(1191, 842).IsA3Landscape())
+	assert.False(t, PageFormatFromSize(842, 1191).IsA3Landscape())
+	assert.False(t, PageFormatFromSize(842, 595).IsA3Landscape())
+
+	assert.True(t, inSpread("svg-mark-ladder", "mark"))
+	assert.False(t, inSpread("svg-mark-landscape-ladder", "mark"))
+	assert.True(t, inSpread("svg-mark-landscape-ladder", "mark-landscape"))
+	assert.False(t, inSpread("page-mark-a3-landscape", "mark-a3"))
+	assert.False(t, inSpread("page-check", "mark"))
+}
+
+const pageFormatLayout = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="200pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="pages">
+<rect width="200" height="100" x="0" y="0"><title>page-dynamic-mark</title></rect>
+<rect width="200" height="80" x="0" y="0"><title>page-dynamic-mark-landscape</title></rect>
+</g>
+<g inkscape:label="anchors">
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-mark-ladder</title><desc>mark.svg</desc></path>
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-mark-landscape-ladder</title><desc>mark-landscape.svg</desc></path>
+</g>
+</svg>`
+
+func TestSpreadForPage(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-pageformat")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	layoutPath := filepath.Join(dir, "layout.svg")
+
+	assert.NoError(t, ioutil.WriteFile(layoutPath, []byte(pageFormatLayout), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "mark.svg"), []byte(fmt.Sprintf(templateDirsLadder, "")), 0644))
+
+	for _, tc := range []struct {
+		format PageFormat
+		want   string
+	}{
+		{PageFormat{Paper: PaperA4}, "mark"},
+		{PageFormat{Paper: PaperA4, Landscape: true}, "mark-landscape"},
+		{PageFormat{Landscape: true}, "mark-landscape"},
+		{PageFormat{Paper: PaperA3}, "mark"},
+		{PageFormat{Paper: PaperA3, Landscape: true}, "mark-landscape"},
+	} {
+		got, err := GetSpreadForPage(layoutPath, "mark", tc.format)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.format.String())
+	}
+
+	// the landscape ladder is not part of the mark spread
+	spread, err := GetTextFieldSpread(layoutPath, "mark")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(spread.Ladders))
+	assert.Equal(t, float64(100), spread.Dim.Height)
+
+	// and variants are linted along with the spread (its ladder is missing)
+	problems, err := LintLayout(layoutPath, []LintSpread{{Name: "mark"}})
+	assert.NoError(t, err)
+
+	variantErrors := 0
+	for _, p := range problems {
+		if !p.Warning {
+			assert.Equal(t, "mark-landscape", p.Spread, p.String())
+			variantErrors++
+		}
+	}
+	assert.Equal(t, 2, variantErrors)
+}
+
+func TestSplitPreviousPage(t *testing.T) {
+
+	page := model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 1190, Ury: 842}
+
+	left, right, err := SplitPreviousPage(page)
+	assert.NoError(t, err)
+	assert.Equal(t, model.PdfRectangle{Llx: 0, Lly: 0, Urx: 595, Ury: 842}, *left.MediaBox)
+	assert.Equal(t, model.PdfRectangle{Llx: 595, Lly: 0, Urx: 1190, Ury: 842}, *right.MediaBox)
+	assert.Equal(t, float64(1190), page.MediaBox.Urx)
+
+	// a portrait page turned to show landscape is split across its height
+	rotate := int64(90)
+	page = model.NewPdfPage()
+	page.MediaBox = &model.PdfRectangle{Llx: 0, Lly: 0, Urx: 842, Ury: 1190}
+	page.Rotate = &rotate
+
+	left, right, err = SplitPreviousPage(page)
+	assert.NoError(t, err)
+	assert.Equal(t, model.PdfRectangle{Llx: 0, Lly: 0, Urx: 842, Ury: 595}, *left.MediaBox)
+	assert.Equal(t, model.PdfRectangle{Llx: 0, Lly: 595, Urx: 842, Ury: 1190}, *right.MediaBox)
+
+	width, height, err := previousPageSize(left)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(595), width)
+	assert.Equal(t, float64(842), height)
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -44,133 +43,118 @@ func DefineLayoutFromSVG(input []byte) (*Layout, error) {
 	// look for reference & header/ladder anchor positions
 	// these also contain the base filename in the description
 	for _, g := range svg.Cg__svg {
-		// get transform applied to layer, if any
 		if g.AttrInkscapeSpacelabel == geo.AnchorsLayer {
-			gdx, gdy := getTranslate(g.Transform)
-
 			layout.Anchors = make(map[string]geo.Point)
 			layout.Filenames = make(map[string]string)
+		}
+	}
 
-			for _, r := range g.Cpath__svg {
-				x, err := strconv.ParseFloat(r.Cx, 64)
-				if err != nil {
-					return nil, err
-				}
-				y, err := strconv.ParseFloat(r.Cy, 64)
-				if err != nil {
-					return nil, err
-				}
-
-				rdx, rdy := getTranslate(r.Transform)
-
-				newX := x + gdx + rdx
-				newY := y + gdy + rdy
+	err = walkLayerPaths(&svg, geo.AnchorsLayer, func(r *Cpath__svg, pos geo.Point) error {
 
-				if r.Title != nil {
-					if r.Title.String == geo.AnchorReference {
+		if r.Title != nil {
+			if r.Title.String == geo.AnchorReference {
 
-						layout.Anchor = geo.Point{X: newX, Y: newY}
-					} else {
+				layout.Anchor = pos
+			} else {
 
-						layout.Anchors[r.Title.String] = geo.Point{X: newX, Y: newY}
+				layout.Anchors[r.Title.String] = pos
 
-						if r.Desc != nil {
-							layout.Filenames[r.Title.String] = r.Desc.String
-						}
-					}
-				} else {
-					log.Errorf("Anchor at (%f,%f) has no title, so ignoring\n", newX, newY)
+				if r.Desc != nil {
+					layout.Filenames[r.Title.String] = r.Desc.String
 				}
 			}
+		} else {
+			log.Errorf("Anchor at (%f,%f) has no title, so ignoring\n", pos.X, pos.Y)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// look for pageDims
+	// look for pageDims, and the font for the page, if any, in the description
 	layout.PageDims = make(map[string]geo.Dim)
-	for _, g := range svg.Cg__svg {
+	layout.Fonts = make(map[string]string)
 
-		if g.AttrInkscapeSpacelabel == geo.PagesLayer {
-			for _, r := range g.Crect__svg {
-				w, err := strconv.ParseFloat(r.Width, 64)
-				if err != nil {
-					return nil, err
-				}
-				h, err := strconv.ParseFloat(r.Height, 64)
-				if err != nil {
-					return nil, err
-				}
+	err = walkLayerRects(&svg, geo.PagesLayer, func(r *Crect__svg, rect geo.Rect) error {
 
-				if r.Title != nil { //avoid seg fault, obvs
-
-					fullname := r.Title.String
-					name := ""
-					isDynamic := false
-
-					switch {
-					case strings.HasPrefix(fullname, "page-dynamic-"):
-						name = strings.TrimPrefix(fullname, "page-dynamic-")
-						isDynamic = true
-					case strings.HasPrefix(fullname, "page-static-"):
-						name = strings.TrimPrefix(fullname, "page-static-")
-					default:
-						// unadorned pages are considered static
-						// because this is the least surprising behaviour
-						name = strings.TrimPrefix(fullname, "page-")
-					}
-
-					if name != "" { //reject anonymous pages
-						layout.PageDims[name] = geo.Dim{Width: w, Height: h, DynamicWidth: isDynamic}
-					}
-
-				} else {
-					log.Errorf("Page at with size (%f,%f) has no title, so ignoring\n", w, h)
+		w := rect.Dim.Width
+		h := rect.Dim.Height
+
+		if r.Title != nil { //avoid seg fault, obvs
+
+			fullname := r.Title.String
+			name := ""
+			isDynamic := false
+
+			switch {
+			case strings.HasPrefix(fullname, "page-dynamic-"):
+				name = strings.TrimPrefix(fullname, "page-dynamic-")
+				isDynamic = true
+			case strings.HasPrefix(fullname, "page-static-"):
+				name = strings.TrimPrefix(fullname, "page-static-")
+			default:
+				// unadorned pages are considered static
+				// because this is the least surprising behaviour
+				name = strings.TrimPrefix(fullname, "page-")
+			}
+
+			if name != "" { //reject anonymous pages
+				layout.PageDims[name] = geo.Dim{Width: w, Height: h, DynamicWidth: isDynamic}
+
+				if r.Desc != nil && strings.TrimSpace(r.Desc.String) != "" {
+					layout.Fonts[name] = strings.TrimSpace(r.Desc.String)
 				}
 			}
+
+		} else {
+			log.Errorf("Page at with size (%f,%f) has no title, so ignoring\n", w, h)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	// look for previousImageDims
 	layout.ImageDims = make(map[string]geo.Dim)
-	for _, g := range svg.Cg__svg {
-		if g.AttrInkscapeSpacelabel == geo.ImagesLayer {
-			for _, r := range g.Crect__svg {
-				w, err := strconv.ParseFloat(r.Width, 64)
-				if err != nil {
-					return nil, err
-				}
-				h, err := strconv.ParseFloat(r.Height, 64)
-				if err != nil {
-					return nil, err
-				}
 
-				if r.Title != nil { //avoid seg fault, obvs
-
-					fullname := r.Title.String
-					name := ""
-					isDynamic := false
-
-					switch {
-					case strings.HasPrefix(fullname, "image-dynamic-"):
-						name = strings.TrimPrefix(fullname, "image-dynamic-")
-						name = strings.TrimPrefix(name, "width-")  //we may want this later, so leave in API
-						name = strings.TrimPrefix(name, "height-") //getting info from box size for now
-						isDynamic = true
-					case strings.HasPrefix(fullname, "image-static-"):
-						name = strings.TrimPrefix(fullname, "image-static-")
-					default:
-						// we're just trying to strip off prefixes,
-						// not prevent underadorned names from working
-						name = strings.TrimPrefix(fullname, "image-")
-					}
-
-					if name != "" { //reject anonymous images - can't place them
-						layout.ImageDims[name] = geo.Dim{Width: w, Height: h, DynamicWidth: isDynamic}
-					}
-
-				} else {
-					log.Errorf("Page at with size (%f,%f) has no title, so ignoring\n", w, h)
-				}
+	err = walkLayerRects(&svg, geo.ImagesLayer, func(r *Crect__svg, rect geo.Rect) error {
+
+		w := rect.Dim.Width
+		h := rect.Dim.Height
+
+		if r.Title != nil { //avoid seg fault, obvs
+
+			fullname := r.Title.String
+			name := ""
+			isDynamic := false
+
+			switch {
+			case strings.HasPrefix(fullname, "image-dynamic-"):
+				name = strings.TrimPrefix(fullname, "image-dynamic-")
+				name = strings.TrimPrefix(name, "width-")  //we may want this later, so leave in API
+				name = strings.TrimPrefix(name, "height-") //getting info from box size for now
+				isDynamic = true
+			case strings.HasPrefix(fullname, "image-static-"):
+				name = strings.TrimPrefix(fullname, "image-static-")
+			default:
+				// we're just trying to strip off prefixes,
+				// not prevent underadorned names from working
+				name = strings.TrimPrefix(fullname, "image-")
+			}
+
+			if name != "" { //reject anonymous images - can't place them
+				layout.ImageDims[name] = geo.Dim{Width: w, Height: h, DynamicWidth: isDynamic}
 			}
+
+		} else {
+			log.Errorf("Page at with size (%f,%f) has no title, so ignoring\n", w, h)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	err = ApplyDocumentUnitsScaleLayout(&svg, layout)
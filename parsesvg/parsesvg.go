@@ -23,43 +23,6 @@ func ParseSvg(input []byte) *Csvg__svg {
 	return &svg
 }
 
-// return the translation applied to the SVG object
-// does not mangle the Y dimension
-func getTranslate(transform string) (float64, float64) {
-
-	if len(transform) <= 0 {
-		return 0.0, 0.0
-	}
-
-	if !strings.Contains(transform, geo.Translate) {
-		return 0.0, 0.0
-	}
-
-	openBracket := strings.Index(transform, "(")
-	comma := strings.Index(transform, ",")
-	closeBracket := strings.Index(transform, ")")
-
-	if openBracket < 0 || comma < 0 || closeBracket < 0 {
-		return 0.0, 0.0
-	}
-
-	if openBracket == comma || comma == closeBracket {
-		return 0.0, 0.0
-	}
-
-	dx, err := strconv.ParseFloat(transform[openBracket+1:comma], 64)
-	if err != nil {
-		return 0.0, 0.0
-	}
-	dy, err := strconv.ParseFloat(transform[comma+1:closeBracket], 64)
-	if err != nil {
-		return 0.0, 0.0
-	}
-
-	return dx, dy
-
-}
-
 func scanUnitStringToPP(str string) (float64, error) {
 
 	str = strings.TrimSpace(str)
@@ -133,81 +96,40 @@ func DefineLadderFromSVG(input []byte) (*Ladder, error) {
 	}
 
 	ladder.Dim = ladderDim
-	// Note to future self - had a global dx, dy here
-	// but doing translate parsing properly should avoid need for that...
+
 	// look for reference anchor position
-	for _, g := range svg.Cg__svg {
-
-		if g.AttrInkscapeSpacelabel == geo.AnchorsLayer {
-
-			gdx, gdy := getTranslate(g.Transform)
-
-			for _, r := range g.Cpath__svg {
-				if r.Title != nil {
-					if r.Title.String == geo.AnchorReference { // was force true?
-						x, err := strconv.ParseFloat(r.Cx, 64)
-						if err != nil {
-							return nil, err
-						}
-						y, err := strconv.ParseFloat(r.Cy, 64)
-						if err != nil {
-							return nil, err
-						}
-						rdx, rdy := getTranslate(r.Transform)
-						newX := x + gdx + rdx
-						newY := y + gdy + rdy
-						ladder.Anchor = geo.Point{X: newX, Y: newY}
-					}
-				}
+	err = walkLayerPaths(&svg, geo.AnchorsLayer, func(p *Cpath__svg, pos geo.Point) error {
+		if p.Title != nil {
+			if p.Title.String == geo.AnchorReference {
+				ladder.Anchor = pos
 			}
 		}
-
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// look for textFields
-	for _, g := range svg.Cg__svg {
-		if g.AttrInkscapeSpacelabel == geo.TextFieldsLayer {
-			gdx, gdy := getTranslate(g.Transform)
-			for _, r := range g.Crect__svg {
-				tf := TextField{}
-				if r.Title != nil { //avoid seg fault, obvs
-					tf.ID = r.Title.String
-				}
-
-				tf.TabSequence = getTabSequence(r)
-
-				if r.Desc != nil {
-					tf.Prefill = r.Desc.String
-				}
-				w, err := strconv.ParseFloat(r.Width, 64)
-				if err != nil {
-					return nil, err
-				}
-				h, err := strconv.ParseFloat(r.Height, 64)
-				if err != nil {
-					return nil, err
-				}
-
-				tf.Rect.Dim.Width = w
-				tf.Rect.Dim.Height = h
-				tf.Rect.Dim.DynamicWidth = false
-				rdx, rdy := getTranslate(r.Transform)
-				x, err := strconv.ParseFloat(r.Rx, 64)
-				if err != nil {
-					return nil, err
-				}
-				y, err := strconv.ParseFloat(r.Ry, 64)
-				if err != nil {
-					return nil, err
-				}
-				tf.Rect.Corner.X = x + rdx + gdx
-				tf.Rect.Corner.Y = y + rdy + gdy
-
-				ladder.TextFields = append(ladder.TextFields, tf)
-			}
+	err = walkLayerRects(&svg, geo.TextFieldsLayer, func(r *Crect__svg, rect geo.Rect) error {
+		tf := TextField{Rect: rect}
+		if r.Title != nil { //avoid seg fault, obvs
+			tf.ID = r.Title.String
+		}
+
+		tf.TabSequence = getTabSequence(r)
+
+		if r.Desc != nil {
+			tf.Prefill = r.Desc.String
 		}
 
+		ladder.TextFields = append(ladder.TextFields, tf)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	// sort textfields based on tab order
 
 	sort.Slice(ladder.TextFields, func(i, j int) bool {
@@ -215,101 +137,90 @@ func DefineLadderFromSVG(input []byte) (*Ladder, error) {
 	})
 
 	// look for prefill textboxes (not editable in pdf)
+	err = walkLayerRects(&svg, geo.TextPrefillsLayer, func(r *Crect__svg, rect geo.Rect) error {
+		tp := TextPrefill{Rect: rect}
+		if r.Title != nil { //avoid seg fault, obvs
+			tp.ID = r.Title.String
+		}
 
-	for _, g := range svg.Cg__svg {
-		gdx, gdy := getTranslate(g.Transform)
-		if g.AttrInkscapeSpacelabel == geo.TextPrefillsLayer {
-
-			for _, r := range g.Crect__svg {
-				tp := TextPrefill{}
-				if r.Title != nil { //avoid seg fault, obvs
-					tp.ID = r.Title.String
-				}
-
-				if r.Desc != nil {
-					tp.Properties = r.Desc.String
-				}
-				w, err := strconv.ParseFloat(r.Width, 64)
-				if err != nil {
-					return nil, err
-				}
-				h, err := strconv.ParseFloat(r.Height, 64)
-				if err != nil {
-					return nil, err
-				}
-
-				tp.Rect.Dim.Width = w
-				tp.Rect.Dim.Height = h
-				tp.Rect.Dim.DynamicWidth = false
-
-				x, err := strconv.ParseFloat(r.Rx, 64)
-				if err != nil {
-					return nil, err
-				}
-				y, err := strconv.ParseFloat(r.Ry, 64)
-				if err != nil {
-					return nil, err
-				}
-				rdx, rdy := getTranslate(r.Transform)
-				tp.Rect.Corner.X = x + rdx + gdx
-				tp.Rect.Corner.Y = y + rdy + gdy
-
-				err = UnmarshalTextPrefill(&tp)
-				if err != nil {
-					return nil, err
-				}
-				ladder.TextPrefills = append(ladder.TextPrefills, tp)
-			}
+		if r.Desc != nil {
+			tp.Properties = r.Desc.String
 		}
 
+		err := UnmarshalTextPrefill(&tp)
+		if err != nil {
+			return err
+		}
+		ladder.TextPrefills = append(ladder.TextPrefills, tp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	for _, g := range svg.Cg__svg {
-		gdx, gdy := getTranslate(g.Transform)
-		if g.AttrInkscapeSpacelabel == geo.ComboBoxesLayer {
-
-			for _, r := range g.Crect__svg {
-				cb := ComboBox{}
-				if r.Title != nil { //avoid seg fault, obvs
-					cb.ID = r.Title.String
-				}
-
-				if r.Desc != nil {
-					cb.Properties = r.Desc.String
-				}
-				w, err := strconv.ParseFloat(r.Width, 64)
-				if err != nil {
-					return nil, err
-				}
-				h, err := strconv.ParseFloat(r.Height, 64)
-				if err != nil {
-					return nil, err
-				}
-
-				cb.Rect.Dim.Width = w
-				cb.Rect.Dim.Height = h
-				cb.Rect.Dim.DynamicWidth = false
-
-				x, err := strconv.ParseFloat(r.Rx, 64)
-				if err != nil {
-					return nil, err
-				}
-				y, err := strconv.ParseFloat(r.Ry, 64)
-				if err != nil {
-					return nil, err
-				}
-				rdx, rdy := getTranslate(r.Transform)
-				cb.Rect.Corner.X = x + rdx + gdx
-				cb.Rect.Corner.Y = y + rdy + gdy
-
-				err = UnmarshalComboBox(&cb)
-				if err != nil {
-					return nil, err
-				}
-				ladder.ComboBoxes = append(ladder.ComboBoxes, cb)
+	err = walkLayerRects(&svg, geo.ComboBoxesLayer, func(r *Crect__svg, rect geo.Rect) error {
+		cb := ComboBox{Rect: rect}
+		if r.Title != nil { //avoid seg fault, obvs
+			cb.ID = r.Title.String
+		}
+
+		if r.Desc != nil {
+			cb.Properties = r.Desc.String
+		}
+
+		err := UnmarshalComboBox(&cb)
+		if err != nil {
+			return err
+		}
+		ladder.ComboBoxes = append(ladder.ComboBoxes, cb)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = walkLayerRects(&svg, geo.CheckBoxesLayer, func(r *Crect__svg, rect geo.Rect) error {
+		cb := CheckBox{Rect: rect}
+		if r.Title != nil { //avoid seg fault, obvs
+			cb.ID = r.Title.String
+		}
+
+		if r.Desc != nil {
+			cb.Prefill = r.Desc.String
+		}
+
+		ladder.CheckBoxes = append(ladder.CheckBoxes, cb)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// radio buttons are grouped by their title, in the order the groups first appear
+	err = walkLayerRects(&svg, geo.RadioButtonsLayer, func(r *Crect__svg, rect geo.Rect) error {
+		id := ""
+		if r.Title != nil { //avoid seg fault, obvs
+			id = r.Title.String
+		}
+
+		rb := RadioButton{Rect: rect}
+
+		if r.Desc != nil {
+			rb.Option = r.Desc.String
+		}
+
+		for idx, rg := range ladder.RadioGroups {
+			if rg.ID == id {
+				ladder.RadioGroups[idx].Buttons = append(rg.Buttons, rb)
+				return nil
 			}
 		}
 
+		ladder.RadioGroups = append(ladder.RadioGroups, RadioGroup{ID: id, Buttons: []RadioButton{rb}})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	err = ApplyDocumentUnits(&svg, ladder)
@@ -404,9 +315,31 @@ func ApplyDocumentUnits(svg *Csvg__svg, ladder *Ladder) error {
 		ladder.ComboBoxes[idx] = cb
 	}
 
+	for idx, cb := range ladder.CheckBoxes {
+		cb.Rect = scaleRect(cb.Rect, sf)
+		ladder.CheckBoxes[idx] = cb
+	}
+
+	for _, rg := range ladder.RadioGroups {
+		for idx, rb := range rg.Buttons {
+			rb.Rect = scaleRect(rb.Rect, sf)
+			rg.Buttons[idx] = rb
+		}
+	}
+
 	return nil
 }
 
+func scaleRect(rect geo.Rect, sf float64) geo.Rect {
+
+	rect.Corner.X = sf * rect.Corner.X
+	rect.Corner.Y = sf * rect.Corner.Y
+	rect.Dim.Width = sf * rect.Dim.Width
+	rect.Dim.Height = sf * rect.Dim.Height
+
+	return rect
+}
+
 func scaleTextFieldUnits(tf *TextField, sf float64) error {
 	if tf == nil {
 		return errors.New("nil pointer to TextField")
@@ -0,0 +1,155 @@
+package parsesvg
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+// The text of a textprefill can use template expressions (see text/template)
+// over the page it is drawn on, so that a new header item doesn't need any
+// code, e.g.
+//
+//   {"text":"{{.Exam}} {{.Candidate}} p{{.PageNumber}}/{{.PageData.Current.Own.Of}}"}
+//   {"text":"{{.PageData.Current.Process.By | upper}}"}
+//   {"text":"{{unixtime \"2 Jan 2006\" .PageData.Current.Process.UnixTime}}"}
+//
+// A value for the textprefill given by the calling code (Prefills) is used
+// as it is, instead. Text without {{ is used as it is, too, so existing
+// layouts are not affected.
+
+// PrefillValues is what the template expressions in a textprefill can use
+type PrefillValues struct {
+	Exam       string            // from the spread contents, or the item in the pagedata if not given
+	Candidate  string            // from the spread contents, or the author in the pagedata if not given
+	Spread     string            // e.g. mark
+	PageNumber int               // counting from one, as shown to the reader
+	PageData   pagedata.PageData // of the page being drawn
+	Prefills   PagePrefills      // the values given by the calling code for this page, e.g. {{index .Prefills "for"}}
+}
+
+var prefillFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"truncate": func(n int, s string) string {
+		// count characters, not bytes, so none are cut in half
+		if r := []rune(s); n >= 0 && len(r) > n {
+			return string(r[:n])
+		}
+		return s
+	},
+	"default": func(def, s string) string {
+		if s == "" {
+			return def
+		}
+		return s
+	},
+	"unixtime": func(layout string, t int64) string {
+		return time.Unix(t, 0).UTC().Format(layout)
+	},
+	"add": func(a, b int) int {
+		return a + b
+	},
+}
+
+// prefillSample has a value in every field, to check template expressions
+// can run, and to fill them in previews
+var prefillSample = PrefillValues{
+	Exam:       "PGEE00000",
+	Candidate:  "B999999",
+	Spread:     "mark",
+	PageNumber: 1,
+	PageData: pagedata.PageData{
+		Current: pagedata.PageDetail{
+			Is:  "page",
+			Own: pagedata.FileDetail{Number: 1, Of: 1},
+			Item: pagedata.ItemDetail{
+				What:    "PGEE00000",
+				When:    "2020-05-01",
+				Who:     "B999999",
+				WhoType: "anonymous",
+			},
+			Process: pagedata.ProcessDetail{
+				Name:     "preview",
+				UnixTime: 1588291200,
+				For:      "ingester",
+				ToDo:     "marking",
+				By:       "gradex-cli",
+			},
+		},
+	},
+	Prefills: PagePrefills{},
+}
+
+func isPrefillTemplate(text string) bool {
+	return strings.Contains(text, "{{")
+}
+
+func parsePrefillTemplate(id, text string) (*template.Template, error) {
+	return template.New(id).Option("missingkey=error").Funcs(prefillFuncs).Parse(text)
+}
+
+// ExpandPrefillText fills in the template expressions in the text, if any
+func ExpandPrefillText(id, text string, values PrefillValues) (string, error) {
+
+	if !isPrefillTemplate(text) {
+		return text, nil
+	}
+
+	t, err := parsePrefillTemplate(id, text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, values)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// checkPrefillText runs the template expressions in the text over sample
+// values, so that misspelt fields and functions are found before rendering
+func checkPrefillText(id, text string) error {
+	_, err := ExpandPrefillText(id, text, prefillSample)
+	return err
+}
+
+// prefillValues gets the values for the template expressions in the
+// textprefills from the contents of the spread being drawn
+func prefillValues(contents SpreadContents) PrefillValues {
+
+	if contents.PrefillValues != nil {
+		return *contents.PrefillValues
+	}
+
+	values := PrefillValues{
+		Exam:       contents.Exam,
+		Candidate:  contents.Candidate,
+		Spread:     contents.SpreadName,
+		PageNumber: contents.PageNumber + 1,
+		PageData:   contents.PageData,
+		Prefills:   contents.Prefills[contents.PageNumber],
+	}
+
+	if values.Exam == "" {
+		values.Exam = contents.PageData.Current.Item.What
+	}
+
+	if values.Candidate == "" {
+		values.Candidate = contents.PageData.Current.Item.Who
+	}
+
+	if values.Prefills == nil {
+		values.Prefills = PagePrefills{}
+	}
+
+	return values
+}
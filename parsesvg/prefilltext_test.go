@@ -0,0 +1,85 @@
+package parsesvg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/pagedata"
+)
+
+func TestExpandPrefillText(t *testing.T) {
+
+	pd := pagedata.PageData{
+		Current: pagedata.PageDetail{
+			Own:     pagedata.FileDetail{Number: 2, Of: 7},
+			Item:    pagedata.ItemDetail{What: "ENGI01020", Who: "B123456"},
+			Process: pagedata.ProcessDetail{By: "marker", UnixTime: 1590969600},
+		},
+	}
+
+	values := prefillValues(SpreadContents{
+		SpreadName: "mark",
+		PageNumber: 1,
+		PageData:   pd,
+		Prefills:   DocPrefills{1: PagePrefills{"for": "ingester"}},
+	})
+
+	for text, want := range map[string]string{
+		"no template here":                                               "no template here",
+		"{{.Exam}} {{.Candidate}}":                                       "ENGI01020 B123456",
+		"{{.PageNumber}}/{{.PageData.Current.Own.Of}}":                   "2/7",
+		"{{.PageData.Current.Process.By | upper}}":                       "MARKER",
+		"{{.Spread}} for {{index .Prefills \"for\"}}":                    "mark for ingester",
+		"{{.Exam | truncate 4}}":                                         "ENGI",
+		"{{.PageData.Current.Process.For | default \"n/a\"}}":            "n/a",
+		"{{unixtime \"2 Jan 2006\" .PageData.Current.Process.UnixTime}}": "1 Jun 2020",
+		"{{add .PageData.Current.Own.Number 1}}":                         "3",
+	} {
+		got, err := ExpandPrefillText("tp", text, values)
+		assert.NoError(t, err, text)
+		assert.Equal(t, want, got, text)
+	}
+
+	// names are cut by character, not by byte
+	values.Exam = "Zoë's exam"
+	got, err := ExpandPrefillText("tp", "{{.Exam | truncate 3}}", values)
+	assert.NoError(t, err)
+	assert.Equal(t, "Zoë", got)
+
+	// given values override those in the pagedata
+	values = prefillValues(SpreadContents{Exam: "Exam", Candidate: "Candidate", PageData: pd})
+	got, err = ExpandPrefillText("tp", "{{.Exam}} {{.Candidate}} {{.PageNumber}}", values)
+	assert.NoError(t, err)
+	assert.Equal(t, "Exam Candidate 1", got)
+
+	for _, bad := range []string{
+		"{{.Exam",
+		"{{.Exm}}",
+		"{{.Exam | shout}}",
+		"{{truncate .Exam}}",
+	} {
+		assert.Error(t, checkPrefillText("tp", bad), bad)
+	}
+
+	assert.NoError(t, checkPrefillText("tp", "{{.PageNumber}}/{{.PageData.Current.Own.Of}}"))
+}
+
+func TestLintPrefillTemplates(t *testing.T) {
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape">
+<g inkscape:label="textprefills">
+<rect x="0" y="0" width="8" height="8"><title>page</title><desc>{"text":"{{.PageNumber}}/{{.PageData.Current.Own.Of}}"}</desc></rect>
+<rect x="0" y="10" width="8" height="8"><title>who</title><desc>{"text":"{{.PageData.Current.Process.Who}}"}</desc></rect>
+<rect x="0" y="20" width="8" height="8"><title>exam</title><desc>{"text":"{{.Exam"}</desc></rect>
+</g>
+</svg>`
+
+	problems := lintLadder([]byte(svg))
+
+	assert.Equal(t, 2, len(problems))
+	assert.Equal(t, 2, CountLintErrors(problems))
+
+	for _, p := range problems {
+		assert.Contains(t, p.Problem, "has a bad template expression")
+	}
+}
@@ -0,0 +1,325 @@
+package parsesvg
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+	"github.com/timdrysdale/unipdf/v3/creator"
+)
+
+// A preview renders a spread over a made-up page, with sample values in the
+// textfields, so that template authors can see what a new sidebar looks like
+// without running an exam through the stages. The debug overlay outlines
+// where the textfields, buttons, optical boxes and anchors are.
+
+const previewPPI = 100
+
+// A4, in points, and A3, which is only previewed if the spread has an A3
+// variant (see PageFormat)
+var previewPages = map[string]geo.Dim{
+	"portrait":     {Width: 595, Height: 842},
+	"landscape":    {Width: 842, Height: 595},
+	"a3-portrait":  {Width: 842, Height: 1191},
+	"a3-landscape": {Width: 1191, Height: 842},
+}
+
+var (
+	debugTextFieldColour = creator.ColorRGBFromHex("#0066ff")
+	debugComboBoxColour  = creator.ColorRGBFromHex("#00aa00")
+	debugButtonColour    = creator.ColorRGBFromHex("#ff9900")
+	debugPrefillColour   = creator.ColorRGBFromHex("#999999")
+	debugOpticalColour   = creator.ColorRGBFromHex("#ff0000")
+	debugAnchorColour    = creator.ColorRGBFromHex("#ff00ff")
+)
+
+// RenderPreview renders the spread over a portrait and a landscape page,
+// using the variant of the spread for each, if the layout has one, and
+// returns the paths of the PDFs it made in outputDir
+func RenderPreview(svgLayoutPath, spreadName, outputDir string, debug bool, shrink float64, templateDirs ...string) ([]string, error) {
+
+	outputPaths := []string{}
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return outputPaths, err
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return outputPaths, fmt.Errorf("can't get layout from %s: %v", svgLayoutPath, err)
+	}
+
+	// fill in any template expressions in the textprefills with made-up values
+	sample := prefillSample
+	sample.Spread = spreadName
+
+	base := strings.TrimSuffix(filepath.Base(svgLayoutPath), filepath.Ext(svgLayoutPath))
+
+	orientations := []string{}
+	for orientation := range previewPages {
+		orientations = append(orientations, orientation)
+	}
+	sort.Strings(orientations)
+
+	for _, orientation := range orientations {
+
+		dim := previewPages[orientation]
+
+		format := PageFormatFromSize(dim.Width, dim.Height)
+
+		variant := chooseSpread(layout, spreadName, format)
+
+		if format.Paper == PaperA3 && !strings.Contains(variant, spreadName+"-"+PaperA3) {
+			continue // no A3 variant, so it would look the same as A4
+		}
+
+		spread, err := GetTextFieldSpread(svgLayoutPath, variant, templateDirs...)
+		if err != nil {
+			return outputPaths, err
+		}
+
+		textFieldValues, comboBoxValues := sampleValues(spread)
+
+		imagePath := filepath.Join(outputDir, fmt.Sprintf("preview-page-%s.jpg", orientation))
+
+		err = writePreviewPage(imagePath, dim)
+		if err != nil {
+			return outputPaths, err
+		}
+
+		outputPath := filepath.Join(outputDir, fmt.Sprintf("%s-%s-%s.pdf", base, spreadName, orientation))
+
+		contents := SpreadContents{
+			SvgLayoutPath:         svgLayoutPath,
+			SpreadName:            variant,
+			PreviousImagePath:     imagePath,
+			PageNumber:            0,
+			PdfOutputPath:         outputPath,
+			TemplatePathsRelative: true,
+			TextFieldValues:       DocPrefills{0: textFieldValues},
+			ComboBoxValues:        DocPrefills{0: comboBoxValues},
+			Debug:                 debug,
+			DebugShrink:           shrink,
+			Fiducials:             true,
+			PrefillValues:         &sample,
+			TemplateDirs:          templateDirs,
+		}
+
+		err = RenderSpreadExtra(contents)
+		if err != nil {
+			return outputPaths, err
+		}
+
+		outputPaths = append(outputPaths, outputPath)
+	}
+
+	return outputPaths, nil
+}
+
+// sampleValues fills in the textfields and comboboxes of the spread,
+// ticking every checkbox, and choosing the first option of every radio group
+func sampleValues(spread Spread) (PagePrefills, PagePrefills) {
+
+	textFieldValues := make(PagePrefills)
+	for _, tf := range spread.TextFields {
+		textFieldValues[tf.ID] = sampleValue(tf)
+	}
+
+	for _, cb := range spread.CheckBoxes {
+		textFieldValues[cb.ID] = "X"
+	}
+	for _, rg := range spread.RadioGroups {
+		if len(rg.Buttons) > 0 {
+			textFieldValues[rg.ID] = rg.Buttons[0].Option
+		}
+	}
+
+	comboBoxValues := make(PagePrefills)
+	for _, ladder := range spread.Ladders {
+		for _, cb := range ladder.ComboBoxes {
+			if len(cb.Options.Options) > 0 {
+				comboBoxValues[cb.ID] = cb.Options.Options[0]
+			}
+		}
+	}
+
+	return textFieldValues, comboBoxValues
+}
+
+// sampleValue is what we might expect a marker to put in the textfield
+func sampleValue(tf TextField) string {
+
+	if tf.Prefill != "" {
+		return tf.Prefill
+	}
+
+	id := strings.ToLower(tf.ID)
+
+	switch {
+	case strings.Contains(id, "optical"):
+		return "X"
+	case strings.Contains(id, "initials"):
+		return "ABC"
+	case strings.Contains(id, "mark"):
+		return "7"
+	case strings.Contains(id, "number"), strings.Contains(id, "section"):
+		return "1"
+	case strings.Contains(id, "page-ok"), strings.Contains(id, "page-bad"):
+		return "X"
+	}
+
+	return tf.ID
+}
+
+// writePreviewPage makes a ruled page, with some scribble on it, so it is
+// easy to see where the page goes on the spread, and how it is scaled
+func writePreviewPage(path string, dim geo.Dim) error {
+
+	w := int(dim.Width * previewPPI / 72)
+	h := int(dim.Height * previewPPI / 72)
+
+	im := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(im, im.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	rule := color.RGBA{180, 200, 230, 255}
+	margin := color.RGBA{230, 150, 150, 255}
+	ink := color.RGBA{40, 40, 90, 255}
+
+	spacing := previewPPI / 3
+	left := previewPPI
+
+	for y := 2 * spacing; y < h-spacing; y += spacing {
+
+		for x := 0; x < w; x++ {
+			im.Set(x, y, rule)
+		}
+
+		// a line of "writing" of varying length on most rules
+		row := y / spacing
+		if row%5 == 4 {
+			continue
+		}
+
+		end := left + (w-2*left)*(60+(row*37)%40)/100
+
+		for x := left + 10; x < end; x++ {
+			if (x/7+row)%6 == 0 {
+				continue //gap between words
+			}
+			for dy := 4; dy < spacing/2; dy++ {
+				if (x+dy)%3 == 0 {
+					im.Set(x, y-dy, ink)
+				}
+			}
+		}
+	}
+
+	for y := 0; y < h; y++ {
+		im.Set(left, y, margin)
+		im.Set(left+1, y, margin)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = jpeg.Encode(f, im, &jpeg.Options{Quality: 90})
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// drawDebugOverlay is called once the page is sized, so the
+// dynamic part of the page (spread.ExtraWidth) is known
+func drawDebugOverlay(c *creator.Creator, layout *Layout, spread Spread, shrink float64) {
+
+	shift := 0.0
+	if spread.Dim.DynamicWidth {
+		shift = spread.ExtraWidth
+	}
+
+	outline := func(rect geo.Rect, inset float64, colour creator.Color) {
+		w := rect.Dim.Width - 2*inset
+		h := rect.Dim.Height - 2*inset
+		if w <= 0 || h <= 0 {
+			return
+		}
+		r := c.NewRectangle(rect.Corner.X+shift+inset, rect.Corner.Y+inset, w, h)
+		r.SetBorderColor(colour)
+		r.SetBorderWidth(0.5)
+		c.Draw(r)
+	}
+
+	label := func(text string, x, y float64, colour creator.Color) {
+		p := c.NewParagraph(text)
+		p.SetFontSize(4)
+		p.SetColor(colour)
+		p.SetPos(x, y)
+		c.Draw(p)
+	}
+
+	for _, tf := range spread.TextFields {
+		outline(tf.Rect, 0, debugTextFieldColour)
+		outline(tf.Rect, shrink, debugOpticalColour)
+		label(tf.ID, tf.Rect.Corner.X+shift, tf.Rect.Corner.Y+tf.Rect.Dim.Height, debugTextFieldColour)
+	}
+
+	for _, cb := range spread.ComboBoxes {
+		outline(cb.Rect, 0, debugComboBoxColour)
+		label(cb.ID, cb.Rect.Corner.X+shift, cb.Rect.Corner.Y+cb.Rect.Dim.Height, debugComboBoxColour)
+	}
+
+	for _, tp := range spread.TextPrefills {
+		outline(tp.Rect, 0, debugPrefillColour)
+	}
+
+	for _, tf := range buttonTextFields(spread) {
+		outline(tf.Rect, 0, debugButtonColour)
+		outline(tf.Rect, shrink, debugOpticalColour)
+		label(tf.ID, tf.Rect.Corner.X+shift, tf.Rect.Corner.Y+tf.Rect.Dim.Height, debugButtonColour)
+	}
+
+	names := []string{}
+	for name := range layout.Anchors {
+		if inSpread(name, spread.Name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	size := 4.0
+
+	for _, name := range names {
+
+		anchor := layout.Anchors[name]
+
+		// only the previous image stays put when the page grows
+		if !strings.HasPrefix(name, "img-previous-") {
+			anchor.X = anchor.X + shift
+		}
+
+		for _, l := range []*creator.Line{
+			c.NewLine(anchor.X-size, anchor.Y, anchor.X+size, anchor.Y),
+			c.NewLine(anchor.X, anchor.Y-size, anchor.X, anchor.Y+size),
+		} {
+			l.SetLineWidth(0.5)
+			l.SetColor(debugAnchorColour)
+			c.Draw(l)
+		}
+
+		label(name, anchor.X+size, anchor.Y+1, debugAnchorColour)
+	}
+}
@@ -0,0 +1,69 @@
+package parsesvg
+
+import (
+	goimage "image"
+	_ "image/jpeg"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+func TestSampleValue(t *testing.T) {
+
+	assert.Equal(t, "7", sampleValue(TextField{ID: "q1-mark"}))
+	assert.Equal(t, "1", sampleValue(TextField{ID: "q1-number"}))
+	assert.Equal(t, "ABC", sampleValue(TextField{ID: "initials"}))
+	assert.Equal(t, "X", sampleValue(TextField{ID: "page-ok"}))
+	assert.Equal(t, "subtotal-01", sampleValue(TextField{ID: "subtotal-01"}))
+	assert.Equal(t, "given", sampleValue(TextField{ID: "q1-mark", Prefill: "given"}))
+}
+
+func TestWritePreviewPage(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-preview")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "page.jpg")
+
+	assert.NoError(t, writePreviewPage(path, geo.Dim{Width: 842, Height: 595}))
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	config, _, err := goimage.DecodeConfig(f)
+	assert.NoError(t, err)
+	assert.Equal(t, 842*previewPPI/72, config.Width)
+	assert.Equal(t, 595*previewPPI/72, config.Height)
+}
+
+func TestRenderPreview(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gradex-preview")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, debug := range []bool{false, true} {
+
+		paths, err := RenderPreview("./test/layout-312pt.svg", "mark", dir, debug, 4)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{
+			filepath.Join(dir, "layout-312pt-mark-landscape.pdf"),
+			filepath.Join(dir, "layout-312pt-mark-portrait.pdf"),
+		}, paths)
+
+		for _, path := range paths {
+			_, err := os.Stat(path)
+			assert.NoError(t, err)
+		}
+	}
+
+	_, err = RenderPreview("./test/layout-312pt.svg", "no-such-spread", dir, false, 0)
+	assert.Error(t, err)
+}
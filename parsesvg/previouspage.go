@@ -0,0 +1,238 @@
+package parsesvg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/unipdf/v3/contentstream"
+	"github.com/timdrysdale/unipdf/v3/core"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+// In vector mode, the previous page is placed as a form XObject, instead of as
+// an image of it, so that typed answers keep their text, and stay sharp however
+// many stages they go through. The page should have had its annotations
+// flattened already, because only its contents are placed. Its pagedata is
+// left behind, because the text in the placed page would still be read, and
+// the old pagedata could be taken for the new.
+
+const previousPageName = "PreviousPage"
+
+// previousPageSize returns the width and height of the page as it is shown,
+// i.e. after any rotation
+func previousPageSize(page *model.PdfPage) (float64, float64, error) {
+
+	mbox, err := page.GetMediaBox()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	width := mbox.Urx - mbox.Llx
+	height := mbox.Ury - mbox.Lly
+
+	if width <= 0 || height <= 0 {
+		return 0, 0, errors.New("previous page has an empty media box")
+	}
+
+	if pageRotation(page)%180 != 0 {
+		return height, width, nil
+	}
+
+	return width, height, nil
+}
+
+func pageRotation(page *model.PdfPage) int64 {
+	if page.Rotate == nil {
+		return 0
+	}
+	return ((*page.Rotate % 360) + 360) % 360
+}
+
+// previousPageMatrix maps the media box of the page, turned by its rotation,
+// onto a box at (x,y) from the top left corner, scaled by scale, on a page of
+// the given height
+func previousPageMatrix(mbox model.PdfRectangle, rotate int64, x, y, scale, pageHeight, shownHeight float64) [6]float64 {
+
+	var m [6]float64
+
+	switch rotate {
+	case 90:
+		m = [6]float64{0, -1, 1, 0, -mbox.Lly, mbox.Urx}
+	case 180:
+		m = [6]float64{-1, 0, 0, -1, mbox.Urx, mbox.Ury}
+	case 270:
+		m = [6]float64{0, 1, -1, 0, mbox.Ury, -mbox.Llx}
+	default:
+		m = [6]float64{1, 0, 0, 1, -mbox.Llx, -mbox.Lly}
+	}
+
+	for i := range m {
+		m[i] = m[i] * scale
+	}
+
+	m[4] = m[4] + x
+	m[5] = m[5] + pageHeight - y - shownHeight*scale
+
+	return m
+}
+
+// drawPreviousPage adds the previous page to the resources of the page as
+// a form XObject, and draws it, scaled, with its top left corner at (x,y)
+func drawPreviousPage(page, previous *model.PdfPage, x, y, scale, pageHeight float64) error {
+
+	mbox, err := previous.GetMediaBox()
+	if err != nil {
+		return err
+	}
+
+	_, shownHeight, err := previousPageSize(previous)
+	if err != nil {
+		return err
+	}
+
+	contents, err := previous.GetAllContentStreams()
+	if err != nil {
+		return err
+	}
+
+	contents, err = withoutPageData(contents)
+	if err != nil {
+		return err
+	}
+
+	xform := model.NewXObjectForm()
+
+	xform.Resources = previous.Resources
+	xform.BBox = core.MakeArrayFromFloats([]float64{mbox.Llx, mbox.Lly, mbox.Urx, mbox.Ury})
+
+	err = xform.SetContentStream([]byte(contents), core.NewFlateEncoder())
+	if err != nil {
+		return err
+	}
+
+	stream, ok := xform.ToPdfObject().(*core.PdfObjectStream)
+	if !ok {
+		return errors.New("previous page did not make a form XObject")
+	}
+
+	if page.Resources == nil {
+		page.Resources = model.NewPdfPageResources()
+	}
+
+	err = page.Resources.SetXObjectByName(core.PdfObjectName(previousPageName), stream)
+	if err != nil {
+		return err
+	}
+
+	m := previousPageMatrix(*mbox, pageRotation(previous), x, y, scale, pageHeight, shownHeight)
+
+	return page.AddContentStreamByString(fmt.Sprintf("q %.4f %.4f %.4f %.4f %.4f %.4f cm /%s Do Q",
+		m[0], m[1], m[2], m[3], m[4], m[5], previousPageName))
+}
+
+// withoutPageData removes the text objects that show pagedata from the
+// contents of a page, leaving everything else as it was
+func withoutPageData(contents string) (string, error) {
+
+	ops, err := contentstream.NewContentStreamParser(contents).Parse()
+	if err != nil {
+		return "", err
+	}
+
+	kept := contentstream.ContentStreamOperations{}
+	text := contentstream.ContentStreamOperations{}
+	inText := false
+	isPageData := false
+
+	for _, op := range *ops {
+
+		switch {
+
+		case op.Operand == "BT":
+			inText = true
+			isPageData = false
+			text = contentstream.ContentStreamOperations{op}
+
+		case inText:
+			text = append(text, op)
+
+			for _, param := range op.Params {
+				isPageData = isPageData || showsPageData(param)
+			}
+
+			if op.Operand == "ET" {
+				inText = false
+				if !isPageData {
+					kept = append(kept, text...)
+				}
+			}
+
+		default:
+			kept = append(kept, op)
+		}
+	}
+
+	// keep a text object that was never ended, as it was
+	if inText {
+		kept = append(kept, text...)
+	}
+
+	return string(kept.Bytes()), nil
+}
+
+func showsPageData(obj core.PdfObject) bool {
+
+	switch t := obj.(type) {
+
+	case *core.PdfObjectString:
+		return strings.Contains(t.Str(), pagedata.StartTag)
+
+	case *core.PdfObjectArray:
+		for _, element := range t.Elements() {
+			if showsPageData(element) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SplitPreviousPage splits the page down the middle, as it is shown, into
+// left and right halves, e.g. for two A4 pages scanned side by side on A3.
+// The halves share the contents of the page, and only their media boxes
+// differ, so only that half is shown when they are placed.
+func SplitPreviousPage(page *model.PdfPage) (*model.PdfPage, *model.PdfPage, error) {
+
+	mbox, err := page.GetMediaBox()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	midX := (mbox.Llx + mbox.Urx) / 2
+	midY := (mbox.Lly + mbox.Ury) / 2
+
+	first, second := *mbox, *mbox
+
+	// the left of the page as shown is a different side of the media box,
+	// depending on how the page is turned (see previousPageMatrix)
+	switch pageRotation(page) {
+	case 90:
+		first.Ury, second.Lly = midY, midY
+	case 180:
+		first.Llx, second.Urx = midX, midX
+	case 270:
+		first.Lly, second.Ury = midY, midY
+	default:
+		first.Urx, second.Llx = midX, midX
+	}
+
+	left, right := *page, *page
+
+	left.MediaBox = &first
+	right.MediaBox = &second
+
+	return &left, &right, nil
+}
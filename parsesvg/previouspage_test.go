@@ -0,0 +1,75 @@
+package parsesvg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/unipdf/v3/model"
+)
+
+func TestPreviousPageMatrix(t *testing.T) {
+
+	mbox := model.PdfRectangle{Llx: 0, Lly: 0, Urx: 100, Ury: 200}
+
+	// top left corner of the page as shown goes to (10,20) from the top left of a 300pt high page
+	m := previousPageMatrix(mbox, 0, 10, 20, 0.5, 300, 200)
+	assert.Equal(t, [6]float64{0.5, 0, 0, 0.5, 10, 180}, m)
+
+	// turned clockwise, so the bottom left corner of the media box is shown at the top left
+	m = previousPageMatrix(mbox, 90, 10, 20, 0.5, 300, 100)
+	x := m[0]*mbox.Llx + m[2]*mbox.Lly + m[4]
+	y := m[1]*mbox.Llx + m[3]*mbox.Lly + m[5]
+	assert.Equal(t, 10.0, x)
+	assert.Equal(t, 280.0, y)
+
+	// upside down, so the bottom right corner is shown at the top left
+	m = previousPageMatrix(mbox, 180, 10, 20, 0.5, 300, 200)
+	x = m[0]*mbox.Urx + m[2]*mbox.Lly + m[4]
+	y = m[1]*mbox.Urx + m[3]*mbox.Lly + m[5]
+	assert.Equal(t, 10.0, x)
+	assert.Equal(t, 280.0, y)
+
+	// turned anticlockwise, so the top left corner of the media box is shown at the bottom left
+	m = previousPageMatrix(mbox, 270, 10, 20, 0.5, 300, 100)
+	x = m[0]*mbox.Llx + m[2]*mbox.Ury + m[4]
+	y = m[1]*mbox.Llx + m[3]*mbox.Ury + m[5]
+	assert.Equal(t, 10.0, x)
+	assert.Equal(t, 230.0, y)
+}
+
+func TestWithoutPageData(t *testing.T) {
+
+	contents := `q
+BT
+/F1 1e-06 Tf
+1 0 0 1 1 1 Tm
+(<gradex-pagedata>{"current":{}}</gradex-pagedata><hash>123</hash>) Tj
+ET
+Q
+BT
+/F1 12 Tf
+1 0 0 1 72 700 Tm
+[(Ans) 20 (wer)] TJ
+ET
+0 0 m
+10 10 l
+S
+`
+
+	stripped, err := withoutPageData(contents)
+	assert.NoError(t, err)
+
+	// only the pagedata's text object has gone
+	assert.NotContains(t, stripped, "gradex-pagedata")
+	assert.Equal(t, 1, strings.Count(stripped, "BT"))
+	assert.Contains(t, stripped, "Ans")
+	assert.Contains(t, stripped, "wer")
+	assert.Contains(t, stripped, " l\n")
+	assert.Contains(t, stripped, "S\n")
+
+	// nothing to take out, so all still there
+	stripped, err = withoutPageData("BT /F1 12 Tf (Answer) Tj ET")
+	assert.NoError(t, err)
+	assert.Contains(t, stripped, "Answer")
+}
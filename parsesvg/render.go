@@ -12,6 +12,7 @@ import (
 	"github.com/timdrysdale/gradex-cli/geo"
 	"github.com/timdrysdale/gradex-cli/pagedata"
 	"github.com/timdrysdale/unipdf/v3/annotator"
+	"github.com/timdrysdale/unipdf/v3/core"
 	"github.com/timdrysdale/unipdf/v3/creator"
 	"github.com/timdrysdale/unipdf/v3/model"
 	"github.com/timdrysdale/unipdf/v3/model/optimize"
@@ -51,14 +52,22 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		return errors.New(fmt.Sprintf("Error obtaining layout from svg %s\n", svgLayoutPath))
 	}
 
+	// where to find the ladders, chrome and fonts
+	var templateDirs []string
+	if contents.TemplatePathsRelative {
+		templateDirs = searchDirs(svgLayoutPath, contents.TemplateDirs)
+	}
+
 	spread := Spread{}
 
 	spread.Name = spreadName
 
 	foundPage := false
+	spreadFont := ""
 	for k, v := range layout.PageDims {
-		if strings.Contains(k, spread.Name) {
+		if inSpread(k, spread.Name) {
 			spread.Dim = v
+			spreadFont = layout.Fonts[k]
 			foundPage = true
 		}
 	}
@@ -71,7 +80,7 @@ func RenderSpreadExtra(contents SpreadContents) error {
 	var svgFilenames, imgFilenames []string
 
 	for k, _ := range layout.Filenames {
-		if strings.Contains(k, spread.Name) {
+		if inSpread(k, spread.Name) {
 
 			// assume jpg- or no prefix is image; svg- is ladder (image plus acroforms)
 			if strings.HasPrefix(k, geo.SVGElement) {
@@ -97,12 +106,11 @@ func RenderSpreadExtra(contents SpreadContents) error {
 			corner = thisAnchor
 		}
 
-		svgfilename := fmt.Sprintf("%s.svg", layout.Filenames[svgname])
-		imgfilename := fmt.Sprintf("%s.jpg", layout.Filenames[svgname]) //TODO check again library is jpg-only?
+		svgfilename, imgfilename := chromeFilenames(layout.Filenames[svgname])
 
 		if contents.TemplatePathsRelative {
-			svgfilename = filepath.Join(filepath.Dir(svgLayoutPath), svgfilename)
-			imgfilename = filepath.Join(filepath.Dir(svgLayoutPath), imgfilename)
+			svgfilename = findTemplateFile(templateDirs, svgfilename)
+			imgfilename = findTemplateFile(templateDirs, imgfilename)
 		}
 
 		svgBytes, err := ioutil.ReadFile(svgfilename)
@@ -154,6 +162,7 @@ func RenderSpreadExtra(contents SpreadContents) error {
 			spread.ComboBoxes = append(spread.ComboBoxes, cb)
 		}
 
+		appendButtons(&spread, ladder, corner)
 	}
 
 	// get all the static images that decorate this page, but not the special script "previous-image"
@@ -169,11 +178,11 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		imgfilename := imgname //in case not specified, e.g. previous image
 
 		if filename, ok := layout.Filenames[imgname]; ok {
-			imgfilename = fmt.Sprintf("%s.jpg", filename)
+			_, imgfilename = chromeFilenames(filename)
 		}
 
 		if contents.TemplatePathsRelative {
-			imgfilename = filepath.Join(filepath.Dir(svgLayoutPath), imgfilename)
+			imgfilename = findTemplateFile(templateDirs, imgfilename)
 		}
 
 		// overwrite filename with dynamically supplied one, if supplied
@@ -223,10 +232,19 @@ func RenderSpreadExtra(contents SpreadContents) error {
 
 	c := creator.New()
 	c.SetPageMargins(0, 0, 0, 0) // we're not printing so use the whole page
+
+	fonts := newSpreadFonts(c, templateDirs, spreadFont)
+
 	var page *model.PdfPage
 	if strings.Compare(previousImage.Filename, "") != 0 {
 
-		img, err := c.NewImageFromFile(previousImage.Filename)
+		var img *creator.Image
+
+		if contents.Bilevel {
+			img, err = newBilevelImageFromFile(c, previousImage.Filename)
+		} else {
+			img, err = c.NewImageFromFile(previousImage.Filename)
+		}
 
 		if err != nil {
 			return errors.New(fmt.Sprintf("Error opening spread %s previous-image file %s: %v", spread.Name, previousImage.Filename, err))
@@ -254,6 +272,35 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		page = c.NewPage()
 
 		c.Draw(img) //draw previous image
+
+	} else if contents.PreviousPage != nil {
+
+		width, height, err := previousPageSize(contents.PreviousPage)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error sizing spread %s previous page: %v", spread.Name, err))
+		}
+
+		// same scaling as for the previous image
+		var scale float64
+		if spread.Dim.DynamicWidth {
+			scale = spread.Dim.Height / height
+			spread.ExtraWidth = width * scale
+		} else {
+			scale = previousImage.Dim.Height / height
+			if width*scale > previousImage.Dim.Width {
+				scale = previousImage.Dim.Width / width
+			}
+		}
+
+		c.SetPageSize(creator.PageSize{spread.GetWidth(), spread.Dim.Height})
+
+		page = c.NewPage()
+
+		err = drawPreviousPage(page, contents.PreviousPage, previousImage.Corner.X, previousImage.Corner.Y, scale, spread.Dim.Height)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error drawing spread %s previous page: %v", spread.Name, err))
+		}
+
 	} else {
 		c.SetPageSize(creator.PageSize{spread.GetWidth(), spread.Dim.Height})
 
@@ -264,6 +311,19 @@ func RenderSpreadExtra(contents SpreadContents) error {
 	// pagedata used to go in here
 
 	for _, v := range spread.Images {
+
+		if isVectorChrome(v.Filename) {
+			corner := v.Corner
+			if spread.Dim.DynamicWidth {
+				corner.X = corner.X + spread.ExtraWidth
+			}
+			err := drawSVGChrome(c, v.Filename, corner, v.Dim)
+			if err != nil {
+				return errors.New(fmt.Sprintf("Error drawing chrome %s: %s", v.Filename, err))
+			}
+			continue
+		}
+
 		img, err := c.NewImageFromFile(v.Filename)
 
 		if err != nil {
@@ -283,6 +343,26 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		c.Draw(img)
 	}
 
+	// show template authors where everything is
+	if contents.Debug {
+		drawDebugOverlay(c, layout, spread, contents.DebugShrink)
+	}
+
+	// fiducials let us register the page if it is printed, marked on paper, and scanned back in
+	if contents.Fiducials {
+		drawFiducials(c, layout, spread)
+	}
+
+	// the identity code lets us find the pagedata again if the hidden text is lost
+	if contents.IdentityCode && contents.PageData.Current.UUID != "" {
+		if rect, ok := identityCodeRect(layout, spread); ok {
+			err := drawIdentityCode(c, rect, pagedata.GetPageIdentity(contents.PageData))
+			if err != nil {
+				return errors.New(fmt.Sprintf("Error drawing identity code for spread %s: %v", spread.Name, err))
+			}
+		}
+	}
+
 	updatedComments := contents.PageData.Current.Comments
 
 	// expect our calling function to have pre-loaded any old comments
@@ -304,14 +384,25 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		lastEditor = "-" + limit(previousPageData.Process.For, 3)
 	}
 
+	// only load the font if it is needed, so we don't embed an empty subset
+	var commentFont *model.PdfFont
+	if len(comments.GetByPage(pageNumber)) > 0 {
+		commentFont, err = fonts.draw("")
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error loading font for spread %s: %v", spread.Name, err))
+		}
+	}
+
 	for i, cmt := range comments.GetByPage(pageNumber) {
 		cmt.Label = fmt.Sprintf("%d%s", i+numOldComments, lastEditor)
 
+		// the pagedata keeps the text as written, but we draw what the font can
 		shortCmt := cmt
-		if len(shortCmt.Text) > 120 {
-			shortCmt.Text = shortCmt.Text[0:119] + "..."
+		shortCmt.Text = fonts.drawable("", shortCmt.Text)
+		if text := []rune(shortCmt.Text); len(text) > 120 { //don't cut an accented character in half
+			shortCmt.Text = string(text[0:119]) + "..."
 		}
-		comment.DrawComment(c, shortCmt, x, y)
+		comment.DrawCommentFont(c, shortCmt, x, y, commentFont)
 		y = y - rowHeight
 		updatedComments = append(updatedComments, cmt)
 	}
@@ -323,14 +414,30 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		pagedata.MarshalOneToCreator(c, &contents.PageData)
 	}
 
+	values := prefillValues(contents)
+
 	for _, tp := range spread.TextPrefills {
 
-		//update prefill contents from info given
+		//update prefill contents from info given, else fill in any template in the layout
 		if val, ok := contents.Prefills[pageNumber][tp.ID]; ok {
 			tp.Text.Text = val
+		} else {
+			text, err := ExpandPrefillText(tp.ID, tp.Text.Text, values)
+			if err != nil {
+				return fmt.Errorf("textprefill %s: %v", tp.ID, err)
+			}
+			tp.Text.Text = text
 		}
 		// update our prefill text
-		p := c.NewParagraph(tp.Text.Text)
+		p := c.NewParagraph(fonts.drawable(tp.Text.TextFont, tp.Text.Text))
+
+		font, err := fonts.draw(tp.Text.TextFont)
+		if err != nil {
+			return fmt.Errorf("textprefill %s: %v", tp.ID, err)
+		}
+		if font != nil {
+			p.SetFont(font)
+		}
 
 		p.SetFontSize(tp.Text.TextSize)
 
@@ -357,6 +464,14 @@ func RenderSpreadExtra(contents SpreadContents) error {
 	  ******************************************************************************/
 	form := model.NewPdfAcroForm()
 
+	// the form font is embedded in full, so leave it out if there's nothing to type in
+	if len(spread.TextFields)+len(spread.ComboBoxes) > 0 {
+		err = fonts.setFormFont(form)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error setting form font for spread %s: %v", spread.Name, err))
+		}
+	}
+
 	for _, tf := range spread.TextFields {
 
 		tfText := tf.Prefill
@@ -379,6 +494,10 @@ func RenderSpreadExtra(contents SpreadContents) error {
 		if err != nil {
 			panic(err)
 		}
+		if fonts.hasFormFont() {
+			textf.DA = formFontAppearance()
+			textf.V = formText(tfText)
+		}
 		*form.Fields = append(*form.Fields, textf.PdfField)
 		page.AddAnnotation(textf.Annotations[0].PdfAnnotation)
 	}
@@ -395,23 +514,91 @@ func RenderSpreadExtra(contents SpreadContents) error {
 			panic(err)
 		}
 
+		if val, ok := contents.ComboBoxValues[pageNumber][cb.ID]; ok {
+			comboboxf.V = core.MakeString(val)
+			if fonts.hasFormFont() {
+				comboboxf.V = formText(val)
+			}
+		}
+
 		*form.Fields = append(*form.Fields, comboboxf.PdfField)
 		page.AddAnnotation(comboboxf.Annotations[0].PdfAnnotation)
 	}
 
+	// checkboxes and radio groups take their state from the textfield values, so it
+	// carries between stages in the same way (a button is on if its value isn't empty)
+	for _, cb := range spread.CheckBoxes {
+
+		checked := cb.Prefill != ""
+
+		if val, ok := contents.TextFieldValues[pageNumber][cb.ID]; ok {
+			checked = val != ""
+		}
+
+		name := fmt.Sprintf("page-%03d-%s", pageNumber+1, cb.ID) //match physical page number
+
+		if spread.Dim.DynamicWidth {
+			cb.Rect.Corner.X = cb.Rect.Corner.X + spread.ExtraWidth
+		}
+
+		opt := annotator.CheckboxFieldOptions{Checked: checked}
+		checkboxf, err := annotator.NewCheckboxField(page, name, formRect(cb.Rect, layout.Dim), opt)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error making checkbox %s: %v", name, err))
+		}
+
+		*form.Fields = append(*form.Fields, checkboxf.PdfField)
+		page.AddAnnotation(checkboxf.Annotations[0].PdfAnnotation)
+	}
+
+	for _, rg := range spread.RadioGroups {
+
+		name := fmt.Sprintf("page-%03d-%s", pageNumber+1, rg.ID) //match physical page number
+
+		if spread.Dim.DynamicWidth {
+			rg = shiftRadioGroup(rg, spread.ExtraWidth)
+		}
+
+		radiof, err := newRadioField(page, name, rg, layout.Dim, selectedOption(rg, contents.TextFieldValues[pageNumber]))
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error making radio group %s: %v", name, err))
+		}
+
+		*form.Fields = append(*form.Fields, radiof.PdfField)
+		for _, widget := range radiof.Annotations {
+			page.AddAnnotation(widget.PdfAnnotation)
+		}
+	}
+
 	err = c.SetForms(form)
 	if err != nil {
 		return errors.New(fmt.Sprintf("Error: %v\n", err))
 	}
 
+	imageQuality := contents.ImageQuality
+	if imageQuality == 0 {
+		imageQuality = 90
+	}
+
+	imageUpperPPI := contents.ImageUpperPPI
+	if imageUpperPPI == 0 {
+		imageUpperPPI = 150
+	}
+
+	if contents.Bilevel {
+		// leave the one-bit page image alone, rather than making a JPEG of it
+		imageQuality = 0
+		imageUpperPPI = 0
+	}
+
 	c.SetOptimizer(optimize.New(optimize.Options{
 		CombineDuplicateDirectObjects:   true,
 		CombineIdenticalIndirectObjects: true,
 		CombineDuplicateStreams:         true,
 		CompressStreams:                 true,
 		UseObjectStreams:                true,
-		ImageQuality:                    90,
-		ImageUpperPPI:                   150,
+		ImageQuality:                    imageQuality,
+		ImageUpperPPI:                   imageUpperPPI,
 	}))
 
 	c.WriteToFile(pdfOutputPath)
@@ -102,6 +102,7 @@ type Cg__svg struct {
 	Attrid                     string        `xml:"id,attr"  json:",omitempty"`
 	AttrInkscapeSpacelabel     string        `xml:"http://www.inkscape.org/namespaces/inkscape label,attr"  json:",omitempty"`
 	Attrstyle                  string        `xml:"style,attr"  json:",omitempty"`
+	Cg__svg                    []*Cg__svg    `xml:"http://www.w3.org/2000/svg g,omitempty" json:"groups,omitempty"`
 	Cpath__svg                 []*Cpath__svg `xml:"http://www.w3.org/2000/svg path,omitempty" json:"path,omitempty"`
 	Crect__svg                 []*Crect__svg `xml:"http://www.w3.org/2000/svg rect,omitempty" json:"rect,omitempty"`
 	Transform                  string        `xml:"transform,attr"  json:",omitempty"`
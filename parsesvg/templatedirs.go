@@ -0,0 +1,105 @@
+package parsesvg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// The files a layout refers to (ladders, chrome, images and fonts) are found
+// relative to the layout. Template dirs, if given, are looked in first, in
+// order, so that an exam can keep its own version of some of the files, and
+// get the rest from the shared template folder, e.g.
+//
+//   exam/00-config/template/layout-mark-ladder.svg   <- used
+//   etc/overlay/template/layout-mark-ladder.svg
+//   etc/overlay/template/layout.svg                  <- used (exam has none)
+
+// findTemplateFile returns the first of the dirs that has the file, or the
+// file in the last dir if none of them do, so errors say where it was expected
+func findTemplateFile(dirs []string, name string) string {
+
+	if len(dirs) == 0 || filepath.IsAbs(name) {
+		return name
+	}
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if fileExists(path) {
+			return path
+		}
+	}
+
+	return filepath.Join(dirs[len(dirs)-1], name)
+}
+
+// searchDirs puts the folder the layout is in after the template dirs
+func searchDirs(svgLayoutPath string, templateDirs []string) []string {
+	dirs := []string{}
+	dirs = append(dirs, templateDirs...)
+	return append(dirs, filepath.Dir(svgLayoutPath))
+}
+
+// TemplateHash is a hash of the contents of the layout, and the files it
+// refers to for the spread, so that pagedata can record exactly which
+// version of a template was used. Files that are missing are left out, and
+// so are images given at render time, like the previous image.
+func TemplateHash(svgLayoutPath, spreadName string, templateDirs ...string) (string, error) {
+
+	svgBytes, err := ioutil.ReadFile(svgLayoutPath)
+	if err != nil {
+		return "", err
+	}
+
+	layout, err := DefineLayoutFromSVG(svgBytes)
+	if err != nil {
+		return "", fmt.Errorf("can't get layout from %s: %v", svgLayoutPath, err)
+	}
+
+	dirs := searchDirs(svgLayoutPath, templateDirs)
+
+	files := []string{}
+
+	for k, filename := range layout.Filenames {
+		if !inSpread(k, spreadName) {
+			continue
+		}
+		ladderFile, chromeFile := chromeFilenames(filename)
+		if strings.HasPrefix(k, geo.SVGElement) {
+			files = append(files, ladderFile)
+		}
+		files = append(files, chromeFile)
+	}
+
+	for k, font := range layout.Fonts {
+		if inSpread(k, spreadName) && !isStandardFont(font) {
+			files = append(files, font)
+		}
+	}
+
+	sort.Strings(files)
+
+	h := sha256.New()
+
+	h.Write(svgBytes)
+
+	for _, name := range files {
+
+		contents, err := ioutil.ReadFile(findTemplateFile(dirs, name))
+		if err != nil {
+			continue
+		}
+
+		// the name goes in too, so swapping two files changes the hash
+		fmt.Fprintf(h, "\x00%s\x00", name)
+		h.Write(contents)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,99 @@
+package parsesvg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const templateDirsLayout = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="200pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="pages">
+<rect width="200" height="100" x="0" y="0"><title>page-dynamic-mark</title></rect>
+<rect width="200" height="100" x="0" y="0"><title>page-dynamic-check</title></rect>
+</g>
+<g inkscape:label="anchors">
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-mark-ladder</title><desc>mark.svg</desc></path>
+<path sodipodi:cx="100" sodipodi:cy="0"><title>svg-check-ladder</title><desc>check.svg</desc></path>
+</g>
+</svg>`
+
+const templateDirsLadder = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="textfields">
+<rect id="r1-tab01" width="10" height="10" x="0" y="0"><title>q1-mark</title></rect>
+%s
+</g>
+</svg>`
+
+func TestTemplateDirs(t *testing.T) {
+
+	global, err := ioutil.TempDir("", "gradex-global")
+	assert.NoError(t, err)
+	defer os.RemoveAll(global)
+
+	exam, err := ioutil.TempDir("", "gradex-exam")
+	assert.NoError(t, err)
+	defer os.RemoveAll(exam)
+
+	write := func(dir, name, contents string) {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644))
+	}
+
+	layoutPath := filepath.Join(global, "layout.svg")
+
+	write(global, "layout.svg", templateDirsLayout)
+	write(global, "mark.svg", fmt.Sprintf(templateDirsLadder, ""))
+	write(global, "check.svg", fmt.Sprintf(templateDirsLadder, ""))
+
+	assert.Equal(t, filepath.Join(global, "mark.svg"), findTemplateFile([]string{exam, global}, "mark.svg"))
+	assert.Equal(t, filepath.Join(global, "none.svg"), findTemplateFile([]string{exam, global}, "none.svg"))
+	assert.Equal(t, "mark.svg", findTemplateFile(nil, "mark.svg"))
+
+	markHash, err := TemplateHash(layoutPath, "mark", exam)
+	assert.NoError(t, err)
+	checkHash, err := TemplateHash(layoutPath, "check", exam)
+	assert.NoError(t, err)
+
+	// the exam has its own mark ladder, with an extra box
+	write(exam, "mark.svg", fmt.Sprintf(templateDirsLadder, `<rect id="r2-tab02" width="10" height="10" x="0" y="20"><title>q2-mark</title></rect>`))
+
+	assert.Equal(t, filepath.Join(exam, "mark.svg"), findTemplateFile([]string{exam, global}, "mark.svg"))
+
+	spread, err := GetTextFieldSpread(layoutPath, "mark", exam)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(spread.TextFields))
+
+	spread, err = GetTextFieldSpread(layoutPath, "mark")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(spread.TextFields))
+
+	newMarkHash, err := TemplateHash(layoutPath, "mark", exam)
+	assert.NoError(t, err)
+	assert.NotEqual(t, markHash, newMarkHash)
+
+	// other spreads, and other exams, are not affected
+	newCheckHash, err := TemplateHash(layoutPath, "check", exam)
+	assert.NoError(t, err)
+	assert.Equal(t, checkHash, newCheckHash)
+
+	globalHash, err := TemplateHash(layoutPath, "mark")
+	assert.NoError(t, err)
+	assert.Equal(t, markHash, globalHash)
+
+	// the exam's ladder is linted instead
+	write(exam, "check.svg", "<svg")
+
+	problems, err := LintLayout(layoutPath, []LintSpread{{Name: "check"}}, exam)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, CountLintErrors(problems))
+
+	_, err = TemplateHash(filepath.Join(exam, "layout.svg"), "mark")
+	assert.Error(t, err)
+}
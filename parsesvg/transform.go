@@ -0,0 +1,402 @@
+package parsesvg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+// Inkscape writes whatever transform it likes on groups and elements, so we
+// read the full grammar (matrix, translate, scale, rotate, skewX, skewY) and
+// compose the transforms down through nested groups. Form fields are always
+// axis-aligned rectangles, so a rect that ends up rotated (other than by a
+// multiple of 90 degrees, which just swaps its width and height) or skewed
+// is an error, rather than a field in the wrong place.
+
+// transform is the affine matrix [a c e; b d f; 0 0 1] that
+// maps the coordinates of an element to those of its parent
+type transform struct {
+	a, b, c, d, e, f float64
+}
+
+const inkscapeLayer = "layer"
+
+// small enough to ignore the rounding in the six decimal places Inkscape writes
+const transformTolerance = 1e-5
+
+var (
+	transformFunction = regexp.MustCompile(`([A-Za-z]+)\s*\(([^)]*)\)`)
+	transformNumber   = regexp.MustCompile(`[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?`)
+	transformJoin     = regexp.MustCompile(`^[\s,]*$`)
+)
+
+func identity() transform {
+	return transform{a: 1, d: 1}
+}
+
+// then applies t to the coordinates that n gives, i.e. t x n
+func (t transform) then(n transform) transform {
+	return transform{
+		a: t.a*n.a + t.c*n.b,
+		b: t.b*n.a + t.d*n.b,
+		c: t.a*n.c + t.c*n.d,
+		d: t.b*n.c + t.d*n.d,
+		e: t.a*n.e + t.c*n.f + t.e,
+		f: t.b*n.e + t.d*n.f + t.f,
+	}
+}
+
+func (t transform) apply(x, y float64) (float64, float64) {
+	return t.a*x + t.c*y + t.e, t.b*x + t.d*y + t.f
+}
+
+// scale is how much lengths are stretched, on average
+func (t transform) scale() float64 {
+	return math.Sqrt(math.Abs(t.a*t.d - t.b*t.c))
+}
+
+// axisAligned is true if rectangles stay rectangles with sides parallel to the page
+func (t transform) axisAligned() bool {
+	return (math.Abs(t.b) < transformTolerance && math.Abs(t.c) < transformTolerance) ||
+		(math.Abs(t.a) < transformTolerance && math.Abs(t.d) < transformTolerance)
+}
+
+// checkAxisAligned explains why a transform can't be used for a form field
+func (t transform) checkAxisAligned() error {
+
+	if t.axisAligned() {
+		return nil
+	}
+
+	angle := math.Atan2(t.b, t.a) * 180 / math.Pi
+
+	quarterTurns := angle / 90
+	if math.Abs(quarterTurns-math.Round(quarterTurns)) > transformTolerance {
+		return fmt.Errorf("is rotated by %.1f degrees, which can't be represented by an axis-aligned form field (rotate it back, or by a multiple of 90 degrees)", angle)
+	}
+
+	return errors.New("is skewed, which can't be represented by an axis-aligned form field")
+}
+
+// rect returns the rectangle that x, y, w, h is transformed to
+func (t transform) rect(x, y, w, h float64) (geo.Rect, error) {
+
+	if err := t.checkAxisAligned(); err != nil {
+		return geo.Rect{}, err
+	}
+
+	x1, y1 := t.apply(x, y)
+	x2, y2 := t.apply(x+w, y+h)
+
+	// a quarter turn swaps the width and height
+	dim := geo.Dim{Width: math.Abs(t.a) * w, Height: math.Abs(t.d) * h}
+	if math.Abs(t.a) < transformTolerance {
+		dim = geo.Dim{Width: math.Abs(t.c) * h, Height: math.Abs(t.b) * w}
+	}
+
+	return geo.Rect{
+		Corner: geo.Point{X: math.Min(x1, x2), Y: math.Min(y1, y2)},
+		Dim:    dim,
+	}, nil
+}
+
+// parseTransform reads a transform attribute, e.g. "translate(10,20) rotate(90)"
+func parseTransform(attr string) (transform, error) {
+
+	t := identity()
+
+	if strings.TrimSpace(attr) == "" {
+		return t, nil
+	}
+
+	last := 0
+
+	for _, match := range transformFunction.FindAllStringSubmatchIndex(attr, -1) {
+
+		if !transformJoin.MatchString(attr[last:match[0]]) {
+			return t, fmt.Errorf("can't understand transform %q at %q", attr, attr[last:match[0]])
+		}
+		last = match[1]
+
+		name := attr[match[2]:match[3]]
+		args := []float64{}
+
+		for _, s := range transformNumber.FindAllString(attr[match[4]:match[5]], -1) {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return t, fmt.Errorf("can't understand transform %q: %v", attr, err)
+			}
+			args = append(args, v)
+		}
+
+		n, err := transformFunctionMatrix(name, args)
+		if err != nil {
+			return t, fmt.Errorf("can't understand transform %q: %v", attr, err)
+		}
+
+		// the transform on the right is applied first
+		t = t.then(n)
+	}
+
+	if !transformJoin.MatchString(attr[last:]) {
+		return t, fmt.Errorf("can't understand transform %q at %q", attr, attr[last:])
+	}
+
+	return t, nil
+}
+
+func transformFunctionMatrix(name string, args []float64) (transform, error) {
+
+	wrongArgs := fmt.Errorf("%s can't have %d numbers", name, len(args))
+
+	switch name {
+
+	case "matrix":
+		if len(args) != 6 {
+			return transform{}, wrongArgs
+		}
+		return transform{a: args[0], b: args[1], c: args[2], d: args[3], e: args[4], f: args[5]}, nil
+
+	case "translate":
+		switch len(args) {
+		case 1:
+			return transform{a: 1, d: 1, e: args[0]}, nil
+		case 2:
+			return transform{a: 1, d: 1, e: args[0], f: args[1]}, nil
+		}
+		return transform{}, wrongArgs
+
+	case "scale":
+		switch len(args) {
+		case 1:
+			return transform{a: args[0], d: args[0]}, nil
+		case 2:
+			return transform{a: args[0], d: args[1]}, nil
+		}
+		return transform{}, wrongArgs
+
+	case "rotate":
+		if len(args) != 1 && len(args) != 3 {
+			return transform{}, wrongArgs
+		}
+
+		rad := args[0] * math.Pi / 180
+		cos, sin := math.Cos(rad), math.Sin(rad)
+
+		// so that rotate(90) etc come out exactly, and stay axis-aligned
+		cos, sin = math.Round(cos*1e12)/1e12, math.Round(sin*1e12)/1e12
+
+		r := transform{a: cos, b: sin, c: -sin, d: cos}
+
+		if len(args) == 3 {
+			cx, cy := args[1], args[2]
+			return transform{a: 1, d: 1, e: cx, f: cy}.then(r).then(transform{a: 1, d: 1, e: -cx, f: -cy}), nil
+		}
+		return r, nil
+
+	case "skewX":
+		if len(args) != 1 {
+			return transform{}, wrongArgs
+		}
+		return transform{a: 1, c: math.Tan(args[0] * math.Pi / 180), d: 1}, nil
+
+	case "skewY":
+		if len(args) != 1 {
+			return transform{}, wrongArgs
+		}
+		return transform{a: 1, b: math.Tan(args[0] * math.Pi / 180), d: 1}, nil
+	}
+
+	return transform{}, fmt.Errorf("unknown transform %s", name)
+}
+
+// walkGroup calls fn for the group, and each group inside it, with the
+// transform from that group's coordinates to those of the document. Layers
+// inside the group are layers in their own right, so they are left out.
+func walkGroup(g *Cg__svg, parent transform, fn func(g *Cg__svg, t transform) error) error {
+
+	gt, err := parseTransform(g.Transform)
+	if err != nil {
+		return fmt.Errorf("group %s: %v", groupName(g), err)
+	}
+
+	t := parent.then(gt)
+
+	err = fn(g, t)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range g.Cg__svg {
+		if isLayer(child) {
+			continue
+		}
+		err = walkGroup(child, t, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isLayer is true for Inkscape layers, and for groups that have the name of one of our
+// layers, which is what a layer becomes if it is pasted in from another document
+func isLayer(g *Cg__svg) bool {
+
+	if g.AttrInkscapeSpacegroupmode == inkscapeLayer {
+		return true
+	}
+
+	switch g.AttrInkscapeSpacelabel {
+	case geo.AnchorsLayer, geo.ChromeLayer, geo.TextFieldsLayer, geo.TextPrefillsLayer,
+		geo.ComboBoxesLayer, geo.CheckBoxesLayer, geo.RadioButtonsLayer, geo.PagesLayer, geo.ImagesLayer:
+		return true
+	}
+
+	return false
+}
+
+func groupName(g *Cg__svg) string {
+	if g.AttrInkscapeSpacelabel != "" {
+		return g.AttrInkscapeSpacelabel
+	}
+	return g.Attrid
+}
+
+// placeRect returns where the rect is in the document, given the transform of its group
+func placeRect(r *Crect__svg, t transform) (geo.Rect, error) {
+
+	w, err := strconv.ParseFloat(r.Width, 64)
+	if err != nil {
+		return geo.Rect{}, err
+	}
+	h, err := strconv.ParseFloat(r.Height, 64)
+	if err != nil {
+		return geo.Rect{}, err
+	}
+
+	// svg defaults a missing position to zero
+	x, y := 0.0, 0.0
+
+	if r.Rx != "" {
+		x, err = strconv.ParseFloat(r.Rx, 64)
+		if err != nil {
+			return geo.Rect{}, err
+		}
+	}
+	if r.Ry != "" {
+		y, err = strconv.ParseFloat(r.Ry, 64)
+		if err != nil {
+			return geo.Rect{}, err
+		}
+	}
+
+	rt, err := parseTransform(r.Transform)
+	if err != nil {
+		return geo.Rect{}, fmt.Errorf("rect %s: %v", rectName(r), err)
+	}
+
+	rect, err := t.then(rt).rect(x, y, w, h)
+	if err != nil {
+		return geo.Rect{}, fmt.Errorf("rect %s %v", rectName(r), err)
+	}
+
+	return rect, nil
+}
+
+// placePath returns where the centre of an (Inkscape) circle is in the document
+func placePath(p *Cpath__svg, t transform) (geo.Point, error) {
+
+	x, err := strconv.ParseFloat(p.Cx, 64)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	y, err := strconv.ParseFloat(p.Cy, 64)
+	if err != nil {
+		return geo.Point{}, err
+	}
+
+	pt, err := parseTransform(p.Transform)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("path %s: %v", p.ID, err)
+	}
+
+	x, y = t.then(pt).apply(x, y)
+
+	return geo.Point{X: x, Y: y}, nil
+}
+
+func rectName(r *Crect__svg) string {
+	if r.Title != nil && r.Title.String != "" {
+		return r.Title.String
+	}
+	return r.Id
+}
+
+// walkLayerRects calls fn with the position in the document of each rect
+// in the layer(s) with this label, including those in groups inside it
+func walkLayerRects(svg *Csvg__svg, label string, fn func(r *Crect__svg, rect geo.Rect) error) error {
+
+	for _, layer := range svg.Cg__svg {
+
+		if layer.AttrInkscapeSpacelabel != label {
+			continue
+		}
+
+		err := walkGroup(layer, identity(), func(g *Cg__svg, t transform) error {
+			for _, r := range g.Crect__svg {
+				rect, err := placeRect(r, t)
+				if err != nil {
+					return fmt.Errorf("%s layer: %v", label, err)
+				}
+				err = fn(r, rect)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkLayerPaths calls fn with the position in the document of the centre of
+// each path in the layer(s) with this label, including those in groups inside it
+func walkLayerPaths(svg *Csvg__svg, label string, fn func(p *Cpath__svg, pos geo.Point) error) error {
+
+	for _, layer := range svg.Cg__svg {
+
+		if layer.AttrInkscapeSpacelabel != label {
+			continue
+		}
+
+		err := walkGroup(layer, identity(), func(g *Cg__svg, t transform) error {
+			for _, p := range g.Cpath__svg {
+				pos, err := placePath(p, t)
+				if err != nil {
+					return fmt.Errorf("%s layer: %v", label, err)
+				}
+				err = fn(p, pos)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
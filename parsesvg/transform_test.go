@@ -0,0 +1,174 @@
+package parsesvg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timdrysdale/gradex-cli/geo"
+)
+
+func assertPoint(t *testing.T, tr transform, x, y, wantX, wantY float64) {
+	gotX, gotY := tr.apply(x, y)
+	assert.InDelta(t, wantX, gotX, 1e-9)
+	assert.InDelta(t, wantY, gotY, 1e-9)
+}
+
+func TestParseTransform(t *testing.T) {
+
+	tr, err := parseTransform("")
+	assert.NoError(t, err)
+	assert.Equal(t, identity(), tr)
+
+	tr, err = parseTransform("translate(10,20)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 2, 11, 22)
+
+	tr, err = parseTransform("translate(10)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 2, 11, 2)
+
+	tr, err = parseTransform("scale(2)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 2, 2, 4)
+
+	tr, err = parseTransform("scale(2 3)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 2, 2, 6)
+
+	tr, err = parseTransform("matrix(1,0,0,1,-5.5,1e1)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 0, 0, -5.5, 10)
+
+	tr, err = parseTransform("rotate(90)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 0, 0, 1)
+
+	tr, err = parseTransform("rotate(180, 10, 10)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 0, 0, 20, 20)
+
+	tr, err = parseTransform("skewX(45)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 0, 1, 1, 1)
+
+	tr, err = parseTransform("skewY(45)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 0, 1, 1)
+
+	// the right-most transform is applied first
+	tr, err = parseTransform("translate(10,0) scale(2)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 1, 12, 2)
+
+	tr, err = parseTransform("scale(2),translate(10,0)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 1, 1, 22, 2)
+
+	// numbers can run together
+	tr, err = parseTransform("translate(10-5)")
+	assert.NoError(t, err)
+	assertPoint(t, tr, 0, 0, 10, -5)
+
+	for _, bad := range []string{
+		"translate(1,2",
+		"shift(1,2)",
+		"matrix(1,2,3)",
+		"rotate(1,2)",
+		"translate(1,2) oops",
+	} {
+		_, err = parseTransform(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestTransformRect(t *testing.T) {
+
+	tr, err := parseTransform("rotate(90)")
+	assert.NoError(t, err)
+
+	rect, err := tr.rect(10, 0, 20, 5)
+	assert.NoError(t, err)
+	assert.InDelta(t, -5, rect.Corner.X, 1e-9)
+	assert.InDelta(t, 10, rect.Corner.Y, 1e-9)
+	assert.InDelta(t, 5, rect.Dim.Width, 1e-9)
+	assert.InDelta(t, 20, rect.Dim.Height, 1e-9)
+
+	tr, err = parseTransform("scale(-1,1)")
+	assert.NoError(t, err)
+
+	rect, err = tr.rect(10, 0, 20, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: -30, Y: 0}, Dim: geo.Dim{Width: 20, Height: 5}}, rect)
+
+	tr, err = parseTransform("rotate(30)")
+	assert.NoError(t, err)
+
+	_, err = tr.rect(0, 0, 10, 10)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "rotated by 30.0 degrees")
+
+	tr, err = parseTransform("skewX(10)")
+	assert.NoError(t, err)
+
+	_, err = tr.rect(0, 0, 10, 10)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "skewed")
+
+	assert.InDelta(t, 2, transform{a: 2, d: 2}.scale(), 1e-9)
+	assert.InDelta(t, 1, transform{a: math.Sqrt(0.5), b: math.Sqrt(0.5), c: -math.Sqrt(0.5), d: math.Sqrt(0.5)}.scale(), 1e-9)
+}
+
+const transformTestSvg = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="anchors" transform="matrix(1,0,0,1,5,5)">
+<path sodipodi:cx="1" sodipodi:cy="2" transform="scale(2)"><title>ref-anchor</title></path>
+</g>
+<g inkscape:label="textfields" transform="translate(10,0)">
+<rect x="0" y="0" width="10" height="5"><title>outer</title></rect>
+<g transform="scale(2)">
+<g transform="translate(0,10)">
+<rect x="1" y="1" width="10" height="5"><title>inner</title></rect>
+</g>
+</g>
+<g transform="rotate(90)">
+<rect x="0" y="0" width="10" height="5"><title>turned</title></rect>
+</g>
+</g>
+</svg>`
+
+func TestDefineLadderNestedTransforms(t *testing.T) {
+
+	ladder, err := DefineLadderFromSVG([]byte(transformTestSvg))
+	assert.NoError(t, err)
+
+	assert.Equal(t, geo.Point{X: 7, Y: 9}, ladder.Anchor)
+
+	rects := make(map[string]geo.Rect)
+	for _, tf := range ladder.TextFields {
+		rects[tf.ID] = tf.Rect
+	}
+
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 10, Y: 0}, Dim: geo.Dim{Width: 10, Height: 5}}, rects["outer"])
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 12, Y: 22}, Dim: geo.Dim{Width: 20, Height: 10}}, rects["inner"])
+	assert.Equal(t, geo.Rect{Corner: geo.Point{X: 5, Y: 0}, Dim: geo.Dim{Width: 5, Height: 10}}, rects["turned"])
+}
+
+func TestDefineLadderRotatedField(t *testing.T) {
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="100pt" height="100pt">
+<metadata/>
+<sodipodi:namedview inkscape:document-units="pt"/>
+<g inkscape:label="textfields">
+<g transform="rotate(15)">
+<rect x="0" y="0" width="10" height="5"><title>q1-mark</title></rect>
+</g>
+</g>
+</svg>`
+
+	_, err := DefineLadderFromSVG([]byte(svg))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "q1-mark")
+	assert.Contains(t, err.Error(), "axis-aligned")
+}
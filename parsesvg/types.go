@@ -4,6 +4,7 @@ import (
 	"github.com/timdrysdale/gradex-cli/comment"
 	"github.com/timdrysdale/gradex-cli/geo"
 	"github.com/timdrysdale/gradex-cli/pagedata"
+	"github.com/timdrysdale/unipdf/v3/model"
 )
 
 type SpreadContents struct {
@@ -22,6 +23,17 @@ type SpreadContents struct {
 	ComboBoxes                DocComboBoxes
 	TemplatePathsRelative     bool
 	PrefillImagePathsRelative bool
+	Fiducials                 bool           // draw registration marks in the layout's fiducial box, see optical.Register
+	IdentityCode              bool           // draw a QR code of the page identity, see pagedata.PageIdentity
+	ImageQuality              int            // JPEG quality of images in the PDF, 90 if not set
+	ImageUpperPPI             float64        // images above this resolution are downsampled, 150 if not set
+	Bilevel                   bool           // store the previous image at one bit per pixel
+	PreviousPage              *model.PdfPage // draw this page, as it is, instead of the previous image
+	ComboBoxValues            DocPrefills    // the option chosen in each combobox, if any
+	Debug                     bool           // outline the textfields, optical boxes and anchors, see RenderPreview
+	DebugShrink               float64        // how far inside their textfields the optical boxes are, in points
+	PrefillValues             *PrefillValues // for the template expressions in textprefills, instead of those from the page, see RenderPreview
+	TemplateDirs              []string       // look for ladders, chrome and fonts here first, if template paths are relative, see findTemplateFile
 }
 
 type PagePrefills map[string]string
@@ -50,6 +62,25 @@ type ComboBox struct {
 	Options    ComboOptions
 }
 
+// a checkbox is ticked to start with if its description is not empty
+type CheckBox struct {
+	Rect    geo.Rect
+	ID      string
+	Prefill string
+}
+
+// the buttons of a radio group share the group's ID as their title,
+// and each has the option it stands for as its description
+type RadioGroup struct {
+	ID      string
+	Buttons []RadioButton
+}
+
+type RadioButton struct {
+	Rect   geo.Rect
+	Option string
+}
+
 // we read the properties from a JSON object in the Description field
 // and then apply when writing the text field - these are private fields
 // in the Paragraph struct in unipdf
@@ -80,6 +111,8 @@ type Ladder struct {
 	TextFields   []TextField
 	TextPrefills []TextPrefill
 	ComboBoxes   []ComboBox
+	CheckBoxes   []CheckBox
+	RadioGroups  []RadioGroup
 }
 
 type Layout struct {
@@ -90,6 +123,7 @@ type Layout struct {
 	PageDims  map[string]geo.Dim   `json:"pageDims"`
 	Filenames map[string]string    `json:"filenames"`
 	ImageDims map[string]geo.Dim   `json:"ImageDims"`
+	Fonts     map[string]string    `json:"fonts"` // font file (or standard font) for each page that names one, see font.go
 }
 
 //TODO move this to types.go; add json tags
@@ -102,6 +136,8 @@ type Spread struct {
 	TextFields   []TextField
 	TextPrefills []TextPrefill
 	ComboBoxes   []ComboBox
+	CheckBoxes   []CheckBox
+	RadioGroups  []RadioGroup
 }
 
 type ImageInsert struct {
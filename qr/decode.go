@@ -0,0 +1,599 @@
+package qr
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+// Decoding: the image is turned into black and white with a global threshold,
+// then every row is scanned for the 1:1:3:1:1 run lengths across a finder
+// pattern, checking each hit down the column and back across the row. Three
+// finders that make a right-angled isosceles triangle give the position,
+// orientation and module size of the code, and hence its version (we try the
+// other versions too, nearest first, because the module size is over-estimated
+// when the code is turned near 45 degrees). The modules are
+// sampled with an affine map from the finder centres, so the code can be
+// rotated, scaled or sheared, but not much distorted by perspective.
+
+var ErrNotFound = errors.New("no QR code found")
+
+type finder struct {
+	x, y   float64
+	module float64
+	count  int
+}
+
+type detector struct {
+	w, h      int
+	lum       []uint8
+	threshold uint8
+}
+
+// Decode finds a QR code in the image, and returns its contents
+func Decode(im image.Image) ([]byte, error) {
+
+	d := newDetector(im)
+
+	finders := d.findFinders()
+
+	tl, tr, bl, ok := pickFinders(finders)
+
+	if !ok {
+		return []byte{}, ErrNotFound
+	}
+
+	module := (tl.module + tr.module + bl.module) / 3
+	across := (distance(tl, tr) + distance(tl, bl)) / 2
+
+	dim := int(math.Round(across/module)) + 7
+
+	switch dim % 4 {
+	case 0:
+		dim++
+	case 2:
+		dim--
+	case 3:
+		dim += 2
+	}
+
+	estimate := (dim - 17) / 4
+
+	var lastErr error = ErrNotFound
+
+	versions := []int{}
+	for v := minVersion; v <= maxVersion; v++ {
+		versions = append(versions, v)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return abs(versions[i]-estimate) < abs(versions[j]-estimate)
+	})
+
+	for _, version := range versions {
+
+		data, err := d.decodeVersion(version, tl, tr, bl)
+
+		if err == nil {
+			return data, nil
+		}
+
+		lastErr = err
+	}
+
+	return []byte{}, lastErr
+}
+
+func newDetector(im image.Image) *detector {
+
+	b := im.Bounds()
+
+	d := &detector{
+		w:   b.Dx(),
+		h:   b.Dy(),
+		lum: make([]uint8, b.Dx()*b.Dy()),
+	}
+
+	switch src := im.(type) {
+
+	case *image.Gray:
+		for y := 0; y < d.h; y++ {
+			start := src.PixOffset(b.Min.X, b.Min.Y+y)
+			copy(d.lum[y*d.w:(y+1)*d.w], src.Pix[start:start+d.w])
+		}
+
+	case *image.YCbCr:
+		for y := 0; y < d.h; y++ {
+			start := src.YOffset(b.Min.X, b.Min.Y+y)
+			copy(d.lum[y*d.w:(y+1)*d.w], src.Y[start:start+d.w])
+		}
+
+	default:
+		for y := 0; y < d.h; y++ {
+			for x := 0; x < d.w; x++ {
+				d.lum[y*d.w+x] = color.GrayModel.Convert(im.At(b.Min.X+x, b.Min.Y+y)).(color.Gray).Y
+			}
+		}
+	}
+
+	d.threshold = otsu(d.lum)
+
+	return d
+}
+
+// otsu finds the threshold that best splits the histogram in two
+func otsu(lum []uint8) uint8 {
+
+	var hist [256]float64
+
+	for _, v := range lum {
+		hist[v]++
+	}
+
+	total := float64(len(lum))
+
+	sum := 0.0
+	for i, n := range hist {
+		sum += float64(i) * n
+	}
+
+	best := 0.0
+	threshold := 128
+
+	sumBelow := 0.0
+	below := 0.0
+
+	for t := 0; t < 256; t++ {
+
+		below += hist[t]
+		if below == 0 {
+			continue
+		}
+
+		above := total - below
+		if above == 0 {
+			break
+		}
+
+		sumBelow += float64(t) * hist[t]
+
+		meanBelow := sumBelow / below
+		meanAbove := (sum - sumBelow) / above
+
+		between := below * above * (meanBelow - meanAbove) * (meanBelow - meanAbove)
+
+		if between > best {
+			best = between
+			threshold = t + 1
+		}
+	}
+
+	return uint8(threshold)
+}
+
+func (d *detector) dark(x, y int) bool {
+	return d.lum[y*d.w+x] < d.threshold
+}
+
+// patternOK checks run lengths are close enough to 1:1:3:1:1
+func patternOK(counts [5]int) bool {
+
+	total := 0
+	for _, c := range counts {
+		if c == 0 {
+			return false
+		}
+		total += c
+	}
+
+	if total < 7 {
+		return false
+	}
+
+	module := float64(total) / 7
+	variance := module / 2
+
+	return math.Abs(module-float64(counts[0])) < variance &&
+		math.Abs(module-float64(counts[1])) < variance &&
+		math.Abs(3*module-float64(counts[2])) < 3*variance &&
+		math.Abs(module-float64(counts[3])) < variance &&
+		math.Abs(module-float64(counts[4])) < variance
+}
+
+func sum(counts [5]int) int {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	return total
+}
+
+func (d *detector) findFinders() []finder {
+
+	finders := []finder{}
+
+	for y := 0; y < d.h; y++ {
+
+		// run lengths along the row, starting with a light run (maybe empty)
+		runs := []int{}
+		starts := []int{}
+
+		current := false
+		length := 0
+
+		for x := 0; x < d.w; x++ {
+			if d.dark(x, y) != current {
+				runs = append(runs, length)
+				starts = append(starts, x-length)
+				current = !current
+				length = 0
+			}
+			length++
+		}
+
+		runs = append(runs, length)
+		starts = append(starts, d.w-length)
+
+		// odd runs are dark
+		for i := 1; i+4 < len(runs); i += 2 {
+
+			counts := [5]int{runs[i], runs[i+1], runs[i+2], runs[i+3], runs[i+4]}
+
+			if !patternOK(counts) {
+				continue
+			}
+
+			cx := float64(starts[i+2]) + float64(runs[i+2])/2
+
+			f, ok := d.crossCheck(cx, float64(y), sum(counts))
+
+			if ok {
+				finders = addFinder(finders, f)
+			}
+		}
+	}
+
+	return finders
+}
+
+// crossCheck confirms a finder seen on a row by looking down its
+// column, then back across the row at the middle of the column
+func (d *detector) crossCheck(cx, cy float64, rowTotal int) (finder, bool) {
+
+	y, vTotal, ok := d.crossCheckLine(int(cx), int(cy), rowTotal, false)
+	if !ok {
+		return finder{}, false
+	}
+
+	x, hTotal, ok := d.crossCheckLine(int(cx), int(y), rowTotal, true)
+	if !ok {
+		return finder{}, false
+	}
+
+	return finder{
+		x:      x,
+		y:      y,
+		module: float64(vTotal+hTotal) / 14,
+		count:  1,
+	}, true
+}
+
+// crossCheckLine measures the finder pattern through (x,y) along a row or
+// column, returning the centre on that line, and the total width
+func (d *detector) crossCheckLine(x, y, expected int, horizontal bool) (float64, int, bool) {
+
+	pos, limit := y, d.h
+	if horizontal {
+		pos, limit = x, d.w
+	}
+
+	dark := func(p int) bool {
+		if horizontal {
+			return d.dark(p, y)
+		}
+		return d.dark(x, p)
+	}
+
+	if !dark(pos) {
+		return 0, 0, false
+	}
+
+	var counts [5]int
+
+	p := pos
+	for p >= 0 && dark(p) {
+		counts[2]++
+		p--
+	}
+	for p >= 0 && !dark(p) && counts[1] <= expected {
+		counts[1]++
+		p--
+	}
+	for p >= 0 && dark(p) && counts[0] <= expected {
+		counts[0]++
+		p--
+	}
+
+	p = pos + 1
+	for p < limit && dark(p) {
+		counts[2]++
+		p++
+	}
+	for p < limit && !dark(p) && counts[3] <= expected {
+		counts[3]++
+		p++
+	}
+	for p < limit && dark(p) && counts[4] <= expected {
+		counts[4]++
+		p++
+	}
+
+	total := sum(counts)
+
+	if 5*abs(total-expected) >= 2*expected || !patternOK(counts) {
+		return 0, 0, false
+	}
+
+	centre := float64(p-counts[4]-counts[3]) - float64(counts[2])/2
+
+	return centre, total, true
+}
+
+// addFinder merges the finder with one already found in the same place
+func addFinder(finders []finder, f finder) []finder {
+
+	for i, g := range finders {
+		if math.Abs(g.x-f.x) <= g.module && math.Abs(g.y-f.y) <= g.module {
+			n := float64(g.count)
+			finders[i] = finder{
+				x:      (g.x*n + f.x) / (n + 1),
+				y:      (g.y*n + f.y) / (n + 1),
+				module: (g.module*n + f.module) / (n + 1),
+				count:  g.count + 1,
+			}
+			return finders
+		}
+	}
+
+	return append(finders, f)
+}
+
+func distance(a, b finder) float64 {
+	return math.Hypot(a.x-b.x, a.y-b.y)
+}
+
+// pickFinders chooses the three finders that look most like the corners
+// of a code, and sorts them into top-left, top-right and bottom-left
+func pickFinders(finders []finder) (finder, finder, finder, bool) {
+
+	sort.Slice(finders, func(i, j int) bool {
+		return finders[i].count > finders[j].count
+	})
+
+	if len(finders) > 12 {
+		finders = finders[:12]
+	}
+
+	bestScore := 0.3
+	var best [3]finder
+	found := false
+
+	for i := 0; i < len(finders); i++ {
+		for j := i + 1; j < len(finders); j++ {
+			for k := j + 1; k < len(finders); k++ {
+
+				a, b, c := finders[i], finders[j], finders[k]
+
+				small := math.Min(a.module, math.Min(b.module, c.module))
+				large := math.Max(a.module, math.Max(b.module, c.module))
+
+				if large > 1.5*small {
+					continue
+				}
+
+				// put the corner (opposite the longest side) first
+				ab, ac, bc := distance(a, b), distance(a, c), distance(b, c)
+
+				corner, p, q := a, b, c
+				hyp, s1, s2 := bc, ab, ac
+
+				if ab > hyp {
+					corner, p, q = c, a, b
+					hyp, s1, s2 = ab, ac, bc
+				}
+				if ac > hyp {
+					corner, p, q = b, a, c
+					hyp, s1, s2 = ac, ab, bc
+				}
+
+				side := (s1 + s2) / 2
+
+				if side < 7*small {
+					continue
+				}
+
+				score := math.Abs(s1-s2)/side + math.Abs(hyp-math.Sqrt2*side)/hyp
+
+				if score < bestScore {
+					bestScore = score
+					best = [3]finder{corner, p, q}
+					found = true
+				}
+			}
+		}
+	}
+
+	if !found {
+		return finder{}, finder{}, finder{}, false
+	}
+
+	corner, p, q := best[0], best[1], best[2]
+
+	// top-right is clockwise from bottom-left, as seen with y down the page
+	if (p.x-corner.x)*(q.y-corner.y)-(p.y-corner.y)*(q.x-corner.x) < 0 {
+		p, q = q, p
+	}
+
+	return corner, p, q, true
+}
+
+func (d *detector) decodeVersion(version int, tl, tr, bl finder) ([]byte, error) {
+
+	size := sizeOf(version)
+
+	// map module centres to the image, with the finder centres at 3.5 modules in
+	span := float64(size - 7)
+	ux, uy := (tr.x-tl.x)/span, (tr.y-tl.y)/span
+	vx, vy := (bl.x-tl.x)/span, (bl.y-tl.y)/span
+	ox := tl.x - 3.5*(ux+vx)
+	oy := tl.y - 3.5*(uy+vy)
+
+	module := math.Hypot(ux, uy)
+	r := int(module * 0.2)
+
+	m := newMatrix(version)
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+
+			px := ox + (float64(x)+0.5)*ux + (float64(y)+0.5)*vx
+			py := oy + (float64(x)+0.5)*uy + (float64(y)+0.5)*vy
+
+			m.modules[y*size+x] = d.sample(int(px), int(py), r)
+		}
+	}
+
+	level, mask, err := m.bestFormat()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	m.applyMask(mask)
+
+	spec := blockSpecs[version][level]
+
+	data := []byte{}
+
+	for _, block := range deinterleave(m.readCodewords(spec.totalCodewords()), spec) {
+
+		_, err := rsCorrect(block, spec.ec)
+		if err != nil {
+			return []byte{}, fmt.Errorf("version %d: %s", version, err.Error())
+		}
+
+		data = append(data, block[:len(block)-spec.ec]...)
+	}
+
+	return parseData(data, version)
+}
+
+// sample is true if the square of radius r around x,y is mostly dark
+func (d *detector) sample(x, y, r int) bool {
+
+	total, n := 0, 0
+
+	for j := y - r; j <= y+r; j++ {
+		for i := x - r; i <= x+r; i++ {
+			if i < 0 || j < 0 || i >= d.w || j >= d.h {
+				continue
+			}
+			total += int(d.lum[j*d.w+i])
+			n++
+		}
+	}
+
+	if n == 0 {
+		return false
+	}
+
+	return total < n*int(d.threshold)
+}
+
+// bestFormat reads the format information, allowing up to three
+// wrong bits, which is as far as the codes can be apart and still be sure
+func (m *matrix) bestFormat() (Level, int, error) {
+
+	a, b := m.readFormat()
+
+	bestDistance := 4
+	bestLevel, bestMask := L, 0
+
+	for level := L; level <= H; level++ {
+		for mask := 0; mask < 8; mask++ {
+			f := formatInfo(level, mask)
+			for _, got := range []int{a, b} {
+				if n := bitCount(f ^ got); n < bestDistance {
+					bestDistance = n
+					bestLevel, bestMask = level, mask
+				}
+			}
+		}
+	}
+
+	if bestDistance > 3 {
+		return L, 0, errors.New("can't read format information")
+	}
+
+	return bestLevel, bestMask, nil
+}
+
+func bitCount(x int) int {
+	n := 0
+	for ; x != 0; x &= x - 1 {
+		n++
+	}
+	return n
+}
+
+// parseData reads the byte mode segments from the data codewords
+func parseData(data []byte, version int) ([]byte, error) {
+
+	result := []byte{}
+
+	pos := 0
+	length := len(data) * 8
+
+	read := func(n int) int {
+		v := 0
+		for i := 0; i < n; i++ {
+			v <<= 1
+			if (data[pos/8]>>uint(7-pos%8))&1 != 0 {
+				v |= 1
+			}
+			pos++
+		}
+		return v
+	}
+
+	for length-pos >= 4 {
+
+		mode := read(4)
+
+		if mode == 0 {
+			break // terminator
+		}
+
+		if mode != 4 {
+			return []byte{}, fmt.Errorf("unsupported mode %d (only byte mode can be read)", mode)
+		}
+
+		if length-pos < countBits(version) {
+			return []byte{}, errors.New("data ends in the byte count")
+		}
+
+		n := read(countBits(version))
+
+		if length-pos < 8*n {
+			return []byte{}, fmt.Errorf("byte count %d is longer than the data", n)
+		}
+
+		for i := 0; i < n; i++ {
+			result = append(result, byte(read(8)))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,174 @@
+package qr
+
+import "fmt"
+
+// Encode makes the smallest code at this level that holds the data,
+// in byte mode, using whichever mask scores best
+func Encode(data []byte, level Level) (*Code, error) {
+
+	if level < L || level > H {
+		return nil, fmt.Errorf("unknown error correction level %d", level)
+	}
+
+	version := 0
+
+	for v := minVersion; v <= maxVersion; v++ {
+		if len(data) <= Capacity(v, level) {
+			version = v
+			break
+		}
+	}
+
+	if version == 0 {
+		return nil, fmt.Errorf("%d bytes is too long for a code (at most %d at this level)", len(data), Capacity(maxVersion, level))
+	}
+
+	codewords := interleave(dataCodewords(data, version, level), blockSpecs[version][level])
+
+	var best *matrix
+	bestMask := 0
+	bestPenalty := 0
+
+	for mask := 0; mask < 8; mask++ {
+
+		m := newMatrix(version)
+		m.placeCodewords(codewords)
+		m.applyMask(mask)
+		m.drawFormat(level, mask)
+
+		p := m.penalty()
+
+		if best == nil || p < bestPenalty {
+			best = m
+			bestMask = mask
+			bestPenalty = p
+		}
+	}
+
+	return &Code{
+		Version: version,
+		Level:   level,
+		Mask:    bestMask,
+		Size:    best.size,
+		modules: best.modules,
+	}, nil
+}
+
+type bitBuffer struct {
+	bytes []byte
+	n     int
+}
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		if b.n%8 == 0 {
+			b.bytes = append(b.bytes, 0)
+		}
+		if (value>>uint(i))&1 != 0 {
+			b.bytes[b.n/8] |= 1 << uint(7-b.n%8)
+		}
+		b.n++
+	}
+}
+
+// dataCodewords is the byte mode segment, terminated and padded to fill the code
+func dataCodewords(data []byte, version int, level Level) []byte {
+
+	capacity := blockSpecs[version][level].dataCodewords()
+
+	b := &bitBuffer{}
+
+	b.append(4, 4) // byte mode
+	b.append(len(data), countBits(version))
+
+	for _, d := range data {
+		b.append(int(d), 8)
+	}
+
+	terminator := capacity*8 - b.n
+	if terminator > 4 {
+		terminator = 4
+	}
+	b.append(0, terminator)
+	b.append(0, (8-b.n%8)%8)
+
+	for pad := 0xec; len(b.bytes) < capacity; pad ^= 0xec ^ 0x11 {
+		b.append(pad, 8)
+	}
+
+	return b.bytes
+}
+
+// interleave splits the data into blocks, adds the error correction to each,
+// and takes a codeword from each block in turn
+func interleave(data []byte, spec blockSpec) []byte {
+
+	blocks := [][]byte{}
+	ecs := [][]byte{}
+
+	start := 0
+	longest := 0
+
+	for _, n := range spec.blockLengths() {
+		block := data[start : start+n]
+		blocks = append(blocks, block)
+		ecs = append(ecs, rsEncode(block, spec.ec))
+		start += n
+		if n > longest {
+			longest = n
+		}
+	}
+
+	result := []byte{}
+
+	for i := 0; i < longest; i++ {
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	for i := 0; i < spec.ec; i++ {
+		for _, ec := range ecs {
+			result = append(result, ec[i])
+		}
+	}
+
+	return result
+}
+
+// deinterleave undoes interleave, returning each block with its
+// error correction codewords on the end
+func deinterleave(codewords []byte, spec blockSpec) [][]byte {
+
+	lengths := spec.blockLengths()
+	blocks := make([][]byte, len(lengths))
+
+	longest := 0
+	for _, n := range lengths {
+		if n > longest {
+			longest = n
+		}
+	}
+
+	k := 0
+
+	for i := 0; i < longest; i++ {
+		for j, n := range lengths {
+			if i < n {
+				blocks[j] = append(blocks[j], codewords[k])
+				k++
+			}
+		}
+	}
+
+	for i := 0; i < spec.ec; i++ {
+		for j := range blocks {
+			blocks[j] = append(blocks[j], codewords[k])
+			k++
+		}
+	}
+
+	return blocks
+}
@@ -0,0 +1,365 @@
+package qr
+
+// matrix holds the modules of a code while it is drawn or read, and which
+// of them are function patterns (finders, timing, alignment, format and
+// version information) rather than data
+
+type matrix struct {
+	size     int
+	modules  []bool
+	function []bool
+}
+
+// newMatrix draws the function patterns for a version, with placeholder
+// format information
+func newMatrix(version int) *matrix {
+
+	size := sizeOf(version)
+
+	m := &matrix{
+		size:     size,
+		modules:  make([]bool, size*size),
+		function: make([]bool, size*size),
+	}
+
+	for i := 0; i < size; i++ {
+		m.setFunction(6, i, i%2 == 0)
+		m.setFunction(i, 6, i%2 == 0)
+	}
+
+	m.drawFinder(3, 3)
+	m.drawFinder(size-4, 3)
+	m.drawFinder(3, size-4)
+
+	positions := alignmentPositions[version]
+	last := len(positions) - 1
+
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue // these are the finders
+			}
+			m.drawAlignment(x, y)
+		}
+	}
+
+	m.drawFormat(M, 0)
+	m.drawVersion(version)
+
+	return m
+}
+
+func (m *matrix) at(x, y int) bool {
+	return m.modules[y*m.size+x]
+}
+
+func (m *matrix) isFunction(x, y int) bool {
+	return m.function[y*m.size+x]
+}
+
+func (m *matrix) setFunction(x, y int, dark bool) {
+	m.modules[y*m.size+x] = dark
+	m.function[y*m.size+x] = true
+}
+
+func (m *matrix) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || y < 0 || x >= m.size || y >= m.size {
+				continue
+			}
+			d := maxAbs(dx, dy)
+			m.setFunction(x, y, d != 2 && d != 4)
+		}
+	}
+}
+
+func (m *matrix) drawAlignment(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			m.setFunction(cx+dx, cy+dy, maxAbs(dx, dy) != 1)
+		}
+	}
+}
+
+func formatInfo(level Level, mask int) int {
+	data := levelBits[level]<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+func versionInfo(version int) int {
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1f25)
+	}
+	return version<<12 | rem
+}
+
+// formatPositions gives where each bit of the two copies of the format
+// information goes, least significant bit first
+func (m *matrix) formatPositions() (first, second [15][2]int) {
+
+	for i := 0; i < 6; i++ {
+		first[i] = [2]int{8, i}
+	}
+	first[6] = [2]int{8, 7}
+	first[7] = [2]int{8, 8}
+	first[8] = [2]int{7, 8}
+	for i := 9; i < 15; i++ {
+		first[i] = [2]int{14 - i, 8}
+	}
+
+	for i := 0; i < 8; i++ {
+		second[i] = [2]int{m.size - 1 - i, 8}
+	}
+	for i := 8; i < 15; i++ {
+		second[i] = [2]int{8, m.size - 15 + i}
+	}
+
+	return first, second
+}
+
+func (m *matrix) drawFormat(level Level, mask int) {
+
+	bits := formatInfo(level, mask)
+
+	first, second := m.formatPositions()
+
+	for i := 0; i < 15; i++ {
+		dark := (bits>>uint(i))&1 != 0
+		m.setFunction(first[i][0], first[i][1], dark)
+		m.setFunction(second[i][0], second[i][1], dark)
+	}
+
+	m.setFunction(8, m.size-8, true) // always dark
+}
+
+// readFormat returns the two copies of the format information
+func (m *matrix) readFormat() (int, int) {
+
+	first, second := m.formatPositions()
+
+	a, b := 0, 0
+
+	for i := 0; i < 15; i++ {
+		if m.at(first[i][0], first[i][1]) {
+			a |= 1 << uint(i)
+		}
+		if m.at(second[i][0], second[i][1]) {
+			b |= 1 << uint(i)
+		}
+	}
+
+	return a, b
+}
+
+func (m *matrix) drawVersion(version int) {
+
+	if version < 7 {
+		return
+	}
+
+	bits := versionInfo(version)
+
+	for i := 0; i < 18; i++ {
+		dark := (bits>>uint(i))&1 != 0
+		a := m.size - 11 + i%3
+		b := i / 3
+		m.setFunction(a, b, dark)
+		m.setFunction(b, a, dark)
+	}
+}
+
+// dataOrder calls place for every data module, in the order the codeword
+// bits go, i.e. pairs of columns zig-zagging up and down from the right
+func (m *matrix) dataOrder(place func(x, y int)) {
+
+	for right := m.size - 1; right >= 1; right -= 2 {
+
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+
+		upward := (right+1)&2 == 0
+
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if upward {
+					y = m.size - 1 - vert
+				}
+				if !m.isFunction(x, y) {
+					place(x, y)
+				}
+			}
+		}
+	}
+}
+
+func (m *matrix) placeCodewords(codewords []byte) {
+
+	i := 0
+
+	m.dataOrder(func(x, y int) {
+		if i < len(codewords)*8 {
+			m.modules[y*m.size+x] = (codewords[i/8]>>uint(7-i%8))&1 != 0
+		}
+		i++ // any remainder bits are left light
+	})
+}
+
+func (m *matrix) readCodewords(n int) []byte {
+
+	codewords := make([]byte, n)
+	i := 0
+
+	m.dataOrder(func(x, y int) {
+		if i < n*8 && m.at(x, y) {
+			codewords[i/8] |= 1 << uint(7-i%8)
+		}
+		i++
+	})
+
+	return codewords
+}
+
+func maskBit(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	case 7:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+	return false
+}
+
+// applyMask flips the data modules where the mask is true, so
+// applying it twice undoes it
+func (m *matrix) applyMask(mask int) {
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			if !m.isFunction(x, y) && maskBit(mask, x, y) {
+				m.modules[y*m.size+x] = !m.modules[y*m.size+x]
+			}
+		}
+	}
+}
+
+// penalty scores how hard the code might be to read, so we can pick the
+// mask that avoids large blocks, long runs, and finder-like patterns
+func (m *matrix) penalty() int {
+
+	n := m.size
+	score := 0
+	dark := 0
+
+	finderLike := []bool{true, false, true, true, true, false, true}
+
+	for i := 0; i < n; i++ {
+		for _, horizontal := range []bool{true, false} {
+
+			at := func(j int) bool {
+				if j < 0 || j >= n {
+					return false
+				}
+				if horizontal {
+					return m.at(j, i)
+				}
+				return m.at(i, j)
+			}
+
+			run := 1
+			for j := 1; j < n; j++ {
+				if at(j) == at(j-1) {
+					run++
+					if run == 5 {
+						score += 3
+					} else if run > 5 {
+						score++
+					}
+				} else {
+					run = 1
+				}
+			}
+
+			for j := -4; j < n; j++ {
+				match := true
+				for k, d := range finderLike {
+					if at(j+k) != d {
+						match = false
+						break
+					}
+				}
+				if !match {
+					continue
+				}
+				before, after := true, true
+				for k := 1; k <= 4; k++ {
+					if at(j - k) {
+						before = false
+					}
+					if at(j + 6 + k) {
+						after = false
+					}
+				}
+				if before || after {
+					score += 40
+				}
+			}
+		}
+	}
+
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if m.at(x, y) {
+				dark++
+			}
+			if x < n-1 && y < n-1 {
+				c := m.at(x, y)
+				if c == m.at(x+1, y) && c == m.at(x, y+1) && c == m.at(x+1, y+1) {
+					score += 3
+				}
+			}
+		}
+	}
+
+	total := n * n
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	if k > 0 {
+		score += k * 10
+	}
+
+	return score
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func maxAbs(a, b int) int {
+	if abs(a) > abs(b) {
+		return abs(a)
+	}
+	return abs(b)
+}
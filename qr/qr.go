@@ -0,0 +1,143 @@
+package qr
+
+import (
+	"image"
+	"image/color"
+)
+
+// A small QR code encoder and decoder, so that pages can carry their identity
+// in a form that survives printing and scanning, or being run through a PDF
+// optimiser that throws away the hidden text. Only what we need is supported:
+// byte mode, versions 1 to 10 (up to 213 bytes at level M), and codes that are
+// the right way round (not mirrored). The decoder is meant for clean renders
+// and reasonable scans of our own pages, rather than photos of codes in the wild.
+
+// Level is the error correction level, i.e. how much of the code can be
+// damaged and still be read (L ~7%, M ~15%, Q ~25%, H ~30%)
+type Level int
+
+const (
+	L Level = iota
+	M
+	Q
+	H
+)
+
+const (
+	minVersion = 1
+	maxVersion = 10
+)
+
+// the two bits used for the level in the format information
+var levelBits = [4]int{1, 0, 3, 2}
+
+// blocks of codewords for a version and level: n1 blocks with d1 data
+// codewords, then n2 blocks with d2 data codewords, each with ec error
+// correction codewords
+type blockSpec struct {
+	ec, n1, d1, n2, d2 int
+}
+
+var blockSpecs = [maxVersion + 1][4]blockSpec{
+	{},
+	{{7, 1, 19, 0, 0}, {10, 1, 16, 0, 0}, {13, 1, 13, 0, 0}, {17, 1, 9, 0, 0}},
+	{{10, 1, 34, 0, 0}, {16, 1, 28, 0, 0}, {22, 1, 22, 0, 0}, {28, 1, 16, 0, 0}},
+	{{15, 1, 55, 0, 0}, {26, 1, 44, 0, 0}, {18, 2, 17, 0, 0}, {22, 2, 13, 0, 0}},
+	{{20, 1, 80, 0, 0}, {18, 2, 32, 0, 0}, {26, 2, 24, 0, 0}, {16, 4, 9, 0, 0}},
+	{{26, 1, 108, 0, 0}, {24, 2, 43, 0, 0}, {18, 2, 15, 2, 16}, {22, 2, 11, 2, 12}},
+	{{18, 2, 68, 0, 0}, {16, 4, 27, 0, 0}, {24, 4, 19, 0, 0}, {28, 4, 15, 0, 0}},
+	{{20, 2, 78, 0, 0}, {18, 4, 31, 0, 0}, {18, 2, 14, 4, 15}, {26, 4, 13, 1, 14}},
+	{{24, 2, 97, 0, 0}, {22, 2, 38, 2, 39}, {22, 4, 18, 2, 19}, {26, 4, 14, 2, 15}},
+	{{30, 2, 116, 0, 0}, {22, 3, 36, 2, 37}, {20, 4, 16, 4, 17}, {24, 4, 12, 4, 13}},
+	{{18, 2, 68, 2, 69}, {26, 4, 43, 1, 44}, {24, 6, 19, 2, 20}, {28, 6, 15, 2, 16}},
+}
+
+var alignmentPositions = [maxVersion + 1][]int{
+	{},
+	{},
+	{6, 18},
+	{6, 22},
+	{6, 26},
+	{6, 30},
+	{6, 34},
+	{6, 22, 38},
+	{6, 24, 42},
+	{6, 26, 46},
+	{6, 28, 50},
+}
+
+func (b blockSpec) dataCodewords() int {
+	return b.n1*b.d1 + b.n2*b.d2
+}
+
+func (b blockSpec) totalCodewords() int {
+	return b.dataCodewords() + (b.n1+b.n2)*b.ec
+}
+
+// blockLengths lists the number of data codewords in each block
+func (b blockSpec) blockLengths() []int {
+	lengths := []int{}
+	for i := 0; i < b.n1; i++ {
+		lengths = append(lengths, b.d1)
+	}
+	for i := 0; i < b.n2; i++ {
+		lengths = append(lengths, b.d2)
+	}
+	return lengths
+}
+
+func sizeOf(version int) int {
+	return 17 + 4*version
+}
+
+// countBits is the length of the byte count in byte mode
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// Capacity is the most bytes that fit in a code of this version and level
+func Capacity(version int, level Level) int {
+	if version < minVersion || version > maxVersion {
+		return 0
+	}
+	return (blockSpecs[version][level].dataCodewords()*8 - 4 - countBits(version)) / 8
+}
+
+type Code struct {
+	Version int
+	Level   Level
+	Mask    int
+	Size    int
+	modules []bool // row by row, true is dark
+}
+
+func (c *Code) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y*c.Size+x]
+}
+
+// Image draws the code with each module scale pixels square,
+// and a light border quiet modules wide
+func (c *Code) Image(scale, quiet int) *image.Gray {
+
+	side := (c.Size + 2*quiet) * scale
+
+	im := image.NewGray(image.Rect(0, 0, side, side))
+
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			v := color.Gray{255}
+			if c.Dark(x/scale-quiet, y/scale-quiet) {
+				v = color.Gray{0}
+			}
+			im.SetGray(x, y, v)
+		}
+	}
+
+	return im
+}
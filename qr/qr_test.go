@@ -0,0 +1,166 @@
+package qr
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRSEncode(t *testing.T) {
+
+	// HELLO WORLD as 1-M, the usual worked example
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	assert.Equal(t, want, rsEncode(data, 10))
+}
+
+func TestRSCorrect(t *testing.T) {
+
+	r := rand.New(rand.NewSource(1))
+
+	for _, ecLen := range []int{7, 10, 18, 30} {
+
+		data := make([]byte, 40)
+		r.Read(data)
+
+		codewords := append(append([]byte{}, data...), rsEncode(data, ecLen)...)
+
+		corrupt := append([]byte{}, codewords...)
+		for _, i := range r.Perm(len(corrupt))[:ecLen/2] {
+			corrupt[i] ^= byte(1 + r.Intn(255))
+		}
+
+		n, err := rsCorrect(corrupt, ecLen)
+		assert.NoError(t, err)
+		assert.Equal(t, ecLen/2, n)
+		assert.Equal(t, codewords, corrupt)
+	}
+}
+
+func TestFormatAndVersionInfo(t *testing.T) {
+
+	assert.Equal(t, 0x77c4, formatInfo(L, 0)) // 111011111000100
+	assert.Equal(t, 0x5412, formatInfo(M, 0)) // 101010000010010
+	assert.Equal(t, 0x355f, formatInfo(Q, 0)) // 011010101011111
+	assert.Equal(t, 0x1689, formatInfo(H, 0)) // 001011010001001
+	assert.Equal(t, 0x07c94, versionInfo(7))  // 000111110010010100
+}
+
+func TestCodewordsFillMatrix(t *testing.T) {
+
+	for version := minVersion; version <= maxVersion; version++ {
+
+		m := newMatrix(version)
+
+		modules := 0
+		m.dataOrder(func(x, y int) { modules++ })
+
+		for level := L; level <= H; level++ {
+			total := blockSpecs[version][level].totalCodewords()
+			assert.Equal(t, modules/8, total, "version %d level %d", version, level)
+		}
+
+		remainder := 0
+		if version >= 2 && version <= 6 {
+			remainder = 7
+		}
+		assert.Equal(t, remainder, modules%8, "version %d", version)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+
+	r := rand.New(rand.NewSource(2))
+
+	for level := L; level <= H; level++ {
+		for _, n := range []int{1, 17, 60, 100, Capacity(maxVersion, level)} {
+
+			data := make([]byte, n)
+			r.Read(data)
+
+			code, err := Encode(data, level)
+			assert.NoError(t, err)
+
+			got, err := Decode(code.Image(4, 4))
+			assert.NoError(t, err, "level %d length %d version %d", level, n, code.Version)
+			assert.Equal(t, data, got)
+		}
+	}
+
+	_, err := Encode(make([]byte, Capacity(maxVersion, M)+1), M)
+	assert.Error(t, err)
+}
+
+func TestDecodeDamaged(t *testing.T) {
+
+	data := []byte("gradex:1|8f2e1c9a-2a4b-4d7e-9f00-5d3a1b2c4e6f|3|B999999|PGEE00000 Example Exam")
+
+	code, err := Encode(data, M)
+	assert.NoError(t, err)
+
+	im := code.Image(4, 4)
+
+	// scribble over a few data modules on the right hand side
+	draw.Draw(im, image.Rect(4*(4+code.Size-8), 4*(4+12), 4*(4+code.Size-5), 4*(4+15)), &image.Uniform{color.Black}, image.Point{}, draw.Src)
+
+	got, err := Decode(im)
+	assert.NoError(t, err)
+	assert.Equal(t, data, got)
+}
+
+func TestDecodeScannedPage(t *testing.T) {
+
+	data := []byte("gradex:1|8f2e1c9a-2a4b-4d7e-9f00-5d3a1b2c4e6f|12|B999999|PGEE00000")
+
+	code, err := Encode(data, M)
+	assert.NoError(t, err)
+
+	// put the code on a page with some other marks on it
+	page := image.NewGray(image.Rect(0, 0, 600, 800))
+	draw.Draw(page, page.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+	draw.Draw(page, image.Rect(40, 40, 40+code.Size*4+16, 40+code.Size*4+16), code.Image(4, 2), image.Point{}, draw.Src)
+	draw.Draw(page, image.Rect(300, 400, 340, 440), &image.Uniform{color.Black}, image.Point{}, draw.Src)
+	draw.Draw(page, image.Rect(100, 600, 500, 604), &image.Uniform{color.Black}, image.Point{}, draw.Src)
+
+	// scan it at an angle, a bit smaller, with a grey background
+	for _, degrees := range []float64{0, 3, -7, 90, 180, 225} {
+
+		a := degrees * math.Pi / 180
+		s := 0.9
+
+		scan := image.NewGray(image.Rect(0, 0, 700, 900))
+
+		for y := 0; y < 700; y++ {
+			for x := 0; x < 700; x++ {
+				// rotate about the middle of the code
+				dx, dy := float64(x)-350, float64(y)-350
+				sx := (dx*math.Cos(a)+dy*math.Sin(a))/s + 110
+				sy := (-dx*math.Sin(a)+dy*math.Cos(a))/s + 110
+				v := uint8(200)
+				if sx >= 0 && sy >= 0 && sx < 600 && sy < 800 {
+					v = page.GrayAt(int(sx), int(sy)).Y/2 + 100
+				}
+				scan.SetGray(x, y, color.Gray{v})
+			}
+		}
+
+		got, err := Decode(scan)
+		assert.NoError(t, err, "rotated %g degrees", degrees)
+		assert.Equal(t, data, got)
+	}
+}
+
+func TestDecodeNotFound(t *testing.T) {
+
+	blank := image.NewGray(image.Rect(0, 0, 200, 200))
+	draw.Draw(blank, blank.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	_, err := Decode(blank)
+	assert.Equal(t, ErrNotFound, err)
+}
@@ -0,0 +1,210 @@
+package qr
+
+import "errors"
+
+// Reed-Solomon error correction over GF(256), with the QR polynomial
+// x^8 + x^4 + x^3 + x^2 + 1 and generator roots starting at alpha^0.
+// Codewords are polynomials with the first codeword as the highest power.
+
+var gfExp [512]byte
+var gfLog [256]int
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	for i := 255; i < 512; i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+255-gfLog[b]]
+}
+
+func gfInverse(a byte) byte {
+	return gfExp[255-gfLog[a]]
+}
+
+// gfPow is alpha^n
+func gfPow(n int) byte {
+	n %= 255
+	if n < 0 {
+		n += 255
+	}
+	return gfExp[n]
+}
+
+// rsGenerator is the product of (x - alpha^i) for i < degree,
+// with the highest power first
+func rsGenerator(degree int) []byte {
+	g := []byte{1}
+	for i := 0; i < degree; i++ {
+		next := make([]byte, len(g)+1)
+		for j, c := range g {
+			next[j] ^= c
+			next[j+1] ^= gfMul(c, gfPow(i))
+		}
+		g = next
+	}
+	return g
+}
+
+// rsEncode returns the ecLen error correction codewords for data
+func rsEncode(data []byte, ecLen int) []byte {
+
+	g := rsGenerator(ecLen)
+	ec := make([]byte, ecLen)
+
+	for _, d := range data {
+		factor := d ^ ec[0]
+		copy(ec, ec[1:])
+		ec[ecLen-1] = 0
+		for i := range ec {
+			ec[i] ^= gfMul(g[i+1], factor)
+		}
+	}
+
+	return ec
+}
+
+var errTooManyErrors = errors.New("too many errors to correct")
+
+// evalLow evaluates a polynomial with the lowest power first
+func evalLow(p []byte, x byte) byte {
+	y := byte(0)
+	for i := len(p) - 1; i >= 0; i-- {
+		y = gfMul(y, x) ^ p[i]
+	}
+	return y
+}
+
+// rsCorrect fixes up to ecLen/2 wrong codewords in place, where the last
+// ecLen codewords are the error correction, and returns how many it fixed
+func rsCorrect(codewords []byte, ecLen int) (int, error) {
+
+	n := len(codewords)
+
+	syndromes := make([]byte, ecLen)
+	clean := true
+
+	for j := 0; j < ecLen; j++ {
+		s := byte(0)
+		x := gfPow(j)
+		for _, c := range codewords {
+			s = gfMul(s, x) ^ c
+		}
+		syndromes[j] = s
+		if s != 0 {
+			clean = false
+		}
+	}
+
+	if clean {
+		return 0, nil
+	}
+
+	// Berlekamp-Massey for the error locator, lowest power first
+	locator := []byte{1}
+	previous := []byte{1}
+	errs := 0
+	shift := 1
+	lastDiscrepancy := byte(1)
+
+	for k := 0; k < ecLen; k++ {
+
+		d := syndromes[k]
+		for i := 1; i <= errs && i < len(locator); i++ {
+			d ^= gfMul(locator[i], syndromes[k-i])
+		}
+
+		if d == 0 {
+			shift++
+			continue
+		}
+
+		coef := gfDiv(d, lastDiscrepancy)
+
+		next := make([]byte, max(len(locator), len(previous)+shift))
+		copy(next, locator)
+		for i, p := range previous {
+			next[i+shift] ^= gfMul(coef, p)
+		}
+
+		if 2*errs <= k {
+			previous = locator
+			errs = k + 1 - errs
+			lastDiscrepancy = d
+			shift = 1
+		} else {
+			shift++
+		}
+
+		locator = next
+	}
+
+	if 2*errs > ecLen {
+		return 0, errTooManyErrors
+	}
+
+	// Chien search for the error positions
+	positions := []int{}
+	for i := 0; i < n; i++ {
+		power := n - 1 - i
+		if evalLow(locator, gfPow(-power)) == 0 {
+			positions = append(positions, i)
+		}
+	}
+
+	if len(positions) != errs {
+		return 0, errTooManyErrors
+	}
+
+	// Forney for the error values
+	omega := make([]byte, ecLen)
+	for i := 0; i < ecLen; i++ {
+		for j := 0; j <= i && j < len(locator); j++ {
+			omega[i] ^= gfMul(locator[j], syndromes[i-j])
+		}
+	}
+
+	derivative := make([]byte, len(locator))
+	for i := 1; i < len(locator); i += 2 {
+		derivative[i-1] = locator[i]
+	}
+
+	for _, i := range positions {
+		x := gfPow(n - 1 - i)
+		xInverse := gfInverse(x)
+		denominator := evalLow(derivative, xInverse)
+		if denominator == 0 {
+			return 0, errTooManyErrors
+		}
+		codewords[i] ^= gfMul(x, gfDiv(evalLow(omega, xInverse), denominator))
+	}
+
+	return errs, nil
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -1,11 +1,22 @@
 package util
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
+// SafeText puts text on one line, and drops anything that can't be printed,
+// but keeps non-ASCII characters, e.g. so that Zoë stays Zoë, because
+// pagedata escapes them, and they can be drawn in a TrueType font
 func SafeText(text string) string {
-	re := regexp.MustCompile("[[:^ascii:]]")
-	text = re.ReplaceAllLiteralString(text, "")
-	re = regexp.MustCompile("\\s+") // this one necessary
+	re := regexp.MustCompile("\\s+") // this one necessary
 	text = re.ReplaceAllLiteralString(text, " ")
-	return text
+	return strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, text)
 }
@@ -11,8 +11,14 @@ func TestWhiteSpaceRemoved(t *testing.T) {
 	assert.Equal(t, "Hello There How Are You? Good!", SafeText("Hello\rThere\nHow Are You?\r\nGood!"))
 
 }
-func TestUnicodeRemoved(t *testing.T) {
+func TestUnicodeKept(t *testing.T) {
 
-	assert.Equal(t, "BooYaa!", SafeText("Boo－Yaa!"))
+	assert.Equal(t, "Boo－Yaa! Zoë", SafeText("Boo－Yaa!\tZoë"))
+
+}
+
+func TestUnprintableRemoved(t *testing.T) {
+
+	assert.Equal(t, "BooYaa!", SafeText("Boo\x00\u200bYaa!\xff"))
 
 }